@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Command ibm-deprecations reports the deprecated resources, data sources,
+// arguments and attributes of the provider, with the version they are
+// removed in and their replacements, as JSON or Markdown:
+//
+//	ibm-deprecations -format markdown -out deprecations.md
+//
+// With -scan, it reports their uses in the .tf files of the given modules
+// instead, and exits with status 1 when there are some. -report scans against
+// a JSON report, e.g. of a newer version of the provider:
+//
+//	ibm-deprecations -scan ./infra
+//	ibm-deprecations -scan -report deprecations.json ./infra
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+func main() {
+	format := flag.String("format", "json", "Format of the report: json or markdown")
+	out := flag.String("out", "", "Path of the report, the standard output by default")
+	scan := flag.Bool("scan", false, "Report the deprecations used by the modules in the arguments")
+	reportPath := flag.String("report", "", "Path of a JSON report to scan against, the report of this provider by default")
+	flag.Parse()
+
+	report := migrate.ProviderRules(provider.Provider()).Report(version.Version)
+	if *reportPath != "" {
+		src, err := os.ReadFile(*reportPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		report = migrate.Report{}
+		if err := json.Unmarshal(src, &report); err != nil {
+			log.Fatalf("[ERROR] Error reading %s: %s", *reportPath, err)
+		}
+	}
+
+	if !*scan {
+		w := io.Writer(os.Stdout)
+		if *out != "" {
+			f, err := os.Create(*out)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := write(w, report, *format); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	modules, err := migrate.ReadModules(paths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dirs := make([]string, 0, len(modules))
+	for dir := range modules {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	found := 0
+	for _, dir := range dirs {
+		findings, err := report.Scan(modules[dir])
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, f := range findings {
+			f.File = filepath.Join(dir, f.File)
+			fmt.Println(f)
+		}
+		found += len(findings)
+	}
+	if found > 0 {
+		log.Printf("[WARN] %d uses of deprecations of the provider %s", found, report.ProviderVersion)
+		os.Exit(1)
+	}
+}
+
+func write(w io.Writer, report migrate.Report, format string) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "markdown":
+		return report.Markdown(w)
+	}
+	return fmt.Errorf("[ERROR] Unknown format %q, expected json or markdown", format)
+}
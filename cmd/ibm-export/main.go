@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Command ibm-export writes the Terraform configuration of the existing
+// resources of an account, with an import block for each of them.
+//
+// The provider is configured from the environment variables it reads, e.g.
+// IC_API_KEY, IBMCLOUD_REGION, IBMCLOUD_VISIBILITY or
+// IBMCLOUD_ENDPOINTS_FILE_PATH, and from the flags that override them. Each
+// resource type is written to <type>.tf in the output directory:
+//
+//	ibm-export -region us-south -resource-group <id> -types ibm_is_vpc,ibm_is_subnet -out ./generated
+//	ibm-export -types ibm_cis_dns_record -arg cis_id=<crn> -arg domain_id=<id>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/export"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+func main() {
+	var types, tags, args listFlag
+	flag.Var(&types, "types", "Comma separated resource types to export, all supported types by default")
+	flag.Var(&tags, "tag", "Tag that exported resources must have, may be repeated")
+	flag.Var(&args, "arg", "Data source argument as name=value, e.g. cis_id=<crn>, may be repeated")
+	resourceGroup := flag.String("resource-group", "", "ID of the resource group of exported resources")
+	region := flag.String("region", "", "Region of exported resources")
+	visibility := flag.String("visibility", "", "Visibility of the endpoints: public, private or public-and-private")
+	endpointsFile := flag.String("endpoints-file", "", "Path of the endpoints file of the provider")
+	out := flag.String("out", ".", "Output directory")
+	list := flag.Bool("list", false, "List the supported resource types and exit")
+	flag.Parse()
+
+	p := provider.Provider()
+	if *list {
+		for _, resourceType := range export.New(p).Types() {
+			fmt.Println(resourceType)
+		}
+		return
+	}
+
+	config := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"region":              *region,
+		"visibility":          *visibility,
+		"endpoints_file_path": *endpointsFile,
+	} {
+		if value != "" {
+			config[key] = value
+		}
+	}
+	ctx := context.Background()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		for _, d := range diags {
+			log.Printf("[ERROR] %s: %s", d.Summary, d.Detail)
+		}
+		os.Exit(1)
+	}
+
+	scope := export.Scope{ResourceGroup: *resourceGroup, Tags: tags, Args: map[string]string{}}
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			log.Fatalf("[ERROR] Argument %q is not of the form name=value", arg)
+		}
+		scope.Args[name] = value
+	}
+
+	e := export.New(p)
+	if len(types) == 0 {
+		// Export every type that can be listed with the arguments given.
+	next:
+		for _, resourceType := range e.Types() {
+			for _, arg := range e.Listers[resourceType].Args {
+				if _, ok := scope.Args[arg]; !ok {
+					continue next
+				}
+			}
+			types = append(types, resourceType)
+		}
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatal(err)
+	}
+
+	failed := false
+	for _, resourceType := range types {
+		resources, errs := e.Export(ctx, resourceType, scope)
+		for _, err := range errs {
+			log.Println(err)
+			failed = true
+		}
+		if len(resources) == 0 {
+			continue
+		}
+		file := filepath.Join(*out, resourceType+".tf")
+		if err := os.WriteFile(file, e.Config(resources).Bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("[INFO] Exported %d %s to %s", len(resources), resourceType, file)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
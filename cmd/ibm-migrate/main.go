@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Command ibm-migrate upgrades Terraform configurations written for older
+// versions of the provider. It renames the resource types of the ibmcloud
+// provider and the deprecated arguments that have a replacement, migrates
+// the state of the renamed resources with -state, and reports the deprecated
+// resources and arguments still in use.
+//
+// Each directory is migrated as a module, with its subdirectories. Without
+// -write, the findings are printed and no file is changed:
+//
+//	ibm-migrate ./infra
+//	ibm-migrate -write -state terraform.tfstate ./infra
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/migrate"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+func main() {
+	write := flag.Bool("write", false, "Write the migrated files, the original ones are kept with a .backup suffix")
+	statePath := flag.String("state", "", "Path of a local state of the module to migrate, requires a single module")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [module directory or .tf file...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	modules, err := migrate.ReadModules(paths)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *statePath != "" && len(modules) != 1 {
+		log.Fatalf("[ERROR] -state requires a single module, got %d", len(modules))
+	}
+
+	rules := migrate.ProviderRules(provider.Provider())
+	pending := 0
+	for _, dir := range sortedKeys(modules) {
+		files := modules[dir]
+		m, err := rules.Config(files)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, f := range m.Findings {
+			f.File = filepath.Join(dir, f.File)
+			fmt.Println(f)
+		}
+		for name, src := range m.Files {
+			pending++
+			if *write {
+				writeFile(filepath.Join(dir, name), files[name], src)
+			}
+		}
+
+		if *statePath == "" {
+			continue
+		}
+		src, err := os.ReadFile(*statePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		state, findings, err := rules.State(src, m.Moved)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, f := range findings {
+			f.File = *statePath
+			fmt.Println(f)
+		}
+		if string(state) != string(src) {
+			pending++
+			if *write {
+				writeFile(*statePath, src, state)
+			}
+		}
+	}
+
+	switch {
+	case pending == 0:
+		log.Println("[INFO] Nothing to migrate")
+	case *write:
+		log.Printf("[INFO] Migrated %d files", pending)
+	default:
+		log.Printf("[INFO] %d files to migrate, run with -write to migrate them", pending)
+	}
+}
+
+func writeFile(path string, old, new []byte) {
+	if err := os.WriteFile(path+".backup", old, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, new, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func sortedKeys(m map[string]map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
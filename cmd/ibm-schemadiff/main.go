@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Command ibm-schemadiff snapshots the schema of the provider and reports
+// the changes between two snapshots, e.g. the snapshots committed in
+// ibm/schemadiff/testdata/schema.json by two releases:
+//
+//	ibm-schemadiff -snapshot schema.json
+//	git show v1.86.0:ibm/schemadiff/testdata/schema.json > old.json
+//	ibm-schemadiff old.json [new.json]
+//
+// The new snapshot is the one of this provider by default. It exits with
+// status 1 when there are breaking changes.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/schemadiff"
+)
+
+func main() {
+	snapshot := flag.String("snapshot", "", "Write the snapshot of the schema of the provider to this path and exit")
+	breakingOnly := flag.Bool("breaking", false, "Only report the breaking changes")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] old.json [new.json]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *snapshot != "" {
+		src, err := schemadiff.New(provider.Provider()).Marshal()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(*snapshot, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if flag.NArg() < 1 || flag.NArg() > 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	old, err := read(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	new := schemadiff.New(provider.Provider())
+	if flag.NArg() == 2 {
+		if new, err = read(flag.Arg(1)); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	changes := schemadiff.Diff(old, new)
+	breaking := schemadiff.Breaking(changes)
+	if *breakingOnly {
+		changes = breaking
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+	if len(breaking) > 0 {
+		log.Printf("[WARN] %d breaking changes", len(breaking))
+		os.Exit(1)
+	}
+}
+
+func read(path string) (*schemadiff.Snapshot, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := schemadiff.Unmarshal(src)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the snapshot %s: %s", path, err)
+	}
+	return s, nil
+}
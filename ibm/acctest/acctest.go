@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -2264,6 +2265,19 @@ var (
 	TestAccProvider  *schema.Provider
 )
 
+// TestAccProtoV5ProviderFactories serves the muxed provider, which is needed
+// by tests of the features served through the plugin framework, such as
+// ephemeral resources.
+var TestAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	ProviderName: func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	},
+}
+
 // testAccProviderConfigure ensures Provider is only configured once
 //
 // The PreCheck(t) function is invoked for every test and this prevents
@@ -2290,6 +2304,7 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func TestAccPreCheck(t *testing.T) {
+	UseCassette(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
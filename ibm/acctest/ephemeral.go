@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// OpenEphemeralResource configures r with providerData, which is usually a
+// stub of conns.ClientSession, and opens it with config, a map of the
+// attributes of the configuration to their string, bool or int value. The
+// attributes that are missing from config are null. It returns the result of
+// Open, for unit tests of ephemeral resources that need no Terraform binary.
+func OpenEphemeralResource(t *testing.T, r ephemeral.EphemeralResource, providerData interface{}, config map[string]interface{}) *ephemeral.OpenResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	if configurable, ok := r.(ephemeral.EphemeralResourceWithConfigure); ok {
+		configureResp := &ephemeral.ConfigureResponse{}
+		configurable.Configure(ctx, ephemeral.ConfigureRequest{ProviderData: providerData}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("Error configuring the ephemeral resource: %v", configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Error getting the schema of the ephemeral resource: %v", schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+	objectType := s.Type().TerraformType(ctx)
+
+	values := make(map[string]tftypes.Value, len(s.Attributes))
+	for name, attribute := range s.Attributes {
+		attributeType := attribute.GetType().TerraformType(ctx)
+		value, ok := config[name]
+		if !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+			continue
+		}
+		if i, ok := value.(int); ok {
+			value = int64(i)
+		}
+		values[name] = tftypes.NewValue(attributeType, value)
+	}
+	for name := range config {
+		if _, ok := s.Attributes[name]; !ok {
+			t.Fatalf("The ephemeral resource has no attribute %s", name)
+		}
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: s, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp
+}
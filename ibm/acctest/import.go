@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/vcr"
+)
+
+// ImportFixturesDir holds the recorded configurations used to check that
+// resources can be imported. Each file is named after the resource type it
+// covers, e.g. ibm_appid_theme_text.tf, and declares that resource with the
+// name "test". Fixtures run against a live account, as no cassettes of the
+// test TestAccImportStateVerify/<type> are recorded for them.
+const ImportFixturesDir = "testdata/import"
+
+// ImportFixturesTest is the test that applies and imports the fixtures, one
+// subtest per resource type, and that names their cassettes.
+const ImportFixturesTest = "TestAccImportStateVerify"
+
+// ImportFixtureResourceName is the name of the resource checked by a fixture.
+const ImportFixtureResourceName = "test"
+
+var (
+	importFixtureEnv    = regexp.MustCompile(`\$\{env\.([A-Za-z0-9_]+)\}`)
+	importFixtureHeader = regexp.MustCompile(`^#\s*(import_state_verify_ignore|import_state_id)\s*:\s*(.*)$`)
+	importFixtureIDPart = regexp.MustCompile(`\{([a-z0-9_.#%]+)\}`)
+)
+
+// ImportFixture is a recorded configuration that creates a resource so that
+// importing it can be checked with ImportStateVerify.
+//
+// Fixtures may start with comment lines that tune the import step:
+//
+//	# import_state_verify_ignore: comment, request_by
+//	# import_state_id: {id}/{nlb_host}
+//
+// import_state_verify_ignore lists the arguments that cannot be read back
+// from the API. import_state_id builds the import ID from attributes of the
+// created resource when it differs from the resource ID. References of the
+// form ${env.NAME} in the configuration are replaced with the environment
+// variable NAME.
+type ImportFixture struct {
+	ResourceType            string
+	Config                  string
+	ImportStateVerifyIgnore []string
+	ImportStateID           string
+	MissingEnv              []string
+}
+
+// ResourceName returns the address of the resource checked by the fixture.
+func (f ImportFixture) ResourceName() string {
+	return f.ResourceType + "." + ImportFixtureResourceName
+}
+
+// LoadImportFixtures reads the fixtures in dir, sorted by resource type.
+func LoadImportFixtures(dir string) ([]ImportFixture, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fixtures := make([]ImportFixture, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		fixture, err := parseImportFixture(strings.TrimSuffix(filepath.Base(file), ".tf"), string(content))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+func parseImportFixture(resourceType, content string) (ImportFixture, error) {
+	fixture := ImportFixture{ResourceType: resourceType}
+
+	for _, line := range strings.Split(content, "\n") {
+		match := importFixtureHeader.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		switch match[1] {
+		case "import_state_verify_ignore":
+			for _, attr := range strings.Split(match[2], ",") {
+				if attr = strings.TrimSpace(attr); attr != "" {
+					fixture.ImportStateVerifyIgnore = append(fixture.ImportStateVerifyIgnore, attr)
+				}
+			}
+		case "import_state_id":
+			fixture.ImportStateID = strings.TrimSpace(match[2])
+		}
+	}
+
+	declaration := fmt.Sprintf("resource %q %q", resourceType, ImportFixtureResourceName)
+	if !strings.Contains(content, declaration) {
+		return fixture, fmt.Errorf("the fixture must declare %s", declaration)
+	}
+
+	missing := map[string]bool{}
+	fixture.Config = importFixtureEnv.ReplaceAllStringFunc(content, func(ref string) string {
+		name := importFixtureEnv.FindStringSubmatch(ref)[1]
+		value := os.Getenv(name)
+		if value == "" && !missing[name] {
+			missing[name] = true
+			fixture.MissingEnv = append(fixture.MissingEnv, name)
+		}
+		return value
+	})
+	return fixture, nil
+}
+
+// ImportFixtureCassette returns the file that holds the recorded cassette of
+// the fixture of resourceType in dir.
+func ImportFixtureCassette(dir, resourceType string) string {
+	return vcr.CassettePath(dir, ImportFixturesTest+"/"+resourceType)
+}
+
+// ImportStateVerifyTestCase applies the fixture configuration and then checks
+// that importing the resource produces the same state. The test records its
+// cassette, or replays it, when IBMCLOUD_VCR_MODE is set; replayed cases run
+// without TF_ACC, as they never reach the API.
+func ImportStateVerifyTestCase(t *testing.T, fixture ImportFixture) resource.TestCase {
+	importStep := resource.TestStep{
+		ResourceName:            fixture.ResourceName(),
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: fixture.ImportStateVerifyIgnore,
+	}
+	if fixture.ImportStateID != "" {
+		importStep.ImportStateIdFunc = importFixtureStateIDFunc(fixture)
+	}
+
+	return resource.TestCase{
+		IsUnitTest: testAccRecorder != nil && testAccRecorder.Mode() == vcr.ModeReplay,
+		PreCheck: func() {
+			if len(fixture.MissingEnv) > 0 {
+				t.Fatalf("%v must be set to import %s", fixture.MissingEnv, fixture.ResourceType)
+			}
+			TestAccPreCheck(t)
+		},
+		Providers: TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fixture.Config,
+			},
+			importStep,
+		},
+	}
+}
+
+func importFixtureStateIDFunc(fixture ImportFixture) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[fixture.ResourceName()]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", fixture.ResourceName())
+		}
+
+		var err error
+		id := importFixtureIDPart.ReplaceAllStringFunc(fixture.ImportStateID, func(ref string) string {
+			attr := strings.Trim(ref, "{}")
+			value, ok := rs.Primary.Attributes[attr]
+			if !ok && err == nil {
+				err = fmt.Errorf("%s has no attribute %s for the import ID", fixture.ResourceName(), attr)
+			}
+			return value
+		})
+		return id, err
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+// importExempt lists the resources that cannot be imported. Their
+// documentation says why.
+var importExempt = map[string]bool{
+	"ibm_backup_recovery_connector_access_token": true,
+}
+
+func TestProviderResourcesSupportImport(t *testing.T) {
+	var missing []string
+	for name, r := range provider.Provider().ResourcesMap {
+		if r.Importer == nil && !importExempt[name] {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		t.Errorf("Resource %s does not support import", name)
+	}
+}
+
+// importFixturesRequired lists the resources that gained an importer without
+// an acceptance test that imports them, so that their fixture is the only
+// check of the import. The other importable resources are checked by the
+// ImportState steps of their own acceptance tests, if at all, and need no
+// fixture.
+var importFixturesRequired = []string{
+	"ibm_appid_theme_text",
+	"ibm_cdn",
+	"ibm_cis_custom_list_items",
+	"ibm_container_api_key_reset",
+	"ibm_container_nlb_dns",
+	"ibm_dns_domain_registration_nameservers",
+	"ibm_iam_authorization_policy_detach",
+	"ibm_network_interface_sg_attachment",
+	"ibm_pi_console_language",
+	"ibm_resource_reclamation_delete",
+}
+
+func TestImportFixtures(t *testing.T) {
+	fixtures, err := LoadImportFixtures(ImportFixturesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatalf("No import fixtures found in %s", ImportFixturesDir)
+	}
+
+	resources := provider.Provider().ResourcesMap
+	covered := map[string]bool{}
+	for _, fixture := range fixtures {
+		covered[fixture.ResourceType] = true
+		r, ok := resources[fixture.ResourceType]
+		if !ok {
+			t.Errorf("Fixture %s does not match a registered resource", fixture.ResourceType)
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("Fixture %s covers a resource that does not support import", fixture.ResourceType)
+		}
+		for _, attr := range fixture.ImportStateVerifyIgnore {
+			if _, ok := r.Schema[attr]; !ok {
+				t.Errorf("Fixture %s ignores unknown attribute %s", fixture.ResourceType, attr)
+			}
+		}
+	}
+	for _, name := range importFixturesRequired {
+		if !covered[name] {
+			t.Errorf("Resource %s has no import fixture in %s", name, ImportFixturesDir)
+		}
+	}
+
+	// Every recorded cassette replays a fixture
+	cassettes, err := filepath.Glob(ImportFixtureCassette(cassetteDir(), "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cassette := range cassettes {
+		resourceType := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(cassette), ImportFixturesTest+"_"), ".json")
+		if !covered[resourceType] {
+			t.Errorf("Cassette %s has no fixture in %s", cassette, ImportFixturesDir)
+		}
+	}
+}
+
+func TestParseImportFixture(t *testing.T) {
+	t.Setenv("IBM_TEST_FIXTURE_CLUSTER", "mycluster")
+
+	fixture, err := parseImportFixture("ibm_container_nlb_dns", `# import_state_verify_ignore: resource_group_id, nlb_ips
+# import_state_id: {id}/{nlb_host}
+resource "ibm_container_nlb_dns" "test" {
+  cluster  = "${env.IBM_TEST_FIXTURE_CLUSTER}"
+  nlb_host = "${env.IBM_TEST_FIXTURE_UNSET}"
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fixture.ResourceName(); got != "ibm_container_nlb_dns.test" {
+		t.Errorf("Expected resource name ibm_container_nlb_dns.test, got %s", got)
+	}
+	if len(fixture.ImportStateVerifyIgnore) != 2 || fixture.ImportStateVerifyIgnore[1] != "nlb_ips" {
+		t.Errorf("Unexpected ignored attributes %v", fixture.ImportStateVerifyIgnore)
+	}
+	if fixture.ImportStateID != "{id}/{nlb_host}" {
+		t.Errorf("Unexpected import ID template %q", fixture.ImportStateID)
+	}
+	if len(fixture.MissingEnv) != 1 || fixture.MissingEnv[0] != "IBM_TEST_FIXTURE_UNSET" {
+		t.Errorf("Unexpected missing environment variables %v", fixture.MissingEnv)
+	}
+
+	if _, err := parseImportFixture("ibm_cdn", `resource "ibm_cdn" "other" {}`); err == nil {
+		t.Error("Expected an error for a fixture without the test resource")
+	}
+}
+
+func TestAccImportStateVerify(t *testing.T) {
+	fixtures, err := LoadImportFixtures(ImportFixturesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fixture := range fixtures {
+		fixture := fixture
+		t.Run(fixture.ResourceType, func(t *testing.T) {
+			resource.Test(t, ImportStateVerifyTestCase(t, fixture))
+		})
+	}
+}
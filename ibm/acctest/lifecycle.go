@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Lifecycle drives a resource of the provider against the mock cloud
+// through the same calls that Terraform makes, without the Terraform binary.
+//
+// resource.UnitTest runs Terraform itself, and downloads it when
+// TF_ACC_TERRAFORM_PATH is not set and it is not on the PATH, which the
+// environments that run the unit tests cannot do. Tests that use Lifecycle
+// always run; UseMockCloud runs the same configurations with
+// resource.UnitTest when Terraform is available.
+type Lifecycle struct {
+	t            *testing.T
+	provider     *schema.Provider
+	resourceType string
+}
+
+// NewLifecycle configures a provider against cloud to drive resources of
+// resourceType.
+func NewLifecycle(t *testing.T, cloud *mockcloud.Server, resourceType string) *Lifecycle {
+	t.Helper()
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+		"ibmcloud_api_key":    mockcloud.APIKey,
+		"region":              mockcloud.Region,
+		"endpoints_file_path": cloud.EndpointsFile(),
+	}))
+	if diags.HasError() {
+		t.Fatalf("Error configuring the provider: %v", diags)
+	}
+	return &Lifecycle{t: t, provider: p, resourceType: resourceType}
+}
+
+// Resource returns the resource that l drives.
+func (l *Lifecycle) Resource() *schema.Resource {
+	return l.provider.ResourcesMap[l.resourceType]
+}
+
+// Plan plans config against state. Like Terraform, it passes the raw
+// configuration to the resource.
+func (l *Lifecycle) Plan(state *terraformsdk.InstanceState, config map[string]interface{}) (*terraformsdk.InstanceDiff, error) {
+	l.t.Helper()
+	src, err := json.Marshal(config)
+	if err != nil {
+		l.t.Fatalf("Error encoding the configuration of %s: %s", l.resourceType, err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(src, l.Resource().CoreConfigSchema().ImpliedType())
+	if err != nil {
+		l.t.Fatalf("Error decoding the configuration of %s: %s", l.resourceType, err)
+	}
+	planned := &terraformsdk.InstanceState{}
+	if state != nil {
+		planned = state.DeepCopy()
+	}
+	planned.RawConfig = rawConfig
+	return l.Resource().SimpleDiff(context.Background(), planned, terraformsdk.NewResourceConfigRaw(config), l.provider.Meta())
+}
+
+// Apply plans config against state and applies the plan.
+func (l *Lifecycle) Apply(state *terraformsdk.InstanceState, config map[string]interface{}) *terraformsdk.InstanceState {
+	l.t.Helper()
+	diff, err := l.Plan(state, config)
+	if err != nil {
+		l.t.Fatalf("Error planning %s: %s", l.resourceType, err)
+	}
+	if diff == nil {
+		return state
+	}
+	newState, diags := l.ApplyDiff(state, diff)
+	if diags.HasError() {
+		l.t.Fatalf("Error applying %s: %v", l.resourceType, diags)
+	}
+	return newState
+}
+
+// ApplyDiff applies diff to state and returns the state that Terraform saves,
+// even when the apply fails.
+func (l *Lifecycle) ApplyDiff(state *terraformsdk.InstanceState, diff *terraformsdk.InstanceDiff) (*terraformsdk.InstanceState, diag.Diagnostics) {
+	return l.Resource().Apply(context.Background(), state, diff, l.provider.Meta())
+}
+
+// Refresh reads state, and returns nil when the resource no longer exists.
+func (l *Lifecycle) Refresh(state *terraformsdk.InstanceState) *terraformsdk.InstanceState {
+	l.t.Helper()
+	newState, diags := l.Resource().RefreshWithoutUpgrade(context.Background(), state, l.provider.Meta())
+	if diags.HasError() {
+		l.t.Fatalf("Error reading %s: %v", l.resourceType, diags)
+	}
+	return newState
+}
+
+// ImportState imports id and reads the imported resource.
+func (l *Lifecycle) ImportState(id string) *terraformsdk.InstanceState {
+	l.t.Helper()
+	states, err := l.provider.ImportState(context.Background(), &terraformsdk.InstanceInfo{Type: l.resourceType}, id)
+	if err != nil {
+		l.t.Fatalf("Error importing %s %s: %s", l.resourceType, id, err)
+	}
+	if len(states) != 1 {
+		l.t.Fatalf("Expected the import of %s %s to return one state, got %d", l.resourceType, id, len(states))
+	}
+	state := l.Refresh(states[0])
+	if state == nil {
+		l.t.Fatalf("Imported %s %s not found", l.resourceType, id)
+	}
+	return state
+}
+
+// Destroy destroys the resource of state.
+func (l *Lifecycle) Destroy(state *terraformsdk.InstanceState) {
+	l.t.Helper()
+	_, diags := l.ApplyDiff(state, &terraformsdk.InstanceDiff{Destroy: true})
+	if diags.HasError() {
+		l.t.Fatalf("Error destroying %s: %v", l.resourceType, diags)
+	}
+}
+
+// AssertImported checks that the imported state matches the state that was
+// applied, except for the arguments under ignore that cannot be read back.
+func AssertImported(t *testing.T, applied, imported *terraformsdk.InstanceState, ignore ...string) {
+	t.Helper()
+	for key, value := range applied.Attributes {
+		ignored := false
+		for _, prefix := range ignore {
+			ignored = ignored || key == prefix || len(key) > len(prefix) && key[:len(prefix)+1] == prefix+"."
+		}
+		if !ignored && imported.Attributes[key] != value {
+			t.Errorf("Attribute %s differs after import: expected %q, got %q", key, value, imported.Attributes[key])
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// UseMockCloud starts an in-process fake of the core IBM Cloud APIs for a
+// unit test, see package mockcloud. Prepend the ProviderConfig of the server
+// to the configuration of the test steps and run them with resource.UnitTest.
+//
+// Unit tests run Terraform, so t is skipped when there is no Terraform binary
+// on the PATH or in TF_ACC_TERRAFORM_PATH. The API traffic of the test is
+// never recorded or replayed, and t cannot run in parallel.
+func UseMockCloud(t *testing.T) *mockcloud.Server {
+	t.Helper()
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Terraform is not installed, set TF_ACC_TERRAFORM_PATH to run the tests against the mock cloud")
+		}
+	}
+	return StartMockCloud(t)
+}
+
+// StartMockCloud starts the mock cloud for the Lifecycle tests of t, which
+// need no Terraform binary. The API traffic of t is never recorded or
+// replayed, and t cannot run in parallel, although its subtests can.
+func StartMockCloud(t *testing.T) *mockcloud.Server {
+	t.Helper()
+	transport := conns.AcceptanceTestTransport
+	conns.AcceptanceTestTransport = nil
+	t.Cleanup(func() { conns.AcceptanceTestTransport = transport })
+
+	return mockcloud.Start(t)
+}
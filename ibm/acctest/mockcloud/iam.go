@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockcloud
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// tokenSignature signs the tokens issued by the server. The provider
+	// does not verify signatures, it only reads the claims.
+	tokenSignature = "bW9ja2Nsb3Vk"
+	refreshToken   = "mock-refresh-token"
+	tokenTTL       = time.Hour
+)
+
+func (s *Server) registerIAM(mux *http.ServeMux) {
+	mux.HandleFunc("POST /identity/token", s.createToken)
+}
+
+// createToken exchanges APIKey, or a refresh token issued before, for an
+// access token.
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "BXNIM0109E", "Error parsing the request: %s", err)
+		return
+	}
+	switch grant := r.PostForm.Get("grant_type"); grant {
+	case "urn:ibm:params:oauth:grant-type:apikey":
+		if r.PostForm.Get("apikey") != APIKey {
+			writeError(w, http.StatusBadRequest, "BXNIM0415E", "Provided API key could not be found.")
+			return
+		}
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != refreshToken {
+			writeError(w, http.StatusBadRequest, "BXNIM0407E", "Provided refresh token is invalid.")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "BXNIM0109E", "Unsupported grant type %q", grant)
+		return
+	}
+
+	issued := time.Now()
+	writeJSON(w, http.StatusOK, object{
+		"access_token":  newToken(issued),
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    int64(tokenTTL.Seconds()),
+		"expiration":    issued.Add(tokenTTL).Unix(),
+		"scope":         "ibm openid",
+	})
+}
+
+// newToken returns an access token for the account of the server, with the
+// claims the provider reads the user details from.
+func newToken(issued time.Time) string {
+	encode := func(v interface{}) string {
+		data, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	claims := object{
+		"iam_id":  "IBMid-mockcloud",
+		"id":      "IBMid-mockcloud",
+		"sub":     "mockcloud@example.com",
+		"email":   "mockcloud@example.com",
+		"account": object{"bss": AccountID, "valid": true},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"iat":     issued.Unix(),
+		"exp":     issued.Add(tokenTTL).Unix(),
+	}
+	return encode(object{"alg": "RS256", "typ": "JWT"}) + "." + encode(claims) + "." + tokenSignature
+}
+
+func validToken(token string) bool {
+	return strings.Count(token, ".") == 2 && strings.HasSuffix(token, "."+tokenSignature)
+}
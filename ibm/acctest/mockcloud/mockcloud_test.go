@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockcloud_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+func TestProviderResourceTag(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpc.ImportState(cloud.AddVPC("tf-mock-tagged-vpc"))
+	crn := vpcState.Attributes["crn"]
+
+	l := acc.NewLifecycle(t, cloud, "ibm_resource_tag")
+	state := l.Apply(nil, map[string]interface{}{"resource_id": crn, "tags": []interface{}{"env:test"}})
+	assert.Equal(t, crn, state.ID)
+	assert.Equal(t, "1", state.Attributes["tags.#"])
+
+	state = l.Apply(state, map[string]interface{}{"resource_id": crn, "tags": []interface{}{"env:prod", "team:network"}})
+	assert.Equal(t, "2", state.Attributes["tags.#"])
+	assert.Equal(t, "2", vpc.Refresh(vpcState).Attributes["tags.#"])
+
+	acc.AssertImported(t, state, l.ImportState(state.ID), "replace")
+
+	l.Destroy(state)
+	assert.Equal(t, "0", vpc.Refresh(vpcState).Attributes["tags.#"])
+}
+
+func TestProviderUnitTest(t *testing.T) {
+	cloud := acc.UseMockCloud(t)
+	config := func(suffix, plan string, tags string) string {
+		return cloud.ProviderConfig() + fmt.Sprintf(`
+resource "ibm_is_vpc" "vpc" {
+  name = "tf-mock-vpc%[1]s"
+}
+
+resource "ibm_resource_instance" "cos" {
+  name     = "tf-mock-cos%[1]s"
+  service  = "cloud-object-storage"
+  plan     = %[2]q
+  location = "global"
+}
+
+resource "ibm_resource_tag" "tags" {
+  resource_id = ibm_is_vpc.vpc.crn
+  tags        = [%[3]s]
+}
+`, suffix, plan, tags)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"ibm": func() (*schema.Provider, error) { return provider.Provider(), nil },
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, r := range state.RootModule().Resources {
+				switch r.Type {
+				case "ibm_is_vpc":
+					if cloud.Exists("vpcs", r.Primary.ID) {
+						return fmt.Errorf("VPC %s still exists", r.Primary.ID)
+					}
+				case "ibm_resource_instance":
+					if cloud.Exists("resource_instances", r.Primary.ID) {
+						return fmt.Errorf("Resource instance %s still exists", r.Primary.ID)
+					}
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("", "lite", `"env:test"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.vpc", "status", "available"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.cos", "status", "active"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tags", "tags.#", "1"),
+				),
+			},
+			{
+				Config: config("-renamed", "standard", `"env:prod", "team:network"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.vpc", "name", "tf-mock-vpc-renamed"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.cos", "plan", "standard"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tags", "tags.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_vpc.vpc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"no_sg_acl_rules", "address_prefix_management"},
+			},
+			{
+				ResourceName:            "ibm_resource_instance.cos",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+			{
+				ResourceName:            "ibm_resource_tag.tags",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+		},
+	})
+}
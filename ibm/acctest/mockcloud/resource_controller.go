@@ -0,0 +1,431 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockcloud
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// catalogService is a service of the Global Catalog that can be provisioned
+// through the Resource Controller.
+type catalogService struct {
+	ID    string
+	Name  string
+	Plans []catalogPlan
+}
+
+// catalogPlan is a plan of a catalog service and the locations it is
+// deployed to.
+type catalogPlan struct {
+	ID        string
+	Name      string
+	Locations []string
+}
+
+// catalog lists the services the server can provision.
+var catalog = []catalogService{
+	{
+		ID:   "dff97f5c-bc5e-4455-b470-411c3edbe49c",
+		Name: "cloud-object-storage",
+		Plans: []catalogPlan{
+			{ID: "2fdf0c08-2d32-4f46-84b5-32e0c92fffd8", Name: "lite", Locations: []string{"global"}},
+			{ID: "744bfc56-d12c-4866-88d5-dac9139e0e5d", Name: "standard", Locations: []string{"global"}},
+		},
+	},
+	{
+		ID:   "ee41347f-b18e-4ca6-bf80-b5467c63f9a6",
+		Name: "kms",
+		Plans: []catalogPlan{
+			{ID: "eedd3585-90c6-4c8f-be3d-062069e99fc3", Name: "tiered-pricing", Locations: []string{"us-south", "us-east", "eu-de"}},
+		},
+	},
+}
+
+func (s *Server) registerResourceController(mux *http.ServeMux) {
+	s.collection("resource_groups")[DefaultResourceGroupID] = object{
+		"id":                 DefaultResourceGroupID,
+		"crn":                crn("resource-controller", "", "resource-group", DefaultResourceGroupID),
+		"account_id":         AccountID,
+		"name":               DefaultResourceGroupName,
+		"state":              "ACTIVE",
+		"default":            true,
+		"enable_reclamation": false,
+		"created_at":         now(),
+		"updated_at":         now(),
+	}
+
+	// Global Catalog, as read by bluemix-go.
+	mux.HandleFunc("GET /api/v1/{$}", s.listCatalogServices)
+	mux.HandleFunc("GET /api/v1/{id}", s.getCatalogEntry)
+	mux.HandleFunc("GET /api/v1/{id}/plan", s.listCatalogPlans)
+	mux.HandleFunc("GET /api/v1/{id}/deployment", s.listCatalogDeployments)
+
+	// Resource Manager.
+	mux.HandleFunc("GET /v2/resource_groups", s.listResourceGroups)
+	mux.HandleFunc("GET /v2/resource_groups/{id}", s.getResourceGroup)
+
+	// Resource Controller.
+	mux.HandleFunc("POST /v2/resource_instances", s.createResourceInstance)
+	mux.HandleFunc("GET /v2/resource_instances", s.listResourceInstances)
+	mux.HandleFunc("GET /v2/resource_instances/{id}", s.getResourceInstance)
+	mux.HandleFunc("PATCH /v2/resource_instances/{id}", s.updateResourceInstance)
+	mux.HandleFunc("DELETE /v2/resource_instances/{id}", s.deleteResourceInstance)
+}
+
+func catalogServiceObject(svc catalogService) object {
+	return object{
+		"id":       svc.ID,
+		"name":     svc.Name,
+		"kind":     "service",
+		"active":   true,
+		"children": []object{},
+		"metadata": object{
+			"service": object{
+				"rc_provisionable": true,
+				"iam_compatible":   true,
+				"bindable":         true,
+			},
+		},
+	}
+}
+
+// deploymentCRN returns the catalog CRN of the deployment of plan to
+// location, which is the target of the instances provisioned there.
+func deploymentCRN(plan catalogPlan, location string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:globalcatalog:%s:::deployment:%s", location, plan.ID)
+}
+
+// findDeployment returns the service and plan deployed to target, and the
+// location of the deployment.
+func findDeployment(target string) (catalogService, catalogPlan, string, bool) {
+	for _, svc := range catalog {
+		for _, plan := range svc.Plans {
+			for _, location := range plan.Locations {
+				if deploymentCRN(plan, location) == target {
+					return svc, plan, location, true
+				}
+			}
+		}
+	}
+	return catalogService{}, catalogPlan{}, "", false
+}
+
+func catalogPage(resources []object) object {
+	return object{"resources": resources, "next": "", "count": len(resources), "offset": 0, "resource_count": len(resources)}
+}
+
+func (s *Server) listCatalogServices(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	q := r.URL.Query().Get("q")
+	resources := []object{}
+	for _, svc := range catalog {
+		if q == "" || svc.Name == q {
+			resources = append(resources, catalogServiceObject(svc))
+		}
+	}
+	writeJSON(w, http.StatusOK, catalogPage(resources))
+}
+
+func (s *Server) getCatalogEntry(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	id := r.PathValue("id")
+	for _, svc := range catalog {
+		if svc.ID == id {
+			writeJSON(w, http.StatusOK, catalogServiceObject(svc))
+			return
+		}
+		for _, plan := range svc.Plans {
+			if plan.ID == id {
+				writeJSON(w, http.StatusOK, object{"id": plan.ID, "name": plan.Name, "kind": "plan", "active": true})
+				return
+			}
+		}
+	}
+	notFound(w, "Catalog entry", id)
+}
+
+func (s *Server) listCatalogPlans(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	id := r.PathValue("id")
+	for _, svc := range catalog {
+		if svc.ID == id {
+			plans := []object{}
+			for _, plan := range svc.Plans {
+				plans = append(plans, object{"id": plan.ID, "name": plan.Name, "kind": "plan", "active": true})
+			}
+			writeJSON(w, http.StatusOK, catalogPage(plans))
+			return
+		}
+	}
+	notFound(w, "Catalog entry", id)
+}
+
+func (s *Server) listCatalogDeployments(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	id := r.PathValue("id")
+	for _, svc := range catalog {
+		for _, plan := range svc.Plans {
+			if plan.ID != id {
+				continue
+			}
+			deployments := []object{}
+			for _, location := range plan.Locations {
+				target := deploymentCRN(plan, location)
+				deployments = append(deployments, object{
+					"id":          plan.ID + ":" + location,
+					"name":        location,
+					"kind":        "deployment",
+					"catalog_crn": target,
+					"metadata": object{
+						"rc_compatible":  true,
+						"iam_compatible": true,
+						"deployment": object{
+							"location":   location,
+							"target_crn": target,
+						},
+					},
+				})
+			}
+			writeJSON(w, http.StatusOK, catalogPage(deployments))
+			return
+		}
+	}
+	notFound(w, "Catalog entry", id)
+}
+
+func (s *Server) listResourceGroups(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	query := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := s.list("resource_groups", func(obj object) bool {
+		if name := query.Get("name"); name != "" && obj["name"] != name {
+			return false
+		}
+		return query.Get("default") != "true" || obj["default"] == true
+	})
+	writeJSON(w, http.StatusOK, object{"resources": groups})
+}
+
+func (s *Server) getResourceGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	group, ok := s.collection("resource_groups")[id]
+	if !ok {
+		notFound(w, "Resource group", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) createResourceInstance(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name           string                 `json:"name"`
+		Target         string                 `json:"target"`
+		ResourceGroup  string                 `json:"resource_group"`
+		ResourcePlanID string                 `json:"resource_plan_id"`
+		Parameters     map[string]interface{} `json:"parameters"`
+		AllowCleanup   bool                   `json:"allow_cleanup"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	svc, plan, location, ok := findDeployment(req.Target)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "bad_request", "Target %s is not a deployment of the catalog", req.Target)
+		return
+	}
+	if req.ResourcePlanID != "" && req.ResourcePlanID != plan.ID {
+		writeError(w, http.StatusBadRequest, "bad_request", "Plan %s is not deployed to %s", req.ResourcePlanID, req.Target)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.collection("resource_groups")[req.ResourceGroup]; !ok {
+		writeError(w, http.StatusBadRequest, "bad_request", "Resource group %s not found", req.ResourceGroup)
+		return
+	}
+	guid := s.newID("")
+	id := crn(svc.Name, location, "", guid)
+	created := now()
+	instance := object{
+		"id":                    id,
+		"guid":                  guid,
+		"crn":                   id,
+		"url":                   "/v2/resource_instances/" + guid,
+		"name":                  req.Name,
+		"account_id":            AccountID,
+		"resource_group_id":     req.ResourceGroup,
+		"resource_group_crn":    crn("resource-controller", "", "resource-group", req.ResourceGroup),
+		"resource_id":           svc.ID,
+		"resource_plan_id":      plan.ID,
+		"target_crn":            req.Target,
+		"parameters":            req.Parameters,
+		"state":                 "active",
+		"type":                  "service_instance",
+		"locked":                false,
+		"allow_cleanup":         req.AllowCleanup,
+		"dashboard_url":         s.URL + "/dashboard/" + guid,
+		"resource_keys_url":     "/v2/resource_instances/" + guid + "/resource_keys",
+		"resource_bindings_url": "/v2/resource_instances/" + guid + "/resource_bindings",
+		"resource_aliases_url":  "/v2/resource_instances/" + guid + "/resource_aliases",
+		"extensions":            object{},
+		"plan_history":          []object{{"resource_plan_id": plan.ID, "start_date": created}},
+		"last_operation":        object{"type": "create", "state": "succeeded", "async": false, "description": "Completed create instance operation"},
+		"created_at":            created,
+		"created_by":            "IBMid-mockcloud",
+		"updated_at":            created,
+		"updated_by":            "IBMid-mockcloud",
+	}
+	s.collection("resource_instances")[id] = instance
+	writeJSON(w, http.StatusCreated, instance)
+}
+
+func (s *Server) listResourceInstances(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	query := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instances := s.list("resource_instances", func(obj object) bool {
+		for _, field := range []string{"name", "guid", "resource_group_id", "resource_id", "resource_plan_id", "type"} {
+			if v := query.Get(field); v != "" && obj[field] != v {
+				return false
+			}
+		}
+		return obj["state"] != "removed" || query.Get("state") == "removed"
+	})
+	writeJSON(w, http.StatusOK, object{"rows_count": len(instances), "next_url": nil, "resources": instances})
+}
+
+// resourceInstance returns the instance id, which is its CRN or GUID.
+func (s *Server) resourceInstance(id string) (object, bool) {
+	instances := s.collection("resource_instances")
+	if instance, ok := instances[id]; ok {
+		return instance, true
+	}
+	for _, instance := range instances {
+		if instance["guid"] == id {
+			return instance, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) getResourceInstance(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	instance, ok := s.resourceInstance(id)
+	if !ok {
+		notFound(w, "Resource instance", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) updateResourceInstance(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name           *string                `json:"name"`
+		Parameters     map[string]interface{} `json:"parameters"`
+		ResourcePlanID *string                `json:"resource_plan_id"`
+		AllowCleanup   *bool                  `json:"allow_cleanup"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	instance, ok := s.resourceInstance(id)
+	if !ok || instance["state"] == "removed" {
+		notFound(w, "Resource instance", id)
+		return
+	}
+	if req.ResourcePlanID != nil && *req.ResourcePlanID != instance["resource_plan_id"] {
+		svc, _, _, _ := findDeployment(instance["target_crn"].(string))
+		found := false
+		for _, plan := range svc.Plans {
+			found = found || plan.ID == *req.ResourcePlanID
+		}
+		if !found {
+			writeError(w, http.StatusBadRequest, "bad_request", "Plan %s is not a plan of %s", *req.ResourcePlanID, svc.Name)
+			return
+		}
+		instance["resource_plan_id"] = *req.ResourcePlanID
+		instance["plan_history"] = append(instance["plan_history"].([]object), object{"resource_plan_id": *req.ResourcePlanID, "start_date": now()})
+	}
+	if req.Name != nil {
+		instance["name"] = *req.Name
+	}
+	if req.Parameters != nil {
+		instance["parameters"] = req.Parameters
+	}
+	if req.AllowCleanup != nil {
+		instance["allow_cleanup"] = *req.AllowCleanup
+	}
+	instance["last_operation"] = object{"type": "update", "state": "succeeded", "async": false, "description": "Completed update instance operation"}
+	instance["updated_at"] = now()
+	writeJSON(w, http.StatusOK, instance)
+}
+
+// deleteResourceInstance removes the instance. Like the Resource Controller,
+// the server keeps answering for it with the state "removed".
+func (s *Server) deleteResourceInstance(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	instance, ok := s.resourceInstance(id)
+	if !ok || instance["state"] == "removed" {
+		notFound(w, "Resource instance", id)
+		return
+	}
+	instance["state"] = "removed"
+	instance["deleted_at"] = now()
+	instance["deleted_by"] = "IBMid-mockcloud"
+	instance["last_operation"] = object{"type": "delete", "state": "succeeded", "async": false, "description": "Completed delete instance operation"}
+	for _, tags := range s.tags {
+		delete(tags, id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
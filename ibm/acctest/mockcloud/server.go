@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package mockcloud is an in-process fake of the core IBM Cloud APIs: the IAM
+// token exchange, the Resource Controller, Resource Manager and Global
+// Catalog, Global Tagging and Global Search, and the VPC, subnet, security
+// group and instance group APIs of the VPC infrastructure. It keeps its
+// state in memory, so that the provider can create, read, update, delete and
+// import resources against it without reaching the network.
+//
+// The provider is pointed at the server through an endpoints file, see
+// Server.EndpointsFile and Server.ProviderConfig.
+//
+// Resources reach their final state at once, but the wait loops of the
+// provider still sleep before their first poll. Tests either shorten the poll
+// interval of the resources they drive, or add the resources they only
+// depend on with the Add methods of the server.
+package mockcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// AccountID is the account that owns every resource of the server.
+	AccountID = "0123456789abcdef0123456789abcdef"
+	// APIKey is the API key that the server exchanges for tokens. Any other
+	// key is rejected.
+	APIKey = "mock-api-key"
+	// Region is the region the endpoints file is written for.
+	Region = "us-south"
+	// DefaultResourceGroupID is the ID of the default resource group.
+	DefaultResourceGroupID = "a0b1c2d3e4f5a0b1c2d3e4f5a0b1c2d3"
+	// DefaultResourceGroupName is the name of the default resource group.
+	DefaultResourceGroupName = "Default"
+)
+
+// endpointKeys are the keys of the endpoints file served by the server, and
+// the path each service is rooted at.
+var endpointKeys = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT":                 "",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT": "",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":    "",
+	"IBMCLOUD_GT_API_ENDPOINT":                  "",
+	"IBMCLOUD_GS_API_ENDPOINT":                  "",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":               "/v1",
+}
+
+// object is a resource as it is sent over the API.
+type object = map[string]interface{}
+
+// Server fakes the IBM Cloud APIs. Its zero value is not usable; create it
+// with Start.
+type Server struct {
+	*httptest.Server
+
+	endpointsFile string
+
+	mu sync.Mutex
+	// collections holds the resources of the server by collection, e.g.
+	// "vpcs", and then by ID.
+	collections map[string]map[string]object
+	// tags holds the tags attached to a resource by tag type, then by CRN.
+	tags   map[string]map[string][]string
+	nextID int
+}
+
+// endpointsFileEnv override the endpoints file that the provider is
+// configured with.
+var endpointsFileEnv = []string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}
+
+// Start starts a server and writes its endpoints file to a temporary
+// directory. Both are removed when t completes. The environment variables
+// that override the endpoints file are unset for the duration of t.
+func Start(t testing.TB) *Server {
+	t.Helper()
+
+	for _, env := range endpointsFileEnv {
+		t.Setenv(env, "")
+	}
+	for key := range endpointKeys {
+		t.Setenv(key, "")
+	}
+
+	s := &Server{
+		collections: make(map[string]map[string]object),
+		tags:        make(map[string]map[string][]string),
+	}
+	mux := http.NewServeMux()
+	s.registerIAM(mux)
+	s.registerResourceController(mux)
+	s.registerTagging(mux)
+	s.registerVPC(mux)
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	s.endpointsFile = filepath.Join(t.TempDir(), "endpoints.json")
+	if err := s.writeEndpointsFile(s.endpointsFile); err != nil {
+		t.Fatalf("Error writing the endpoints file: %s", err)
+	}
+	return s
+}
+
+// EndpointsFile returns the path of the endpoints file that points the
+// provider at the server, for the public visibility and Region.
+func (s *Server) EndpointsFile() string {
+	return s.endpointsFile
+}
+
+// ProviderConfig returns a provider block that authenticates to the server
+// and reaches it through its endpoints file.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "ibm" {
+  ibmcloud_api_key    = %q
+  region              = %q
+  endpoints_file_path = %q
+}
+`, APIKey, Region, s.endpointsFile)
+}
+
+// Exists reports whether the resource id of collection, e.g. "vpcs" or
+// "resource_instances", exists and has not been deleted.
+func (s *Server) Exists(collection, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collections[collection][id]
+	return ok && obj["state"] != "removed"
+}
+
+func (s *Server) writeEndpointsFile(path string) error {
+	endpoints := make(map[string]interface{}, len(endpointKeys))
+	for key, root := range endpointKeys {
+		endpoints[key] = map[string]interface{}{
+			"public": map[string]string{Region: s.URL + root},
+		}
+	}
+	data, err := json.MarshalIndent(endpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// newID returns a new resource ID with prefix, e.g. r006-.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%08x-0000-4000-8000-%012x", prefix, s.nextID, s.nextID)
+}
+
+func (s *Server) collection(name string) map[string]object {
+	c, ok := s.collections[name]
+	if !ok {
+		c = make(map[string]object)
+		s.collections[name] = c
+	}
+	return c
+}
+
+// list returns the resources of collection that match filter, sorted by
+// creation.
+func (s *Server) list(collection string, filter func(object) bool) []object {
+	items := []object{}
+	for _, obj := range s.collection(collection) {
+		if filter == nil || filter(obj) {
+			items = append(items, obj)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i]["created_at"].(string)+items[i]["id"].(string) < items[j]["created_at"].(string)+items[j]["id"].(string)
+	})
+	return items
+}
+
+// crn returns the CRN of a resource of service in the account of the server.
+func crn(service, location, resourceType, id string) string {
+	if resourceType == "" {
+		return fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", service, location, AccountID, id)
+	}
+	return fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s::%s:%s", service, location, AccountID, resourceType, id)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// merge applies a JSON merge patch to obj.
+func merge(obj, patch object) {
+	for key, value := range patch {
+		if value == nil {
+			delete(obj, key)
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			if current, ok := obj[key].(map[string]interface{}); ok {
+				merge(current, nested)
+				continue
+			}
+		}
+		obj[key] = value
+	}
+}
+
+func decode(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of the VPC API, which the other
+// SDKs also accept.
+func writeError(w http.ResponseWriter, status int, code, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	writeJSON(w, status, object{
+		"errors":  []object{{"code": code, "message": message}},
+		"code":    code,
+		"message": message,
+		"trace":   "mockcloud",
+	})
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "not_found", "%s not found: %s", kind, id)
+}
+
+// authorized checks the bearer token of r, writing an error when it is not
+// one issued by the server.
+func authorized(w http.ResponseWriter, r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !validToken(token) {
+		writeError(w, http.StatusUnauthorized, "not_authorized", "The request is not authorized")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockcloud
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+// searchQuery matches the Global Search queries the provider sends to read
+// the tags of a resource, by CRN or, for classic infrastructure, by ID.
+var searchQuery = regexp.MustCompile(`^(?:crn:"([^"]+)"|doc\.id:(\S+) AND family:ims)$`)
+
+func (s *Server) registerTagging(mux *http.ServeMux) {
+	// Global Tagging.
+	mux.HandleFunc("GET /v3/tags", s.listTags)
+	mux.HandleFunc("POST /v3/tags/attach", s.attachTags)
+	mux.HandleFunc("POST /v3/tags/detach", s.detachTags)
+	mux.HandleFunc("DELETE /v3/tags/{tag_name}", s.deleteTag)
+
+	// Global Search.
+	mux.HandleFunc("POST /v3/resources/search", s.search)
+}
+
+// tagType returns the tag_type of r, which defaults to user tags.
+func tagType(r *http.Request) string {
+	if t := r.URL.Query().Get("tag_type"); t != "" {
+		return t
+	}
+	return "user"
+}
+
+// tagsOf returns the tags of type attached to the resource id.
+func (s *Server) tagsOf(tagType, id string) []string {
+	tags := append([]string{}, s.tags[tagType][id]...)
+	sort.Strings(tags)
+	return tags
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var names []string
+	if attachedTo := r.URL.Query().Get("attached_to"); attachedTo != "" {
+		names = s.tagsOf(tagType(r), attachedTo)
+	} else {
+		seen := map[string]bool{}
+		for _, tags := range s.tags[tagType(r)] {
+			for _, name := range tags {
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+		sort.Strings(names)
+	}
+	items := []object{}
+	for _, name := range names {
+		items = append(items, object{"name": name})
+	}
+	writeJSON(w, http.StatusOK, object{"total_count": len(items), "offset": 0, "limit": len(items), "items": items})
+}
+
+type tagRequest struct {
+	Resources []struct {
+		ResourceID   string `json:"resource_id"`
+		ResourceType string `json:"resource_type"`
+	} `json:"resources"`
+	TagName  string   `json:"tag_name"`
+	TagNames []string `json:"tag_names"`
+}
+
+func (req tagRequest) names() []string {
+	if req.TagName != "" {
+		return append([]string{req.TagName}, req.TagNames...)
+	}
+	return req.TagNames
+}
+
+func (s *Server) attachTags(w http.ResponseWriter, r *http.Request) {
+	s.updateTags(w, r, func(current, names []string) []string {
+		if r.URL.Query().Get("replace") == "true" {
+			current = nil
+		}
+		for _, name := range names {
+			if !slices.Contains(current, name) {
+				current = append(current, name)
+			}
+		}
+		return current
+	})
+}
+
+func (s *Server) detachTags(w http.ResponseWriter, r *http.Request) {
+	s.updateTags(w, r, func(current, names []string) []string {
+		kept := []string{}
+		for _, name := range current {
+			if !slices.Contains(names, name) {
+				kept = append(kept, name)
+			}
+		}
+		return kept
+	})
+}
+
+func (s *Server) updateTags(w http.ResponseWriter, r *http.Request, update func(current, names []string) []string) {
+	if !authorized(w, r) {
+		return
+	}
+	var req tagRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	if len(req.Resources) == 0 || len(req.names()) == 0 {
+		writeError(w, http.StatusBadRequest, "bad_request", "resources and tag_names are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := tagType(r)
+	if s.tags[t] == nil {
+		s.tags[t] = make(map[string][]string)
+	}
+	results := []object{}
+	for _, resource := range req.Resources {
+		s.tags[t][resource.ResourceID] = update(s.tags[t][resource.ResourceID], req.names())
+		results = append(results, object{"resource_id": resource.ResourceID, "is_error": false})
+	}
+	writeJSON(w, http.StatusOK, object{"results": results})
+}
+
+// deleteTag deletes a tag that is no longer attached to any resource.
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := r.PathValue("tag_name")
+	for _, tags := range s.tags[tagType(r)] {
+		if slices.Contains(tags, name) {
+			writeJSON(w, http.StatusBadRequest, object{"total_count": 1, "errors": true, "items": []object{{"provider": "ghost", "is_error": true}}})
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, object{"total_count": 1, "errors": false, "items": []object{{"provider": "ghost", "is_error": false}}})
+}
+
+// search answers the queries for the tags of a single resource. The resource
+// is found when it exists on the server or has tags attached.
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Query string `json:"query"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	match := searchQuery.FindStringSubmatch(req.Query)
+	if match == nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Unsupported query %q", req.Query)
+		return
+	}
+	id := match[1] + match[2]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := []object{}
+	user, access, service := s.tagsOf("user", id), s.tagsOf("access", id), s.tagsOf("service", id)
+	if s.hasCRN(id) || len(user)+len(access)+len(service) > 0 {
+		items = append(items, object{
+			"crn":          id,
+			"tags":         user,
+			"access_tags":  access,
+			"service_tags": service,
+		})
+	}
+	writeJSON(w, http.StatusOK, object{"items": items, "limit": 10, "search_cursor": ""})
+}
+
+// hasCRN reports whether a resource of the server that has not been deleted
+// has the CRN crn.
+func (s *Server) hasCRN(crn string) bool {
+	for _, collection := range s.collections {
+		for _, obj := range collection {
+			if obj["crn"] == crn && obj["state"] != "removed" {
+				return true
+			}
+		}
+	}
+	return false
+}
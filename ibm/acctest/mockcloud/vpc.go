@@ -0,0 +1,1325 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package mockcloud
+
+import (
+	"fmt"
+	"math/bits"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// zones are the zones of Region, with the default address prefix of a VPC
+// in each.
+var zones = []struct {
+	Name   string
+	Prefix string
+}{
+	{Region + "-1", "10.240.0.0/18"},
+	{Region + "-2", "10.240.64.0/18"},
+	{Region + "-3", "10.240.128.0/18"},
+}
+
+func (s *Server) registerVPC(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/vpcs", s.createVPC)
+	mux.HandleFunc("GET /v1/vpcs", s.listHandler("vpcs", "vpcs", "name", "resource_group.id", "classic_access"))
+	mux.HandleFunc("GET /v1/vpcs/{id}", s.getHandler("vpcs", "VPC"))
+	mux.HandleFunc("PATCH /v1/vpcs/{id}", s.updateHandler("vpcs", "VPC", "name", "dns"))
+	mux.HandleFunc("DELETE /v1/vpcs/{id}", s.deleteVPC)
+	mux.HandleFunc("GET /v1/vpcs/{id}/address_prefixes", s.listAddressPrefixes)
+	mux.HandleFunc("GET /v1/vpcs/{id}/default_network_acl", s.getVPCDefault("network_acls", "default_network_acl"))
+	mux.HandleFunc("GET /v1/vpcs/{id}/default_security_group", s.getVPCDefault("security_groups", "default_security_group"))
+	mux.HandleFunc("GET /v1/vpcs/{id}/default_routing_table", s.getVPCDefault("routing_tables", "default_routing_table"))
+	mux.HandleFunc("POST /v1/vpcs/{vpc_id}/routing_tables", s.createRoutingTable)
+	mux.HandleFunc("GET /v1/vpcs/{vpc_id}/routing_tables/{id}", s.getHandler("routing_tables", "Routing table"))
+	mux.HandleFunc("PATCH /v1/vpcs/{vpc_id}/routing_tables/{id}", s.updateHandler("routing_tables", "Routing table", "name"))
+	mux.HandleFunc("DELETE /v1/vpcs/{vpc_id}/routing_tables/{id}", s.deleteRoutingTable)
+	mux.HandleFunc("GET /v1/vpcs/{vpc_id}/routing_tables/{id}/routes", s.listRoutes)
+	mux.HandleFunc("POST /v1/vpcs/{vpc_id}/routing_tables/{id}/routes", s.createRoute)
+	mux.HandleFunc("GET /v1/vpcs/{vpc_id}/routing_tables/{id}/routes/{route_id}", s.route(nil))
+	mux.HandleFunc("PATCH /v1/vpcs/{vpc_id}/routing_tables/{id}/routes/{route_id}", s.route(s.updateRoute))
+	mux.HandleFunc("DELETE /v1/vpcs/{vpc_id}/routing_tables/{id}/routes/{route_id}", s.route(s.deleteRoute))
+	mux.HandleFunc("GET /v1/network_acls/{id}", s.getHandler("network_acls", "Network ACL"))
+	mux.HandleFunc("PATCH /v1/network_acls/{id}", s.updateHandler("network_acls", "Network ACL", "name"))
+
+	mux.HandleFunc("POST /v1/subnets", s.createSubnet)
+	mux.HandleFunc("GET /v1/subnets", s.listHandler("subnets", "subnets", "name", "resource_group.id", "vpc.id", "zone.name"))
+	mux.HandleFunc("GET /v1/subnets/{id}", s.getHandler("subnets", "Subnet"))
+	mux.HandleFunc("PATCH /v1/subnets/{id}", s.updateHandler("subnets", "Subnet", "name"))
+	mux.HandleFunc("DELETE /v1/subnets/{id}", s.deleteHandler("subnets", "Subnet"))
+
+	mux.HandleFunc("POST /v1/security_groups", s.createSecurityGroup)
+	mux.HandleFunc("GET /v1/security_groups", s.listHandler("security_groups", "security_groups", "name", "resource_group.id", "vpc.id"))
+	mux.HandleFunc("GET /v1/security_groups/{id}", s.getHandler("security_groups", "Security group"))
+	mux.HandleFunc("PATCH /v1/security_groups/{id}", s.updateHandler("security_groups", "Security group", "name"))
+	mux.HandleFunc("DELETE /v1/security_groups/{id}", s.deleteSecurityGroup)
+	mux.HandleFunc("GET /v1/security_groups/{id}/rules", s.listSecurityGroupRules)
+	mux.HandleFunc("GET /v1/security_groups/{id}/targets", s.listSecurityGroupTargets)
+	mux.HandleFunc("POST /v1/security_groups/{id}/rules", s.createSecurityGroupRule)
+	mux.HandleFunc("GET /v1/security_groups/{id}/rules/{rule_id}", s.securityGroupRule(nil))
+	mux.HandleFunc("PATCH /v1/security_groups/{id}/rules/{rule_id}", s.securityGroupRule(s.updateSecurityGroupRule))
+	mux.HandleFunc("DELETE /v1/security_groups/{id}/rules/{rule_id}", s.securityGroupRule(s.deleteSecurityGroupRule))
+
+	mux.HandleFunc("POST /v1/instance_groups", s.createInstanceGroup)
+	mux.HandleFunc("GET /v1/instance_groups/{id}", s.getHandler("instance_groups", "Instance group"))
+	mux.HandleFunc("PATCH /v1/instance_groups/{id}", s.updateInstanceGroup)
+	mux.HandleFunc("DELETE /v1/instance_groups/{id}", s.deleteInstanceGroup)
+	mux.HandleFunc("GET /v1/instance_groups/{id}/managers", s.listInstanceGroupManagers)
+	mux.HandleFunc("GET /v1/instance_groups/{id}/memberships", s.listInstanceGroupMemberships)
+	mux.HandleFunc("GET /v1/instance_groups/{id}/memberships/{membership_id}", s.instanceGroupMembership(nil))
+	mux.HandleFunc("PATCH /v1/instance_groups/{id}/memberships/{membership_id}", s.instanceGroupMembership(s.updateInstanceGroupMembership))
+	mux.HandleFunc("DELETE /v1/instance_groups/{id}/memberships/{membership_id}", s.instanceGroupMembership(s.deleteInstanceGroupMembership))
+}
+
+func (s *Server) href(path string) string {
+	return s.URL + "/v1/" + path
+}
+
+// reference returns the reference to obj that other resources embed.
+func reference(obj object) object {
+	ref := object{}
+	for _, key := range []string{"id", "crn", "href", "name", "resource_type"} {
+		if v, ok := obj[key]; ok {
+			ref[key] = v
+		}
+	}
+	return ref
+}
+
+func (s *Server) resourceGroupReference(id string) (object, error) {
+	if id == "" {
+		id = DefaultResourceGroupID
+	}
+	group, ok := s.collection("resource_groups")[id]
+	if !ok {
+		return nil, fmt.Errorf("Resource group %s not found", id)
+	}
+	return object{"id": id, "name": group["name"], "href": s.URL + "/v2/resource_groups/" + id}, nil
+}
+
+// newVPCResource returns a new resource of the VPC API of resourceType,
+// stored in collection.
+func (s *Server) newVPCResource(collection, resourceType, name string, resourceGroup object) object {
+	id := s.newID("r006-")
+	return object{
+		"id":             id,
+		"crn":            crn("is", Region, resourceType, id),
+		"href":           s.href(collection + "/" + id),
+		"name":           name,
+		"resource_group": resourceGroup,
+		"resource_type":  resourceType,
+		"created_at":     now(),
+	}
+}
+
+// field returns the value of the dotted path of obj, e.g. vpc.id.
+func field(obj object, path string) interface{} {
+	var value interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func (s *Server) listHandler(collection, key string, filters ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		query := r.URL.Query()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		items := s.list(collection, func(obj object) bool {
+			for _, filter := range filters {
+				if v := query.Get(filter); v != "" && fmt.Sprint(field(obj, filter)) != v {
+					return false
+				}
+			}
+			return true
+		})
+		writeJSON(w, http.StatusOK, object{
+			key:           items,
+			"limit":       50,
+			"total_count": len(items),
+			"first":       object{"href": s.href(collection + "?limit=50")},
+		})
+	}
+}
+
+func (s *Server) getHandler(collection, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("id")
+		obj, ok := s.collection(collection)[id]
+		if !ok {
+			notFound(w, kind, id)
+			return
+		}
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+// updateHandler applies the JSON merge patch of a request to the properties
+// of a resource that can be updated.
+func (s *Server) updateHandler(collection, kind string, updatable ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		var patch object
+		if err := decode(r, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+			return
+		}
+		for key := range patch {
+			if !slices.Contains(updatable, key) {
+				writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s cannot be updated", key)
+				return
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("id")
+		obj, ok := s.collection(collection)[id]
+		if !ok {
+			notFound(w, kind, id)
+			return
+		}
+		if name, ok := patch["name"].(string); ok && name != obj["name"] && s.nameInUse(collection, obj, name) {
+			writeError(w, http.StatusConflict, "validation_unique_failed", "%s name %s is already in use", kind, name)
+			return
+		}
+		merge(obj, patch)
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) deleteHandler(collection, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("id")
+		obj, ok := s.collection(collection)[id]
+		if !ok {
+			notFound(w, kind, id)
+			return
+		}
+		s.remove(collection, obj)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// remove deletes obj from collection along with its tags.
+func (s *Server) remove(collection string, obj object) {
+	delete(s.collection(collection), obj["id"].(string))
+	for _, tags := range s.tags {
+		delete(tags, obj["crn"].(string))
+	}
+}
+
+// nameInUse reports whether another resource of collection that is in the
+// same scope as obj, i.e. the region or the VPC of obj, is named name.
+func (s *Server) nameInUse(collection string, obj object, name string) bool {
+	for _, other := range s.collection(collection) {
+		if other["id"] != obj["id"] && other["name"] == name && field(other, "vpc.id") == field(obj, "vpc.id") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name                    string `json:"name"`
+		AddressPrefixManagement string `json:"address_prefix_management"`
+		ClassicAccess           bool   `json:"classic_access"`
+		ResourceGroup           struct {
+			ID string `json:"id"`
+		} `json:"resource_group"`
+		Dns object `json:"dns"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resourceGroup, err := s.resourceGroupReference(req.ResourceGroup.ID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	if req.Name == "" {
+		req.Name = s.newID("vpc-")
+	}
+	vpc := s.newVPCResource("vpcs", "vpc", req.Name, resourceGroup)
+	if s.nameInUse("vpcs", vpc, req.Name) {
+		writeError(w, http.StatusConflict, "validation_unique_failed", "VPC name %s is already in use", req.Name)
+		return
+	}
+	s.addVPC(vpc, req.AddressPrefixManagement != "manual", req.ClassicAccess, req.Dns)
+	writeJSON(w, http.StatusCreated, vpc)
+}
+
+// AddVPC adds a VPC with default address prefixes in the default resource
+// group, as if it was created outside of Terraform, and returns its ID.
+func (s *Server) AddVPC(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resourceGroup, _ := s.resourceGroupReference("")
+	vpc := s.newVPCResource("vpcs", "vpc", name, resourceGroup)
+	s.addVPC(vpc, true, false, nil)
+	return vpc["id"].(string)
+}
+
+// addVPC stores vpc along with its default network ACL, security group and
+// routing table, and the default address prefixes if defaultPrefixes is set.
+// It must be called with s.mu held.
+func (s *Server) addVPC(vpc object, defaultPrefixes, classicAccess bool, dnsRequest object) {
+	id := vpc["id"].(string)
+	name := vpc["name"].(string)
+	resourceGroup := vpc["resource_group"].(object)
+	vpcRef := reference(vpc)
+
+	acl := s.newVPCResource("network_acls", "network_acl", name+"-default-acl", resourceGroup)
+	acl["vpc"] = vpcRef
+	acl["subnets"] = []object{}
+	acl["rules"] = []object{
+		{"id": s.newID("r006-"), "name": "allow-inbound", "action": "allow", "direction": "inbound", "protocol": "all", "ip_version": "ipv4", "source": "0.0.0.0/0", "destination": "0.0.0.0/0", "created_at": now()},
+		{"id": s.newID("r006-"), "name": "allow-outbound", "action": "allow", "direction": "outbound", "protocol": "all", "ip_version": "ipv4", "source": "0.0.0.0/0", "destination": "0.0.0.0/0", "created_at": now()},
+	}
+	s.collection("network_acls")[acl["id"].(string)] = acl
+
+	sg := s.newVPCResource("security_groups", "security_group", name+"-default-sg", resourceGroup)
+	sg["vpc"] = vpcRef
+	sg["targets"] = []object{}
+	sg["rules"] = []object{
+		s.newSecurityGroupRule(sg, object{"direction": "inbound", "ip_version": "ipv4", "protocol": "all", "remote": reference(sg)}),
+		s.newSecurityGroupRule(sg, object{"direction": "outbound", "ip_version": "ipv4", "protocol": "all", "remote": object{"cidr_block": "0.0.0.0/0"}}),
+	}
+	s.collection("security_groups")[sg["id"].(string)] = sg
+
+	rt := s.newRoutingTable(vpc, name+"-default-rt")
+	rt["is_default"] = true
+	s.collection("routing_tables")[rt["id"].(string)] = rt
+
+	if defaultPrefixes {
+		for _, zone := range zones {
+			prefix := s.newVPCResource("address_prefixes", "address_prefix", "", nil)
+			delete(prefix, "resource_group")
+			delete(prefix, "crn")
+			prefix["name"] = prefix["id"]
+			prefix["href"] = s.href("vpcs/" + id + "/address_prefixes/" + prefix["id"].(string))
+			prefix["cidr"] = zone.Prefix
+			prefix["zone"] = object{"name": zone.Name, "href": s.href("regions/" + Region + "/zones/" + zone.Name)}
+			prefix["is_default"] = true
+			prefix["has_subnets"] = false
+			prefix["vpc"] = vpcRef
+			s.collection("address_prefixes")[prefix["id"].(string)] = prefix
+		}
+	}
+
+	dns := object{
+		"enable_hub":               false,
+		"resolution_binding_count": 0,
+		"resolver": object{
+			"type":          "system",
+			"configuration": "default",
+			"servers":       []object{{"address": "161.26.0.7"}, {"address": "161.26.0.8"}},
+		},
+	}
+	if enableHub, ok := field(dnsRequest, "enable_hub").(bool); ok {
+		dns["enable_hub"] = enableHub
+	}
+
+	vpc["classic_access"] = classicAccess
+	vpc["status"] = "available"
+	vpc["health_state"] = "ok"
+	vpc["health_reasons"] = []object{}
+	vpc["cse_source_ips"] = []object{}
+	vpc["dns"] = dns
+	vpc["default_network_acl"] = reference(acl)
+	vpc["default_security_group"] = reference(sg)
+	vpc["default_routing_table"] = reference(rt)
+	s.collection("vpcs")[id] = vpc
+}
+
+// deleteVPC deletes a VPC that has no subnets or security groups left, along
+// with its default network ACL, security group and routing table.
+func (s *Server) deleteVPC(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	vpc, ok := s.collection("vpcs")[id]
+	if !ok {
+		notFound(w, "VPC", id)
+		return
+	}
+	inVPC := func(obj object) bool { return field(obj, "vpc.id") == id }
+	if len(s.list("subnets", inVPC)) > 0 {
+		writeError(w, http.StatusConflict, "vpc_in_use", "The VPC %s still has subnets", id)
+		return
+	}
+	defaultSG := field(vpc, "default_security_group.id")
+	for _, sg := range s.list("security_groups", inVPC) {
+		if sg["id"] != defaultSG {
+			writeError(w, http.StatusConflict, "vpc_in_use", "The VPC %s still has security groups", id)
+			return
+		}
+	}
+	for _, collection := range []string{"address_prefixes", "network_acls", "routing_tables", "security_groups"} {
+		for _, obj := range s.list(collection, inVPC) {
+			delete(s.collection(collection), obj["id"].(string))
+		}
+	}
+	delete(s.collection("routing_tables"), field(vpc, "default_routing_table.id").(string))
+	s.remove("vpcs", vpc)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listAddressPrefixes(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.collection("vpcs")[id]; !ok {
+		notFound(w, "VPC", id)
+		return
+	}
+	prefixes := s.list("address_prefixes", func(obj object) bool { return field(obj, "vpc.id") == id })
+	writeJSON(w, http.StatusOK, object{"address_prefixes": prefixes, "limit": 50, "total_count": len(prefixes)})
+}
+
+// getVPCDefault returns a handler for the default resource of a VPC that the
+// VPC references with key.
+func (s *Server) getVPCDefault(collection, key string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := r.PathValue("id")
+		vpc, ok := s.collection("vpcs")[id]
+		if !ok {
+			notFound(w, "VPC", id)
+			return
+		}
+		obj := s.collection(collection)[field(vpc, key+".id").(string)]
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) createSubnet(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name          string `json:"name"`
+		IPVersion     string `json:"ip_version"`
+		Ipv4CIDRBlock string `json:"ipv4_cidr_block"`
+		TotalCount    int64  `json:"total_ipv4_address_count"`
+		VPC           struct {
+			ID string `json:"id"`
+		} `json:"vpc"`
+		Zone struct {
+			Name string `json:"name"`
+		} `json:"zone"`
+		ResourceGroup struct {
+			ID string `json:"id"`
+		} `json:"resource_group"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vpc, ok := s.collection("vpcs")[req.VPC.ID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "VPC %s not found", req.VPC.ID)
+		return
+	}
+	resourceGroup, err := s.resourceGroupReference(req.ResourceGroup.ID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	cidr, total, err := s.allocateCIDR(req.VPC.ID, req.Zone.Name, req.Ipv4CIDRBlock, req.TotalCount)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	if req.Name == "" {
+		req.Name = s.newID("subnet-")
+	}
+	subnet := s.newVPCResource("subnets", "subnet", req.Name, resourceGroup)
+	subnet["vpc"] = reference(vpc)
+	if s.nameInUse("subnets", subnet, req.Name) {
+		writeError(w, http.StatusConflict, "validation_unique_failed", "Subnet name %s is already in use", req.Name)
+		return
+	}
+	subnet["zone"] = object{"name": req.Zone.Name, "href": s.href("regions/" + Region + "/zones/" + req.Zone.Name)}
+	subnet["ip_version"] = "ipv4"
+	subnet["ipv4_cidr_block"] = cidr
+	subnet["total_ipv4_address_count"] = total
+	// The first four addresses and the last one are reserved.
+	subnet["available_ipv4_address_count"] = total - 5
+	subnet["status"] = "available"
+	subnet["network_acl"] = vpc["default_network_acl"]
+	subnet["routing_table"] = vpc["default_routing_table"]
+	s.collection("subnets")[subnet["id"].(string)] = subnet
+	writeJSON(w, http.StatusCreated, subnet)
+}
+
+// allocateCIDR returns the CIDR block of a new subnet of the VPC in zone and
+// its number of addresses. The block is taken from the default address
+// prefix of the zone, after the blocks of the existing subnets, unless cidr
+// is given.
+func (s *Server) allocateCIDR(vpcID, zone, cidr string, total int64) (string, int64, error) {
+	if cidr != "" {
+		var a, b, c, d, length int
+		if _, err := fmt.Sscanf(cidr, "%d.%d.%d.%d/%d", &a, &b, &c, &d, &length); err != nil || length < 8 || length > 29 {
+			return "", 0, fmt.Errorf("Invalid ipv4_cidr_block %q", cidr)
+		}
+		return cidr, 1 << (32 - length), nil
+	}
+	if total < 8 || total&(total-1) != 0 {
+		return "", 0, fmt.Errorf("total_ipv4_address_count must be a power of 2 of at least 8, not %d", total)
+	}
+	base := -1
+	for i, z := range zones {
+		if z.Name == zone {
+			base = i << 14
+		}
+	}
+	if base < 0 {
+		return "", 0, fmt.Errorf("Zone %s not found", zone)
+	}
+	next := int64(0)
+	for _, subnet := range s.list("subnets", func(obj object) bool {
+		return field(obj, "vpc.id") == vpcID && field(obj, "zone.name") == zone
+	}) {
+		next += subnet["total_ipv4_address_count"].(int64)
+	}
+	// Align the block on its size.
+	next = (next + total - 1) / total * total
+	if next+total > 1<<14 {
+		return "", 0, fmt.Errorf("The address prefix of zone %s is exhausted", zone)
+	}
+	offset := int64(base) + next
+	return fmt.Sprintf("10.240.%d.%d/%d", offset>>8, offset&0xff, 32-(bits.Len64(uint64(total))-1)), total, nil
+}
+
+func (s *Server) createSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+		VPC  struct {
+			ID string `json:"id"`
+		} `json:"vpc"`
+		ResourceGroup struct {
+			ID string `json:"id"`
+		} `json:"resource_group"`
+		Rules []object `json:"rules"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vpc, ok := s.collection("vpcs")[req.VPC.ID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "VPC %s not found", req.VPC.ID)
+		return
+	}
+	resourceGroup, err := s.resourceGroupReference(req.ResourceGroup.ID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	if req.Name == "" {
+		req.Name = s.newID("sg-")
+	}
+	sg := s.newVPCResource("security_groups", "security_group", req.Name, resourceGroup)
+	sg["vpc"] = reference(vpc)
+	if s.nameInUse("security_groups", sg, req.Name) {
+		writeError(w, http.StatusConflict, "validation_unique_failed", "Security group name %s is already in use", req.Name)
+		return
+	}
+	sg["targets"] = []object{}
+	rules := []object{}
+	for _, rule := range req.Rules {
+		rules = append(rules, s.newSecurityGroupRule(sg, rule))
+	}
+	sg["rules"] = rules
+	s.collection("security_groups")[sg["id"].(string)] = sg
+	writeJSON(w, http.StatusCreated, sg)
+}
+
+// deleteSecurityGroup deletes a security group, unless it is the default
+// security group of its VPC.
+func (s *Server) deleteSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	sg, ok := s.collection("security_groups")[id]
+	if !ok {
+		notFound(w, "Security group", id)
+		return
+	}
+	vpc := s.collection("vpcs")[field(sg, "vpc.id").(string)]
+	if field(vpc, "default_security_group.id") == id {
+		writeError(w, http.StatusConflict, "security_group_in_use", "The security group %s is the default security group of its VPC", id)
+		return
+	}
+	s.remove("security_groups", sg)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newSecurityGroupRule completes a rule of the request for a rule of sg.
+func (s *Server) newSecurityGroupRule(sg object, rule object) object {
+	id := s.newID("r006-")
+	created := object{
+		"id":         id,
+		"href":       s.href("security_groups/" + sg["id"].(string) + "/rules/" + id),
+		"ip_version": "ipv4",
+		"local":      object{"cidr_block": "0.0.0.0/0"},
+	}
+	merge(created, rule)
+	if _, ok := created["remote"]; !ok {
+		created["remote"] = object{"cidr_block": "0.0.0.0/0"}
+	}
+	if remoteID, ok := field(created, "remote.id").(string); ok {
+		if remote, ok := s.collection("security_groups")[remoteID]; ok {
+			created["remote"] = reference(remote)
+		}
+	}
+	return created
+}
+
+func (s *Server) listSecurityGroupRules(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	sg, ok := s.collection("security_groups")[id]
+	if !ok {
+		notFound(w, "Security group", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, object{"rules": sg["rules"]})
+}
+
+func (s *Server) listSecurityGroupTargets(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	sg, ok := s.collection("security_groups")[id]
+	if !ok {
+		notFound(w, "Security group", id)
+		return
+	}
+	targets := sg["targets"].([]object)
+	writeJSON(w, http.StatusOK, object{
+		"targets":     targets,
+		"limit":       50,
+		"total_count": len(targets),
+		"first":       object{"href": s.href("security_groups/" + id + "/targets?limit=50")},
+	})
+}
+
+// AddSecurityGroupRule adds a rule to the security group id, as if it was
+// added outside of Terraform, e.g. in the console.
+func (s *Server) AddSecurityGroupRule(id string, rule map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.collection("security_groups")[id]
+	if !ok {
+		return fmt.Errorf("Security group %s not found", id)
+	}
+	sg["rules"] = append(sg["rules"].([]object), s.newSecurityGroupRule(sg, rule))
+	return nil
+}
+
+func (s *Server) createSecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var rule object
+	if err := decode(r, &rule); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	sg, ok := s.collection("security_groups")[id]
+	if !ok {
+		notFound(w, "Security group", id)
+		return
+	}
+	created := s.newSecurityGroupRule(sg, rule)
+	sg["rules"] = append(sg["rules"].([]object), created)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// securityGroupRule returns a handler that finds a rule of a security group
+// and passes it to handle, or writes it when handle is nil.
+func (s *Server) securityGroupRule(handle func(w http.ResponseWriter, r *http.Request, sg object, i int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, ruleID := r.PathValue("id"), r.PathValue("rule_id")
+		sg, ok := s.collection("security_groups")[id]
+		if !ok {
+			notFound(w, "Security group", id)
+			return
+		}
+		for i, rule := range sg["rules"].([]object) {
+			if rule["id"] == ruleID {
+				if handle == nil {
+					writeJSON(w, http.StatusOK, rule)
+				} else {
+					handle(w, r, sg, i)
+				}
+				return
+			}
+		}
+		notFound(w, "Security group rule", ruleID)
+	}
+}
+
+func (s *Server) updateSecurityGroupRule(w http.ResponseWriter, r *http.Request, sg object, i int) {
+	var patch object
+	if err := decode(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	rule := sg["rules"].([]object)[i]
+	if _, ok := patch["remote"]; ok {
+		// The remote of a rule is replaced rather than merged, as it is
+		// either an address, a CIDR block or a security group.
+		rule["remote"] = patch["remote"]
+		delete(patch, "remote")
+	}
+	merge(rule, patch)
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func (s *Server) deleteSecurityGroupRule(w http.ResponseWriter, r *http.Request, sg object, i int) {
+	rules := sg["rules"].([]object)
+	sg["rules"] = append(rules[:i:i], rules[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newRoutingTable returns a new routing table of vpc. Unlike the API, the
+// routing table references its VPC, which scopes its name, and embeds its
+// routes rather than references to them.
+func (s *Server) newRoutingTable(vpc object, name string) object {
+	rt := s.newVPCResource("routing_tables", "routing_table", name, nil)
+	delete(rt, "resource_group")
+	rt["href"] = s.href("vpcs/" + vpc["id"].(string) + "/routing_tables/" + rt["id"].(string))
+	rt["vpc"] = reference(vpc)
+	rt["is_default"] = false
+	rt["lifecycle_state"] = "stable"
+	rt["routes"] = []object{}
+	rt["subnets"] = []object{}
+	rt["accept_routes_from"] = []object{}
+	rt["advertise_routes_to"] = []string{}
+	return rt
+}
+
+func (s *Server) createRoutingTable(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name   string   `json:"name"`
+		Routes []object `json:"routes"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vpcID := r.PathValue("vpc_id")
+	vpc, ok := s.collection("vpcs")[vpcID]
+	if !ok {
+		notFound(w, "VPC", vpcID)
+		return
+	}
+	if req.Name == "" {
+		req.Name = s.newID("rt-")
+	}
+	rt := s.newRoutingTable(vpc, req.Name)
+	if s.nameInUse("routing_tables", rt, req.Name) {
+		writeError(w, http.StatusConflict, "validation_unique_failed", "Routing table name %s is already in use", req.Name)
+		return
+	}
+	for _, route := range req.Routes {
+		if _, err := s.addRoute(rt, route); err != nil {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+			return
+		}
+	}
+	s.collection("routing_tables")[rt["id"].(string)] = rt
+	writeJSON(w, http.StatusCreated, rt)
+}
+
+// deleteRoutingTable deletes a routing table, unless it is the default
+// routing table of its VPC.
+func (s *Server) deleteRoutingTable(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	rt, ok := s.collection("routing_tables")[id]
+	if !ok || field(rt, "vpc.id") != r.PathValue("vpc_id") {
+		notFound(w, "Routing table", id)
+		return
+	}
+	if rt["is_default"] == true {
+		writeError(w, http.StatusConflict, "routing_table_in_use", "The routing table %s is the default routing table of its VPC", id)
+		return
+	}
+	s.remove("routing_tables", rt)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// addRoute completes a route of a request and adds it to rt.
+func (s *Server) addRoute(rt object, route object) (object, error) {
+	name, _ := route["name"].(string)
+	if name == "" {
+		name = s.newID("route-")
+	}
+	for _, other := range rt["routes"].([]object) {
+		if other["name"] == name {
+			return nil, fmt.Errorf("Route name %s is already in use", name)
+		}
+	}
+	zone, _ := field(route, "zone.name").(string)
+	if zone == "" || route["destination"] == nil {
+		return nil, fmt.Errorf("The destination and the zone of a route are required")
+	}
+	id := s.newID("r006-")
+	created := object{
+		"id":              id,
+		"href":            strings.TrimSuffix(rt["href"].(string), "/") + "/routes/" + id,
+		"name":            name,
+		"action":          "deliver",
+		"advertise":       false,
+		"priority":        2,
+		"origin":          "user",
+		"lifecycle_state": "stable",
+		"created_at":      now(),
+	}
+	merge(created, route)
+	created["name"] = name
+	created["zone"] = object{"name": zone, "href": s.href("regions/" + Region + "/zones/" + zone)}
+	if created["action"] != "deliver" {
+		created["next_hop"] = object{"address": "0.0.0.0"}
+	} else if _, ok := created["next_hop"]; !ok {
+		return nil, fmt.Errorf("The next hop of a route that delivers packets is required")
+	}
+	rt["routes"] = append(rt["routes"].([]object), created)
+	return created, nil
+}
+
+// AddRoute adds a route to the routing table id, as if it was added outside
+// of Terraform, e.g. in the console.
+func (s *Server) AddRoute(id string, route map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, ok := s.collection("routing_tables")[id]
+	if !ok {
+		return fmt.Errorf("Routing table %s not found", id)
+	}
+	_, err := s.addRoute(rt, route)
+	return err
+}
+
+func (s *Server) listRoutes(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	rt, ok := s.collection("routing_tables")[id]
+	if !ok {
+		notFound(w, "Routing table", id)
+		return
+	}
+	routes := rt["routes"].([]object)
+	writeJSON(w, http.StatusOK, object{
+		"routes":      routes,
+		"limit":       50,
+		"total_count": len(routes),
+		"first":       object{"href": rt["href"].(string) + "/routes?limit=50"},
+	})
+}
+
+func (s *Server) createRoute(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var route object
+	if err := decode(r, &route); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	rt, ok := s.collection("routing_tables")[id]
+	if !ok {
+		notFound(w, "Routing table", id)
+		return
+	}
+	created, err := s.addRoute(rt, route)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// route returns a handler that finds a route of a routing table and passes
+// it to handle, or writes it when handle is nil.
+func (s *Server) route(handle func(w http.ResponseWriter, r *http.Request, rt object, i int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, routeID := r.PathValue("id"), r.PathValue("route_id")
+		rt, ok := s.collection("routing_tables")[id]
+		if !ok {
+			notFound(w, "Routing table", id)
+			return
+		}
+		for i, route := range rt["routes"].([]object) {
+			if route["id"] == routeID {
+				if handle == nil {
+					writeJSON(w, http.StatusOK, route)
+				} else {
+					handle(w, r, rt, i)
+				}
+				return
+			}
+		}
+		notFound(w, "Route", routeID)
+	}
+}
+
+func (s *Server) updateRoute(w http.ResponseWriter, r *http.Request, rt object, i int) {
+	var patch object
+	if err := decode(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	for key := range patch {
+		if !slices.Contains([]string{"advertise", "name", "next_hop", "priority"}, key) {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s cannot be updated", key)
+			return
+		}
+	}
+	routes := rt["routes"].([]object)
+	if name, ok := patch["name"].(string); ok {
+		for j, other := range routes {
+			if j != i && other["name"] == name {
+				writeError(w, http.StatusConflict, "validation_unique_failed", "Route name %s is already in use", name)
+				return
+			}
+		}
+	}
+	route := routes[i]
+	if _, ok := patch["next_hop"]; ok {
+		// The next hop of a route is either an address or a VPN gateway
+		// connection, it is replaced rather than merged.
+		route["next_hop"] = patch["next_hop"]
+		delete(patch, "next_hop")
+	}
+	merge(route, patch)
+	writeJSON(w, http.StatusOK, route)
+}
+
+func (s *Server) deleteRoute(w http.ResponseWriter, r *http.Request, rt object, i int) {
+	routes := rt["routes"].([]object)
+	rt["routes"] = append(routes[:i:i], routes[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddInstanceTemplate adds an instance template, as if it was created
+// outside of Terraform, and returns its ID. The instance group members that
+// are created from a template that is not healthy fail, e.g. because their
+// image does not boot.
+func (s *Server) AddInstanceTemplate(name string, healthy bool) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template := s.newVPCResource("instance/templates", "instance_template", name, nil)
+	delete(template, "resource_group")
+	template["healthy"] = healthy
+	s.collection("instance_templates")[template["id"].(string)] = template
+	return template["id"].(string)
+}
+
+func (s *Server) createInstanceGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name             string `json:"name"`
+		MembershipCount  int    `json:"membership_count"`
+		ApplicationPort  *int   `json:"application_port"`
+		InstanceTemplate struct {
+			ID string `json:"id"`
+		} `json:"instance_template"`
+		Subnets []struct {
+			ID string `json:"id"`
+		} `json:"subnets"`
+		ResourceGroup struct {
+			ID string `json:"id"`
+		} `json:"resource_group"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.collection("instance_templates")[req.InstanceTemplate.ID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Instance template %s not found", req.InstanceTemplate.ID)
+		return
+	}
+	if len(req.Subnets) == 0 {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "The subnets of an instance group are required")
+		return
+	}
+	subnets := []object{}
+	var vpc interface{}
+	for _, ref := range req.Subnets {
+		subnet, ok := s.collection("subnets")[ref.ID]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Subnet %s not found", ref.ID)
+			return
+		}
+		subnets = append(subnets, reference(subnet))
+		vpc = subnet["vpc"]
+	}
+	resourceGroup, err := s.resourceGroupReference(req.ResourceGroup.ID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s", err)
+		return
+	}
+	if req.Name == "" {
+		req.Name = s.newID("ig-")
+	}
+	group := s.newVPCResource("instance_groups", "instance_group", req.Name, resourceGroup)
+	delete(group, "resource_type")
+	group["vpc"] = vpc
+	if s.nameInUse("instance_groups", group, req.Name) {
+		writeError(w, http.StatusConflict, "validation_unique_failed", "Instance group name %s is already in use", req.Name)
+		return
+	}
+	group["instance_template"] = reference(template)
+	group["subnets"] = subnets
+	group["managers"] = []object{}
+	group["membership_count"] = 0
+	group["status"] = "healthy"
+	if req.ApplicationPort != nil {
+		group["application_port"] = *req.ApplicationPort
+	}
+	s.collection("instance_groups")[group["id"].(string)] = group
+	s.scaleInstanceGroup(group, req.MembershipCount)
+	writeJSON(w, http.StatusCreated, group)
+}
+
+// updateInstanceGroup updates an instance group, and creates or deletes
+// members when its membership count changes. The new members are created
+// from the instance template of the group after the update.
+func (s *Server) updateInstanceGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	var req struct {
+		Name             *string `json:"name"`
+		MembershipCount  *int    `json:"membership_count"`
+		InstanceTemplate *struct {
+			ID string `json:"id"`
+		} `json:"instance_template"`
+		Subnets []struct {
+			ID string `json:"id"`
+		} `json:"subnets"`
+	}
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	group, ok := s.collection("instance_groups")[id]
+	if !ok {
+		notFound(w, "Instance group", id)
+		return
+	}
+	if req.Name != nil && *req.Name != group["name"] {
+		if s.nameInUse("instance_groups", group, *req.Name) {
+			writeError(w, http.StatusConflict, "validation_unique_failed", "Instance group name %s is already in use", *req.Name)
+			return
+		}
+		group["name"] = *req.Name
+	}
+	if req.InstanceTemplate != nil {
+		template, ok := s.collection("instance_templates")[req.InstanceTemplate.ID]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Instance template %s not found", req.InstanceTemplate.ID)
+			return
+		}
+		group["instance_template"] = reference(template)
+	}
+	if req.Subnets != nil {
+		subnets := []object{}
+		for _, ref := range req.Subnets {
+			subnet, ok := s.collection("subnets")[ref.ID]
+			if !ok {
+				writeError(w, http.StatusBadRequest, "validation_invalid_argument", "Subnet %s not found", ref.ID)
+				return
+			}
+			subnets = append(subnets, reference(subnet))
+		}
+		group["subnets"] = subnets
+	}
+	if req.MembershipCount != nil {
+		s.scaleInstanceGroup(group, *req.MembershipCount)
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+// scaleInstanceGroup creates members of group from its instance template, or
+// deletes its newest members, until it has count members.
+func (s *Server) scaleInstanceGroup(group object, count int) {
+	members := s.instanceGroupMembers(group["id"].(string))
+	for i := len(members) - 1; i >= count; i-- {
+		delete(s.collection("instance_group_memberships"), members[i]["id"].(string))
+	}
+	template := s.collection("instance_templates")[field(group, "instance_template.id").(string)]
+	for i := len(members); i < count; i++ {
+		id := s.newID("r006-")
+		instanceID := s.newID("0717_")
+		status := "healthy"
+		if template["healthy"] != true {
+			status = "failed"
+		}
+		s.collection("instance_group_memberships")[id] = object{
+			"id":                                   id,
+			"href":                                 s.href("instance_groups/" + group["id"].(string) + "/memberships/" + id),
+			"name":                                 group["name"].(string) + "-membership-" + id[5:13],
+			"instance_group":                       object{"id": group["id"]},
+			"instance_template":                    reference(template),
+			"instance":                             object{"id": instanceID, "crn": crn("is", Region+"-1", "instance", instanceID), "href": s.href("instances/" + instanceID), "name": group["name"].(string) + "-" + id[5:13]},
+			"delete_instance_on_membership_delete": false,
+			"status":                               status,
+			"created_at":                           now(),
+		}
+	}
+	group["membership_count"] = count
+}
+
+// instanceGroupMembers returns the members of the instance group id, sorted
+// by creation.
+func (s *Server) instanceGroupMembers(id string) []object {
+	return s.list("instance_group_memberships", func(obj object) bool { return field(obj, "instance_group.id") == id })
+}
+
+// deleteInstanceGroup deletes an instance group along with its members.
+func (s *Server) deleteInstanceGroup(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	group, ok := s.collection("instance_groups")[id]
+	if !ok {
+		notFound(w, "Instance group", id)
+		return
+	}
+	s.scaleInstanceGroup(group, 0)
+	s.remove("instance_groups", group)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listInstanceGroupManagers lists the managers of an instance group. The
+// server does not implement managers, so there are none.
+func (s *Server) listInstanceGroupManagers(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.collection("instance_groups")[id]; !ok {
+		notFound(w, "Instance group", id)
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"managers":    []object{},
+		"limit":       50,
+		"total_count": 0,
+		"first":       object{"href": s.href("instance_groups/" + id + "/managers?limit=50")},
+	})
+}
+
+func (s *Server) listInstanceGroupMemberships(w http.ResponseWriter, r *http.Request) {
+	if !authorized(w, r) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.collection("instance_groups")[id]; !ok {
+		notFound(w, "Instance group", id)
+		return
+	}
+	members := s.instanceGroupMembers(id)
+	writeJSON(w, http.StatusOK, object{
+		"memberships": members,
+		"limit":       50,
+		"total_count": len(members),
+		"first":       object{"href": s.href("instance_groups/" + id + "/memberships?limit=50")},
+	})
+}
+
+// instanceGroupMembership returns a handler that finds a member of an
+// instance group and passes it to handle, or writes it when handle is nil.
+func (s *Server) instanceGroupMembership(handle func(w http.ResponseWriter, r *http.Request, group, membership object)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, membershipID := r.PathValue("id"), r.PathValue("membership_id")
+		group, ok := s.collection("instance_groups")[id]
+		if !ok {
+			notFound(w, "Instance group", id)
+			return
+		}
+		membership, ok := s.collection("instance_group_memberships")[membershipID]
+		if !ok || field(membership, "instance_group.id") != id {
+			notFound(w, "Instance group membership", membershipID)
+			return
+		}
+		if handle == nil {
+			writeJSON(w, http.StatusOK, membership)
+		} else {
+			handle(w, r, group, membership)
+		}
+	}
+}
+
+func (s *Server) updateInstanceGroupMembership(w http.ResponseWriter, r *http.Request, group, membership object) {
+	var patch object
+	if err := decode(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", "Error parsing the request: %s", err)
+		return
+	}
+	for key := range patch {
+		if key != "name" && key != "delete_instance_on_membership_delete" {
+			writeError(w, http.StatusBadRequest, "validation_invalid_argument", "%s cannot be updated", key)
+			return
+		}
+	}
+	merge(membership, patch)
+	writeJSON(w, http.StatusOK, membership)
+}
+
+// deleteInstanceGroupMembership deletes a member of an instance group, which
+// decreases the membership count of the group by one.
+func (s *Server) deleteInstanceGroupMembership(w http.ResponseWriter, r *http.Request, group, membership object) {
+	delete(s.collection("instance_group_memberships"), membership["id"].(string))
+	group["membership_count"] = group["membership_count"].(int) - 1
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,5 @@
+resource "ibm_appid_theme_text" "test" {
+  tenant_id = "${env.IBM_APPID_TENANT_ID}"
+  tab_title = "import fixture title"
+  footnote  = "import fixture footnote"
+}
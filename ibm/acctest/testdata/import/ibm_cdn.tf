@@ -0,0 +1,6 @@
+resource "ibm_cdn" "test" {
+  host_name      = "${env.IBM_CDN_HOST_NAME}"
+  vendor_name    = "akamai"
+  origin_address = "${env.IBM_CDN_ORIGIN_ADDRESS}"
+  origin_type    = "HOST_SERVER"
+}
@@ -0,0 +1,23 @@
+data "ibm_resource_group" "cis" {
+  name = "${env.IBM_CIS_RESOURCE_GROUP}"
+}
+
+data "ibm_cis" "cis" {
+  resource_group_id = data.ibm_resource_group.cis.id
+  name              = "${env.IBM_CIS_INSTANCE}"
+}
+
+resource "ibm_cis_custom_list" "list" {
+  cis_id = data.ibm_cis.cis.id
+  kind   = "ip"
+  name   = "import_fixture_list"
+}
+
+resource "ibm_cis_custom_list_items" "test" {
+  cis_id  = data.ibm_cis.cis.id
+  list_id = ibm_cis_custom_list.list.list_id
+  items {
+    ip      = "172.64.0.1"
+    comment = "import fixture"
+  }
+}
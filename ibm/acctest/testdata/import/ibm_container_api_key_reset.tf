@@ -0,0 +1,6 @@
+# import_state_id: {id}/{reset_api_key}
+resource "ibm_container_api_key_reset" "test" {
+  region            = "${env.IBM_CONTAINER_REGION}"
+  resource_group_id = "${env.IBM_CONTAINER_RESOURCE_GROUP_ID}"
+  reset_api_key     = 2
+}
@@ -0,0 +1,11 @@
+# import_state_verify_ignore: resource_group_id
+# import_state_id: {id}/{nlb_host}
+data "ibm_container_nlb_dns" "dns" {
+  cluster = "${env.IBM_CONTAINER_CLUSTER_NAME}"
+}
+
+resource "ibm_container_nlb_dns" "test" {
+  cluster  = data.ibm_container_nlb_dns.dns.cluster
+  nlb_host = data.ibm_container_nlb_dns.dns.nlb_config.0.nlb_sub_domain
+  nlb_ips  = data.ibm_container_nlb_dns.dns.nlb_config.0.nlb_ips
+}
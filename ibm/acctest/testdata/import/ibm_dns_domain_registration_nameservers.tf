@@ -0,0 +1,9 @@
+# import_state_verify_ignore: original_name_servers
+data "ibm_dns_domain_registration" "domain" {
+  name = "${env.IBM_DNS_DOMAIN_REGISTRATION_NAME}"
+}
+
+resource "ibm_dns_domain_registration_nameservers" "test" {
+  dns_registration_id = data.ibm_dns_domain_registration.domain.id
+  name_servers        = ["ns1.softlayer.com", "ns2.softlayer.com"]
+}
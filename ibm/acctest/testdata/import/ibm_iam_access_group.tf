@@ -0,0 +1,4 @@
+resource "ibm_iam_access_group" "test" {
+  name        = "tf-import-fixture-group"
+  description = "import fixture"
+}
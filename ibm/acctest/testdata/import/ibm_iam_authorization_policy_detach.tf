@@ -0,0 +1,3 @@
+resource "ibm_iam_authorization_policy_detach" "test" {
+  authorization_policy_id = "${env.IBM_IAM_AUTHORIZATION_POLICY_ID}"
+}
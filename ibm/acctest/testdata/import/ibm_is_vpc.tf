@@ -0,0 +1,3 @@
+resource "ibm_is_vpc" "test" {
+  name = "tf-import-fixture-vpc"
+}
@@ -0,0 +1,22 @@
+data "ibm_security_group" "allowssh" {
+  name = "allow_ssh"
+}
+
+resource "ibm_compute_vm_instance" "vm" {
+  hostname             = "tf-import-fixture"
+  domain               = "tfimportfixture.com"
+  os_reference_code    = "DEBIAN_9_64"
+  datacenter           = "wdc07"
+  network_speed        = 10
+  hourly_billing       = true
+  private_network_only = false
+  cores                = 1
+  memory               = 1024
+  disks                = [25]
+  local_disk           = false
+}
+
+resource "ibm_network_interface_sg_attachment" "test" {
+  security_group_id    = data.ibm_security_group.allowssh.id
+  network_interface_id = ibm_compute_vm_instance.vm.public_interface_id
+}
@@ -0,0 +1,6 @@
+# import_state_id: {id}/{pi_language_code}
+resource "ibm_pi_console_language" "test" {
+  pi_cloud_instance_id = "${env.PI_CLOUDINSTANCE_ID}"
+  pi_instance_name     = "${env.PI_INSTANCE_NAME}"
+  pi_language_code     = "037"
+}
@@ -0,0 +1,5 @@
+# import_state_verify_ignore: comment
+resource "ibm_resource_reclamation_delete" "test" {
+  reclamation_id = "${env.IBM_RECLAMATION_ID}"
+  comment        = "import fixture"
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/vcr"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const (
+	// VCRModeEnv selects whether the acceptance tests record their API
+	// traffic ("record") or replay it offline ("replay").
+	VCRModeEnv = "IBMCLOUD_VCR_MODE"
+	// VCRCassetteDirEnv overrides the directory, relative to the package
+	// under test, that holds the recorded cassettes.
+	VCRCassetteDirEnv = "IBMCLOUD_VCR_CASSETTE_DIR"
+	// DefaultCassetteDir is the directory that holds the recorded cassettes.
+	DefaultCassetteDir = "testdata/cassettes"
+)
+
+// vcrPlaceholderEnv are the credentials that TestAccPreCheck requires. They
+// are only exchanged for tokens that the cassettes replay, so placeholders
+// are enough in replay mode.
+var vcrPlaceholderEnv = []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"}
+
+// testAccRecorder records or replays the API traffic of the acceptance tests
+// when IBMCLOUD_VCR_MODE is set.
+var testAccRecorder *vcr.Recorder
+
+func init() {
+	mode, err := vcr.ParseMode(os.Getenv(VCRModeEnv))
+	if err != nil {
+		log.Printf("[WARN] %s, running the acceptance tests against the API", err)
+		return
+	}
+	if mode == vcr.ModeLive {
+		return
+	}
+
+	testAccRecorder = vcr.NewRecorder(mode, cassetteDir())
+	conns.AcceptanceTestTransport = testAccRecorder.Wrap
+
+	if mode == vcr.ModeReplay {
+		for _, env := range vcrPlaceholderEnv {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, vcr.Redacted)
+			}
+		}
+	}
+}
+
+// cassetteDir returns the directory that holds the recorded cassettes.
+func cassetteDir() string {
+	if dir := os.Getenv(VCRCassetteDirEnv); dir != "" {
+		return dir
+	}
+	return DefaultCassetteDir
+}
+
+// UseCassette records the API traffic of t to its cassette, or replays it
+// from there, when IBMCLOUD_VCR_MODE is set. TestAccPreCheck calls it, so
+// only tests that use another pre-check need to call it themselves. The
+// cassette is saved when t passes; tests that use it cannot run in parallel.
+func UseCassette(t *testing.T) {
+	if testAccRecorder == nil {
+		return
+	}
+	if err := testAccRecorder.Start(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := testAccRecorder.Stop(t.Failed()); err != nil {
+			t.Errorf("Error saving the cassette of %s: %s", t.Name(), err)
+		}
+	})
+}
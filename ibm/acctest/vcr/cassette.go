@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vcr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// Cassette is the recorded API traffic of one acceptance test.
+type Cassette struct {
+	Name         string         `json:"name"`
+	Interactions []*Interaction `json:"interactions"`
+
+	used []bool
+}
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request, with its secrets scrubbed.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response, with its secrets scrubbed.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a request or response body. Bodies that are not valid UTF-8 are
+// stored base64 encoded.
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal("base64:" + base64.StdEncoding.EncodeToString(b))
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if encoded, ok := strings.CutPrefix(s, "base64:"); ok {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return err
+		}
+		*b = decoded
+		return nil
+	}
+	*b = Body(s)
+	return nil
+}
+
+// CassettePath returns the file that holds the cassette of the test name in
+// dir. Subtests are stored next to their parent, e.g. TestAccX/step becomes
+// TestAccX_step.json.
+func CassettePath(dir, name string) string {
+	return filepath.Join(dir, strings.ReplaceAll(name, "/", "_")+".json")
+}
+
+// LoadCassette reads the cassette of the test name from dir.
+func LoadCassette(dir, name string) (*Cassette, error) {
+	data, err := os.ReadFile(CassettePath(dir, name))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the cassette of %s: %s", name, err)
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the cassette of %s: %s", name, err)
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+	return cassette, nil
+}
+
+// Save writes the cassette to dir.
+func (c *Cassette) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(CassettePath(dir, c.Name), append(data, '\n'), 0644)
+}
+
+// match returns the first unused interaction that answers req, which must
+// already be scrubbed. Requests are matched on their method, URL and body
+// when possible. Because tests name their resources randomly, the body and
+// then the query string are dropped from the comparison when nothing matches
+// exactly. Token exchanges may be answered repeatedly, as the number of times
+// the credentials are refreshed depends on the order the tests run in.
+func (c *Cassette) match(req Request) *Interaction {
+	matchers := []func(Request, Request) bool{
+		func(a, b Request) bool {
+			return a.Method == b.Method && a.URL == b.URL && string(a.Body) == string(b.Body)
+		},
+		func(a, b Request) bool { return a.Method == b.Method && a.URL == b.URL },
+		func(a, b Request) bool { return a.Method == b.Method && urlPath(a.URL) == urlPath(b.URL) },
+	}
+	for _, matches := range matchers {
+		for i, interaction := range c.Interactions {
+			if !c.used[i] && matches(interaction.Request, req) {
+				c.used[i] = true
+				return interaction
+			}
+		}
+	}
+	if isTokenRequest(req.URL) {
+		for i := len(c.Interactions) - 1; i >= 0; i-- {
+			if interaction := c.Interactions[i]; interaction.Request.Method == req.Method && urlPath(interaction.Request.URL) == urlPath(req.URL) {
+				return interaction
+			}
+		}
+	}
+	return nil
+}
+
+func urlPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Scheme + "://" + u.Host + u.Path
+}
+
+// isTokenRequest reports whether rawURL is an IAM token endpoint.
+func isTokenRequest(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && strings.HasSuffix(u.Path, "/identity/token")
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package vcr records the API traffic of the acceptance tests to cassettes
+// and replays it, so that recorded tests run offline and deterministically.
+package vcr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Recorder talks to the API.
+type Mode string
+
+const (
+	// ModeLive sends the requests to the API and records nothing.
+	ModeLive Mode = ""
+	// ModeRecord sends the requests to the API and records them.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests from the cassettes and never reaches
+	// the API.
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode parses the value of the IBMCLOUD_VCR_MODE environment variable.
+func ParseMode(mode string) (Mode, error) {
+	switch m := Mode(strings.ToLower(mode)); m {
+	case ModeLive, ModeRecord, ModeReplay:
+		return m, nil
+	}
+	return ModeLive, fmt.Errorf("[ERROR] Unknown VCR mode %q, expected %q or %q", mode, ModeRecord, ModeReplay)
+}
+
+// Recorder records or replays the cassette of the test that is running.
+// A single cassette is active at a time, so the tests that use a Recorder
+// cannot run in parallel.
+type Recorder struct {
+	mode Mode
+	dir  string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// auth holds the last token exchange recorded. The credentials are only
+	// exchanged once per provider configuration, so the exchange is copied
+	// into every cassette recorded afterwards to let them replay on their own.
+	auth *Interaction
+}
+
+// NewRecorder returns a Recorder that keeps its cassettes in dir.
+func NewRecorder(mode Mode, dir string) *Recorder {
+	return &Recorder{mode: mode, dir: dir}
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Start makes the cassette of the test name active. In replay mode the
+// cassette must have been recorded before.
+func (r *Recorder) Start(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		if r.cassette.Name == name {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] The cassette of %s is still in use; tests cannot run in parallel in %s mode", r.cassette.Name, r.mode)
+	}
+
+	switch r.mode {
+	case ModeRecord:
+		r.cassette = &Cassette{Name: name}
+		if r.auth != nil {
+			r.cassette.Interactions = append(r.cassette.Interactions, r.auth)
+		}
+	case ModeReplay:
+		cassette, err := LoadCassette(r.dir, name)
+		if err != nil {
+			return err
+		}
+		r.cassette = cassette
+	}
+	return nil
+}
+
+// Stop deactivates the cassette of the running test. In record mode the
+// cassette is saved, unless discard is set, e.g. because the test failed.
+func (r *Recorder) Stop(discard bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette := r.cassette
+	r.cassette = nil
+	if cassette == nil || r.mode != ModeRecord || discard {
+		return nil
+	}
+	return cassette.Save(r.dir)
+}
+
+// Wrap returns a transport that records the requests sent through next, or
+// answers them from the active cassette.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	if r.mode == ModeLive {
+		return next
+	}
+	return &transport{recorder: r, next: next}
+}
+
+type transport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.recorder.mode == ModeReplay {
+		return t.recorder.replay(req, recorded)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.recorder.record(&Interaction{
+		Request: recorded,
+		Response: scrubResponse(Response{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header,
+			Body:       body,
+		}),
+	})
+	return resp, nil
+}
+
+func (r *Recorder) record(interaction *Interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if isTokenRequest(interaction.Request.URL) && interaction.Response.StatusCode == http.StatusOK {
+		r.auth = interaction
+	}
+	if r.cassette == nil {
+		log.Printf("[WARN] Not recording %s %s, no test is running", interaction.Request.Method, interaction.Request.URL)
+		return
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("[ERROR] Cannot replay %s %s, no test is running", recorded.Method, recorded.URL)
+	}
+	interaction := r.cassette.match(recorded)
+	if interaction == nil {
+		return nil, fmt.Errorf("[ERROR] The cassette of %s has no recorded response for %s %s", r.cassette.Name, recorded.Method, recorded.URL)
+	}
+
+	resp := interaction.Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Headers.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// newRequest captures the scrubbed form of req, restoring its body so that
+// it can still be sent.
+func newRequest(req *http.Request) (Request, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = readBody(&req.Body); err != nil {
+			return Request{}, err
+		}
+	}
+	return scrubRequest(Request{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: req.Header,
+		Body:    body,
+	}), nil
+}
+
+// readBody reads all of *body and replaces it with an unread copy.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vcr
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// Redacted replaces the secrets scrubbed from a cassette.
+const Redacted = conns.Redacted
+
+// scrubRequest removes the secrets from a request before it is recorded or
+// matched against a cassette.
+func scrubRequest(req Request) Request {
+	req.Headers = conns.RedactHeaders(req.Headers)
+	req.URL = conns.RedactURL(req.URL)
+	req.Body = scrubBody(req.Headers.Get("Content-Type"), req.Body)
+	return req
+}
+
+// scrubResponse removes the secrets from a response before it is recorded.
+func scrubResponse(resp Response) Response {
+	resp.Headers = conns.RedactHeaders(resp.Headers)
+	resp.Body = scrubBody(resp.Headers.Get("Content-Type"), resp.Body)
+	return resp
+}
+
+func scrubBody(contentType string, body Body) Body {
+	return Body(conns.RedactBody(contentType, body))
+}
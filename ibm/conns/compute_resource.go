@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// ComputeResourceVPCInstance authenticates with the identity token of the
+	// VPC instance that the provider runs on, from its metadata service.
+	ComputeResourceVPCInstance = "vpc_instance"
+
+	// ComputeResourceContainer authenticates with the compute resource token
+	// that Kubernetes projects into the pod that the provider runs in.
+	ComputeResourceContainer = "container"
+)
+
+// ComputeResources are the compute resources the provider can authenticate
+// as.
+var ComputeResources = []string{ComputeResourceVPCInstance, ComputeResourceContainer}
+
+// newComputeResourceAuthenticator returns the authenticator that exchanges
+// the token of the compute resource of c for an IAM token of its trusted
+// profile, or nil when c does not authenticate as a compute resource.
+func newComputeResourceAuthenticator(c *Config, iamURL string, middleware *transportMiddleware) (core.Authenticator, error) {
+	var authenticator core.Authenticator
+	switch c.IAMComputeResource {
+	case "":
+		return nil, nil
+	case ComputeResourceVPCInstance:
+		if c.IAMTrustedProfileName != "" {
+			return nil, fmt.Errorf("[ERROR] A VPC instance cannot assume the trusted profile %q by name, use its ID or CRN", c.IAMTrustedProfileName)
+		}
+		authenticator = &core.VpcInstanceAuthenticator{
+			IAMProfileCRN: c.IAMTrustedProfileCRN,
+			IAMProfileID:  c.IAMTrustedProfileID,
+			Client:        newHTTPClient(middleware, "IAMAuthenticator", 0),
+		}
+	case ComputeResourceContainer:
+		if c.IAMTrustedProfileCRN != "" {
+			return nil, fmt.Errorf("[ERROR] A container cannot assume the trusted profile %q by CRN, use its ID or name", c.IAMTrustedProfileCRN)
+		}
+		authenticator = &core.ContainerAuthenticator{
+			CRTokenFilename: c.IAMComputeResourceTokenFile,
+			IAMProfileID:    c.IAMTrustedProfileID,
+			IAMProfileName:  c.IAMTrustedProfileName,
+			URL:             iamURL,
+			Client:          newHTTPClient(middleware, "IAMAuthenticator", 0),
+		}
+	default:
+		return nil, fmt.Errorf("[ERROR] Unsupported compute resource %q, expected one of %s", c.IAMComputeResource, strings.Join(ComputeResources, ", "))
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error configuring the %s authentication: %s", c.IAMComputeResource, err)
+	}
+	return authenticator, nil
+}
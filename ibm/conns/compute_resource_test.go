@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestNewComputeResourceAuthenticator(t *testing.T) {
+	for name, c := range map[string]Config{
+		"VPC instance with a profile name": {IAMComputeResource: ComputeResourceVPCInstance, IAMTrustedProfileName: "runner"},
+		"container without a profile":      {IAMComputeResource: ComputeResourceContainer},
+		"container with a profile CRN":     {IAMComputeResource: ComputeResourceContainer, IAMTrustedProfileCRN: "crn:v1:bluemix:public:iam-identity::a/0123::profile:Profile-1"},
+		"unknown compute resource":         {IAMComputeResource: "code_engine"},
+	} {
+		if _, err := newComputeResourceAuthenticator(&c, IAMURL, nil); err == nil {
+			t.Errorf("Expected an error for a %s", name)
+		}
+	}
+
+	if authenticator, err := newComputeResourceAuthenticator(&Config{}, IAMURL, nil); authenticator != nil || err != nil {
+		t.Errorf("Expected no authenticator without a compute resource, got %v, %v", authenticator, err)
+	}
+
+	authenticator, err := newComputeResourceAuthenticator(&Config{
+		IAMComputeResource:  ComputeResourceVPCInstance,
+		IAMTrustedProfileID: "Profile-1",
+	}, IAMURL, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if vpcAuthenticator, ok := authenticator.(*core.VpcInstanceAuthenticator); !ok || vpcAuthenticator.IAMProfileID != "Profile-1" {
+		t.Errorf("Expected a VPC instance authenticator of Profile-1, got %#v", authenticator)
+	}
+
+	authenticator, err = newComputeResourceAuthenticator(&Config{
+		IAMComputeResource:          ComputeResourceContainer,
+		IAMTrustedProfileName:       "runner",
+		IAMComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}, "https://private.iam.cloud.ibm.com", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	containerAuthenticator, ok := authenticator.(*core.ContainerAuthenticator)
+	if !ok || containerAuthenticator.IAMProfileName != "runner" || containerAuthenticator.CRTokenFilename != "/var/run/secrets/tokens/sa-token" {
+		t.Errorf("Expected a container authenticator of runner, got %#v", authenticator)
+	}
+	if containerAuthenticator != nil && containerAuthenticator.URL != "https://private.iam.cloud.ibm.com" {
+		t.Errorf("Expected the container authenticator to use the IAM endpoint of the visibility, got %s", containerAuthenticator.URL)
+	}
+}
+
+func TestClientSessionVPCInstanceAuthentication(t *testing.T) {
+	for _, env := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+		"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+		"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH", "TF_LOG"} {
+		t.Setenv(env, "")
+	}
+	token := testIAMToken(t)
+	tokenResponse := func(accessToken string) string {
+		now := time.Now().UTC()
+		response, _ := json.Marshal(map[string]interface{}{
+			"access_token": accessToken,
+			"created_at":   now.Format(time.RFC3339),
+			"expires_at":   now.Add(time.Hour).Format(time.RFC3339),
+			"expires_in":   3600,
+		})
+		return string(response)
+	}
+	var vpcAuthorization string
+	AcceptanceTestTransport = func(next gohttp.RoundTripper) gohttp.RoundTripper {
+		return roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+			var body string
+			switch {
+			case req.URL.Path == "/instance_identity/v1/token":
+				body = tokenResponse("instance-identity-token")
+			case req.URL.Path == "/instance_identity/v1/iam_token":
+				if req.Header.Get("Authorization") != "Bearer instance-identity-token" {
+					return nil, fmt.Errorf("unexpected authorization %q", req.Header.Get("Authorization"))
+				}
+				requestBody, _ := io.ReadAll(req.Body)
+				if !strings.Contains(string(requestBody), "Profile-1") {
+					return nil, fmt.Errorf("expected the trusted profile in %s", requestBody)
+				}
+				body = tokenResponse(token)
+			case strings.HasSuffix(req.URL.Path, "/v1/vpcs"):
+				vpcAuthorization = req.Header.Get("Authorization")
+				body = `{"vpcs": []}`
+			default:
+				body = `{}`
+			}
+			return &gohttp.Response{
+				StatusCode: gohttp.StatusOK,
+				Header:     gohttp.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		})
+	}
+	defer func() { AcceptanceTestTransport = nil }()
+
+	c := &Config{
+		IAMComputeResource:  ComputeResourceVPCInstance,
+		IAMTrustedProfileID: "Profile-1",
+		Region:              "us-south",
+		BluemixTimeout:      time.Minute,
+		RetryDelay:          time.Millisecond,
+	}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring client session: %s", err)
+	}
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		t.Fatalf("Error getting the Bluemix session: %s", err)
+	}
+	if bxSession.Config.IAMAccessToken != "Bearer "+token {
+		t.Errorf("Expected the Bluemix session to use the IAM token of the trusted profile, got %q", bxSession.Config.IAMAccessToken)
+	}
+
+	vpcClient, err := meta.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("Error getting VPC client: %s", err)
+	}
+	if _, _, err := vpcClient.ListVpcs(&vpc.ListVpcsOptions{}); err != nil {
+		t.Fatalf("Error listing VPCs: %s", err)
+	}
+	if vpcAuthorization != "Bearer "+token {
+		t.Errorf("Expected the VPC client to use the IAM token of the trusted profile, got %q", vpcAuthorization)
+	}
+}
@@ -5,17 +5,15 @@ package conns
 
 import (
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
@@ -95,6 +93,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/common"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt/v5"
 	slsession "github.com/softlayer/softlayer-go/session"
 
@@ -207,6 +206,24 @@ type Config struct {
 	// TrustedProfileName
 	IAMTrustedProfileName string
 
+	// TrustedProfileCRN
+	IAMTrustedProfileCRN string
+
+	// IAMComputeResource authenticates as the compute resource that the
+	// provider runs on, one of ComputeResources, with its trusted profile
+	// instead of an API key or token.
+	IAMComputeResource string
+
+	// IAMComputeResourceTokenFile is the compute resource token of a
+	// container. It defaults to the token that IBM Cloud Kubernetes Service
+	// projects into pods.
+	IAMComputeResourceTokenFile string
+
+	// AssumeTrustedProfiles are assumed in turn after authenticating, each
+	// with the token of the one before it, e.g. a trusted profile of an
+	// enterprise account and then one of a child account.
+	AssumeTrustedProfiles []TrustedProfile
+
 	// Account
 	Account string
 
@@ -218,6 +235,29 @@ type Config struct {
 	Visibility          string
 	PrivateEndpointType string
 	EndpointsFile       string
+
+	// Endpoints are the URLs of the endpoints block of the provider, by the
+	// service names of EndpointServices. They override the endpoints file.
+	Endpoints map[string]string
+
+	// DefaultTags are attached to every resource that supports global
+	// tagging, in addition to its own tags.
+	DefaultTags DefaultTags
+
+	// HTTPTracePath is the file that a record of every HTTP call is appended
+	// to, in HTTPTraceFormat. Tracing is off when it is empty.
+	HTTPTracePath   string
+	HTTPTraceFormat string
+
+	// RateLimit limits the rate of the HTTP calls of the clients. There is
+	// no limit when it is nil.
+	RateLimit *RateLimit
+}
+
+// DefaultTags are the tags of the default_tags block of the provider.
+type DefaultTags struct {
+	Tags       []string
+	AccessTags []string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -338,11 +378,28 @@ type ClientSession interface {
 	LogsV0() (*logsv0.LogsV0, error)
 	SdsaasV1() (*sdsaasv1.SdsaasV1, error)
 	DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error)
+	DefaultTags() DefaultTags
+	HTTPClient(service string) *gohttp.Client
+	IAMAuthenticator() (core.Authenticator, error)
 }
 
 type clientSession struct {
 	session *Session
 
+	defaultTags DefaultTags
+
+	// authenticator is the IAM authenticator of the SDK clients, which
+	// refreshes their tokens.
+	authenticator core.Authenticator
+
+	// middleware wraps the transports of the clients, e.g. to trace their
+	// HTTP calls or to limit their rate.
+	middleware *transportMiddleware
+
+	// clients holds the deferred constructors of the service clients, keyed
+	// by the name of the ClientSession accessor that serves them.
+	clients map[string]*lazyClient
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -420,6 +477,9 @@ type clientSession struct {
 
 	kmsErr error
 	kmsAPI *kp.API
+	// kmsMu guards kmsErr, which KeyManagementAPI sets when it cannot
+	// rebuild the client.
+	kmsMu sync.Mutex
 
 	hpcsEndpointErr error
 	hpcsEndpointAPI hpcs.HPCSV2
@@ -722,65 +782,95 @@ type clientSession struct {
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.load("UsageReportsV4")
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.load("PartnerCenterSellV1")
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.load("ConfigurationAggregatorV1")
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.load("AppIDAPI")
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.load("CatalogManagementV1")
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.load("BluemixAcccountAPI")
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.load("BluemixAcccountv1API")
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
+// DefaultTags returns the tags that the provider attaches to every resource
+// that supports global tagging.
+func (sess *clientSession) DefaultTags() DefaultTags {
+	return sess.defaultTags
+}
+
+// HTTPClient returns a client for the SDKs that are built outside of
+// ClientSession, e.g. the COS S3 clients, that goes through the same
+// middleware as the other clients, or nil when there is none.
+func (sess *clientSession) HTTPClient(service string) *gohttp.Client {
+	return newHTTPClient(sess.middleware, service, 0)
+}
+
+// IAMAuthenticator returns the authenticator that the SDK clients get their
+// IAM tokens from.
+func (sess *clientSession) IAMAuthenticator() (core.Authenticator, error) {
+	return sess.authenticator, sess.bluemixSessionErr
+}
+
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.load("ContainerAPI")
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.load("VpcContainerAPI")
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.load("ContainerRegistryV1")
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.load("SchematicsV1")
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -789,122 +879,148 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.load("FunctionClient")
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.load("GlobalSearchAPI")
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.load("GlobalTaggingAPI")
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.load("GlobalTaggingAPIv1")
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.load("GlobalSearchAPIV2")
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.load("HpcsEndpointAPI")
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.load("UkoV4")
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.load("UserManagementAPI")
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.load("IAMPolicyManagementV1API")
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.load("IAMAccessGroupsV2")
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.load("IBMCloudShellV1")
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.load("ICDAPI")
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.load("CloudDatabasesV5")
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.load("Db2saasV1")
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.load("MccpAPI")
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.load("ResourceCatalogAPI")
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.load("ResourceManagementAPIv2")
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.load("ResourceControllerAPI")
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.load("ResourceControllerAPIV2")
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.load("PushServiceV1")
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.load("EventNotificationsApiV1")
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.load("AppConfigurationV1")
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.load("KeyProtectAPI")
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.load("KeyManagementAPI")
+	sess.kmsMu.Lock()
+	defer sess.kmsMu.Unlock()
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -923,59 +1039,70 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, wrapTransport(sess.middleware, "KeyManagementAPI", defaultTransport()))
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			sess.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, sess.kmsErr
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.load("VpcV1API")
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.load("VpcV1BetaAPI")
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.load("DirectlinkV1API")
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.load("DirectlinkProviderV2API")
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.load("CosConfigV1API")
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.load("TransitGatewayV1API")
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.load("IBMPISession")
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.load("PrivateDNSClientSession")
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.load("FunctionIAMNamespaceAPI")
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.load("CisZonesV1ClientSession")
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -983,7 +1110,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.load("CisDNSRecordClientSession")
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -991,7 +1119,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.load("CisDNSRecordBulkClientSession")
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -999,7 +1128,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.load("CisGLBPoolClientSession")
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -1007,7 +1137,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.load("CisGLBClientSession")
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -1015,7 +1146,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.load("CisGLBHealthCheckClientSession")
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -1023,7 +1155,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.load("CisRLClientSession")
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -1031,7 +1164,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.load("CisIPClientSession")
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -1039,7 +1173,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.load("CisPageRuleClientSession")
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1047,7 +1182,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.load("CisEdgeFunctionClientSession")
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1055,7 +1191,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.load("CisSSLClientSession")
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1063,12 +1200,14 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // DrAutomation Service
-func (session clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+func (session *clientSession) DrAutomationServiceV1() (*drautomationservicev1.DrAutomationServiceV1, error) {
+	session.load("DrAutomationServiceV1")
 	return session.drAutomationServiceClient, session.drAutomationServiceClientErr
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.load("CisWAFPackageClientSession")
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1076,7 +1215,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.load("CisDomainSettingsClientSession")
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1084,7 +1224,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.load("CisAlertsSession")
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1092,7 +1233,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.load("CisRulesetsSession")
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1100,7 +1242,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.load("CisRoutingClientSession")
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1108,7 +1251,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.load("CisWAFGroupClientSession")
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1116,7 +1260,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.load("CisCacheClientSession")
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1124,7 +1269,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.load("CisCustomPageClientSession")
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1132,7 +1278,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.load("CisAccessRuleClientSession")
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1140,7 +1287,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.load("CisUARuleClientSession")
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1148,7 +1296,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.load("CisLockdownClientSession")
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1156,7 +1305,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.load("CisRangeAppClientSession")
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1164,7 +1314,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.load("CisWAFRuleClientSession")
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1172,7 +1323,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.load("CisOrigAuthSession")
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1180,7 +1332,8 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // CIS Lists
-func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+func (sess *clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
+	sess.load("CisListsSession")
 	if sess.cisListsErr != nil {
 		return sess.cisListsClient, sess.cisListsErr
 	}
@@ -1188,55 +1341,66 @@ func (sess clientSession) CisListsSession() (*cislistsapiv1.ListsApiV1, error) {
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.load("IAMIdentityV1API")
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.load("ResourceManagerV2API")
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.load("EnterpriseManagementV1")
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.load("ResourceControllerV2API")
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
-func (session clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+func (session *clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+	session.load("BackupRecoveryV1")
 	return session.backupRecoveryClient, session.backupRecoveryClientErr
 }
 
-func (session clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+func (session *clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+	session.load("BackupRecoveryV1Connector")
 	return session.backupRecoveryConnectorClient, session.backupRecoveryConnectorClientErr
 }
 
-func (session clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+func (session *clientSession) BackupRecoveryManagerV1() (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
+	session.load("BackupRecoveryManagerV1")
 	return session.backupRecoveryManagerClient, session.backupRecoveryManagerClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.load("SecretsManagerV2")
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.load("SatellitLinkClientSession")
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.load("SatelliteClientSession")
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.load("CisLogpushJobsSession")
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1244,7 +1408,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.load("CisMtlsSession")
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1252,7 +1417,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.load("CisBotManagementSession")
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1260,7 +1426,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.load("CisBotAnalyticsSession")
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1268,7 +1435,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.load("CisWebhookSession")
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1276,7 +1444,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.load("CisFiltersSession")
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1284,7 +1453,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.load("CisFirewallRulesSession")
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1292,55 +1462,66 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.load("AtrackerV2")
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.load("MetricsRouterV3")
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.load("ESschemaRegistrySession")
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.load("ESadminRestSession")
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.load("SecurityAndComplianceCenterV3")
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.load("ContextBasedRestrictionsV1")
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.load("CdToolchainV2")
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.load("CdTektonPipelineV2")
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.load("CodeEngineV2")
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.load("ProjectV1")
 	return session.projectClient, session.projectClientErr
 }
 
 // MQ SaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.load("MqcloudV1")
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1350,39 +1531,58 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 }
 
 // sdsaas
-func (session clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+func (session *clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+	session.load("SdsaasV1")
 	return session.sdsaasClient, session.sdsaasClientErr
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.load("VmwareV1")
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.load("LogsV0")
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.load("IBMCloudLogsRoutingV0")
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
 // GlobalCatalog Session
-func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.load("GlobalCatalogV1API")
 	return sess.globalCatalogClient, sess.globalCatalogClientErr
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, fileMap, err := newSession(c)
+	tracer, err := newHTTPTracer(c.HTTPTracePath, c.HTTPTraceFormat)
+	if err != nil {
+		return nil, err
+	}
+	middleware := &transportMiddleware{
+		tracer:  tracer,
+		limiter: newRateLimiter(c.RateLimit, c.RetryCount),
+	}
+	endpoints, err := newServiceEndpoints(c)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := newSession(c, middleware, endpoints)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:     sess,
+		defaultTags: c.DefaultTags,
+		middleware:  middleware,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1475,7 +1675,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.logsClientErr = errEmptyBluemixCredentials
 		session.ibmCloudLogsRoutingClientErr = errEmptyBluemixCredentials
 
-		return session, nil
+		return &session, nil
 	}
 
 	err = fetchAuthorizationData(sess.BluemixSession)
@@ -1506,74 +1706,88 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	session.lazy("FunctionClient", func() {
+		session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	})
 
 	BluemixRegion = sess.BluemixSession.Config.Region
 
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
+	session.lazy("BluemixAcccountv1API", func() {
+		accv1API, err := accountv1.New(sess.BluemixSession)
+		if err != nil {
+			session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
+		}
+		session.bmxAccountv1ServiceAPI = accv1API
+	})
 
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
+	session.lazy("BluemixAcccountAPI", func() {
+		accAPI, err := accountv2.New(sess.BluemixSession)
+		if err != nil {
+			session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
+		}
+		session.bmxAccountServiceAPI = accAPI
+	})
 
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
+	session.lazy("MccpAPI", func() {
+		cfAPI, err := mccpv2.New(sess.BluemixSession)
+		if err != nil {
+			session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
+		}
+		session.cfServiceAPI = cfAPI
+	})
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
+	session.lazy("ContainerAPI", func() {
+		clusterAPI, err := containerv1.New(sess.BluemixSession)
+		if err != nil {
+			session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+		session.csServiceAPI = clusterAPI
+	})
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
+	session.lazy("VpcContainerAPI", func() {
+		v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+		if err != nil {
+			session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+		session.csv2ServiceAPI = v2clusterAPI
+	})
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
+	session.lazy("HpcsEndpointAPI", func() {
+		hpcsAPI, err := hpcs.New(sess.BluemixSession)
+		if err != nil {
+			session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
+		}
+		session.hpcsEndpointAPI = hpcsAPI
+	})
 
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
-	var options kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	kpurl = endpoints.url("IBMCLOUD_KP_API_ENDPOINT", kpurl)
+	session.lazy("KeyProtectAPI", func() {
+		var options kp.ClientConfig
+		if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") && c.IAMComputeResource == "" && len(c.AssumeTrustedProfiles) == 0 {
+			options = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
+		} else {
+			options = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 		}
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+		kpAPIclient, err := kp.New(options, wrapTransport(middleware, "KeyProtectAPI", defaultTransport()))
+		if err != nil {
+			session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
+		session.kpAPI = kpAPIclient
+	})
 
 	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
 
@@ -1584,50 +1798,53 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+	iamURL = endpoints.url("IBMCLOUD_IAM_API_ENDPOINT", iamURL)
 
 	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
-	var kmsOptions kp.ClientConfig
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+	kmsurl = endpoints.url("IBMCLOUD_KP_API_ENDPOINT", kmsurl)
+	session.lazy("KeyManagementAPI", func() {
+		var kmsOptions kp.ClientConfig
+		if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID == "" && c.IAMTrustedProfileName == "") && c.IAMComputeResource == "" && len(c.AssumeTrustedProfiles) == 0 {
+			kmsOptions = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
+		} else {
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+			}
 		}
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		kmsAPIclient, err := kp.New(kmsOptions, wrapTransport(middleware, "KeyManagementAPI", defaultTransport()))
+		if err != nil {
+			session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 		}
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
+		session.kmsAPI = kmsAPIclient
+	})
 
 	var authenticator core.Authenticator
 
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
+	if c.IAMComputeResource != "" || len(c.AssumeTrustedProfiles) > 0 {
+		// Share the tokens of the compute resource and of the assumed
+		// trusted profiles with the Bluemix session.
+		authenticator = sess.BluemixSession.Config.Authenticator
+	} else if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
 		if c.IAMTrustedProfileID != "" {
 			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
 				SetApiKey(c.BluemixAPIKey).
 				SetIAMProfileID(c.IAMTrustedProfileID).
 				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(newHTTPClient(middleware, "IAMAuthenticator", 0)).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
@@ -1638,6 +1855,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				SetIAMProfileName(c.IAMTrustedProfileName).
 				SetIAMAccountID(c.Account).
 				SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL)).
+				SetClient(newHTTPClient(middleware, "IAMAuthenticator", 0)).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
@@ -1649,6 +1867,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			authenticator = &core.IamAuthenticator{
 				ApiKey: c.BluemixAPIKey,
 				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client: newHTTPClient(middleware, "IAMAuthenticator", 0),
 			}
 		} else {
 			// Construct the IamAuthenticator with the IAM refresh token.
@@ -1657,6 +1876,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				ClientId:     "bx",
 				ClientSecret: "bx",
 				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+				Client:       newHTTPClient(middleware, "IAMAuthenticator", 0),
 			}
 		}
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
@@ -1668,107 +1888,120 @@ func (c *Config) ClientSession() (interface{}, error) {
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
 		}
 	}
+	session.authenticator = authenticator
 
 	// Construct the service options.
 	var backupRecoveryURL string = "https://default.backup-recovery.cloud.ibm.com/v2"
 	var backupRecoveryConnectorURL string
 	var backupRecoveryManagerURL string = "https://manager.backup-recovery.cloud.ibm.com/v2"
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		backupRecoveryURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", c.Region, backupRecoveryURL)
-		backupRecoveryConnectorURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT", c.Region, backupRecoveryConnectorURL)
-		backupRecoveryManagerURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT", c.Region, backupRecoveryConnectorURL)
-	}
+	backupRecoveryURL = endpoints.url("IBMCLOUD_BACKUP_RECOVERY_ENDPOINT", backupRecoveryURL)
+	backupRecoveryConnectorURL = endpoints.url("IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT", backupRecoveryConnectorURL)
+	backupRecoveryManagerURL = endpoints.url("IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT", backupRecoveryConnectorURL)
 
-	backupRecoveryClientOptions := &backuprecoveryv1.BackupRecoveryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT"}, backupRecoveryURL),
-	}
+	session.lazy("BackupRecoveryV1", func() {
+		var err error
+		backupRecoveryClientOptions := &backuprecoveryv1.BackupRecoveryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT"}, backupRecoveryURL),
+		}
 
-	// Construct the service client.
-	session.backupRecoveryClient, err = backuprecoveryv1.NewBackupRecoveryV1(backupRecoveryClientOptions)
-	if err != nil {
-		session.backupRecoveryClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
-	}
-	if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// Construct the service client.
+		session.backupRecoveryClient, err = backuprecoveryv1.NewBackupRecoveryV1(backupRecoveryClientOptions)
+		if err != nil {
+			session.backupRecoveryClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
+		}
+		if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
+			// Enable retries for API calls
+			session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "BackupRecoveryV1", session.backupRecoveryClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	var backupRecoveryConnectorClientAuthenticator core.Authenticator
-	backupRecoveryConnectorClientAuthenticator = &core.NoAuthAuthenticator{}
+	session.lazy("BackupRecoveryV1Connector", func() {
+		var err error
+		var backupRecoveryConnectorClientAuthenticator core.Authenticator
+		backupRecoveryConnectorClientAuthenticator = &core.NoAuthAuthenticator{}
 
-	backupRecoveryConnectorClientOptions := &backuprecoveryv1.BackupRecoveryV1ConnectorOptions{
-		ConnectorURL:  EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT"}, backupRecoveryConnectorURL),
-		Authenticator: backupRecoveryConnectorClientAuthenticator,
-	}
+		backupRecoveryConnectorClientOptions := &backuprecoveryv1.BackupRecoveryV1ConnectorOptions{
+			ConnectorURL:  EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT"}, backupRecoveryConnectorURL),
+			Authenticator: backupRecoveryConnectorClientAuthenticator,
+		}
 
-	// Construct the service connector client.
-	session.backupRecoveryConnectorClient, err = backuprecoveryv1.NewBackupRecoveryV1Connector(backupRecoveryConnectorClientOptions)
-	session.backupRecoveryConnectorClient.Service.DisableSSLVerification()
-	if err != nil {
-		session.backupRecoveryConnectorClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API connector service: %q", err)
-	}
-	if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryConnectorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// Construct the service connector client.
+		session.backupRecoveryConnectorClient, err = backuprecoveryv1.NewBackupRecoveryV1Connector(backupRecoveryConnectorClientOptions)
+		session.backupRecoveryConnectorClient.Service.DisableSSLVerification()
+		if err != nil {
+			session.backupRecoveryConnectorClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API connector service: %q", err)
+		}
+		if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
+			// Enable retries for API calls
+			session.backupRecoveryConnectorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "BackupRecoveryV1Connector", session.backupRecoveryConnectorClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	var backupRecoveryManagerClientAuthenticator core.Authenticator
-	backupRecoveryManagerClientAuthenticator = &core.NoAuthAuthenticator{}
+	session.lazy("BackupRecoveryManagerV1", func() {
+		var err error
+		var backupRecoveryManagerClientAuthenticator core.Authenticator
+		backupRecoveryManagerClientAuthenticator = &core.NoAuthAuthenticator{}
 
-	backupRecoveryManagerClientOptions := &backuprecoveryv1.BackupRecoveryManagementSreApiV1Options{
-		Authenticator: backupRecoveryManagerClientAuthenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT"}, backupRecoveryManagerURL),
-	}
-	// Construct the service client.
-	session.backupRecoveryManagerClient, err = backuprecoveryv1.NewBackupRecoveryManagementSreApiV1(backupRecoveryManagerClientOptions)
-	if err != nil {
-		session.backupRecoveryManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
-	}
-	if session.backupRecoveryManagerClient != nil && session.backupRecoveryManagerClient.Service != nil {
-		// Enable retries for API calls
-		session.backupRecoveryManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.backupRecoveryManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		backupRecoveryManagerClientOptions := &backuprecoveryv1.BackupRecoveryManagementSreApiV1Options{
+			Authenticator: backupRecoveryManagerClientAuthenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT"}, backupRecoveryManagerURL),
+		}
+		// Construct the service client.
+		session.backupRecoveryManagerClient, err = backuprecoveryv1.NewBackupRecoveryManagementSreApiV1(backupRecoveryManagerClientOptions)
+		if err != nil {
+			session.backupRecoveryManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Backup recovery API service: %q", err)
+		}
+		if session.backupRecoveryManagerClient != nil && session.backupRecoveryManagerClient.Service != nil {
+			// Enable retries for API calls
+			session.backupRecoveryManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "BackupRecoveryManagerV1", session.backupRecoveryManagerClient.Service)
+			// Add custom header for analytics
+			session.backupRecoveryManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
-	}
+	projectEndpoint = endpoints.url("IBMCLOUD_PROJECT_API_ENDPOINT", project.DefaultServiceURL)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
 	}
 	// Construct an "options" struct for creating the service client.
-	projectClientOptions := &project.ProjectV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
-		Authenticator: authenticator,
-	}
+	session.lazy("ProjectV1", func() {
+		var err error
+		projectClientOptions := &project.ProjectV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
+			Authenticator: authenticator,
+		}
 
-	// Construct the service client.
-	session.projectClient, err = project.NewProjectV1(projectClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.projectClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
-	}
+		// Construct the service client.
+		session.projectClient, err = project.NewProjectV1(projectClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ProjectV1", session.projectClient.Service)
+			// Add custom header for analytics
+			session.projectClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
+		}
+	})
 
 	// Construct an "options" struct for creating the service client.
 	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
@@ -1776,33 +2009,35 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
-	}
-	logsClientOptions := &logsv0.LogsV0Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, logsEndpoint),
-	}
+	logsEndpoint = endpoints.url("IBMCLOUD_LOGS_API_ENDPOINT", logsEndpoint)
+	session.lazy("LogsV0", func() {
+		var err error
+		logsClientOptions := &logsv0.LogsV0Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_LOGS_API_ENDPOINT"}, logsEndpoint),
+		}
 
-	// Construct the service client.
-	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.logsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
-	}
+		// Construct the service client.
+		session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "LogsV0", session.logsClient.Service)
+			// Add custom header for analytics
+			session.logsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
+		}
+	})
 
 	// LOGS ROUTER Version 0
 	var logsrouterClientURL string
 	var logsrouterURLErr error
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsrouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", c.Region, ibmcloudlogsroutingv0.DefaultServiceURL)
+	if url := endpoints.url("IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", ""); url != "" {
+		logsrouterClientURL = url
 	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
 	} else {
@@ -1811,65 +2046,74 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if logsrouterURLErr != nil {
 		logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
 	}
-	ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
-		Authenticator: authenticator,
-		URL:           logsrouterClientURL,
-	}
+	session.lazy("IBMCloudLogsRoutingV0", func() {
+		var err error
+		ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
+			Authenticator: authenticator,
+			URL:           logsrouterClientURL,
+		}
 
-	// Construct the service client.
-	session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
-	}
+		// Construct the service client.
+		session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "IBMCloudLogsRoutingV0", session.ibmCloudLogsRoutingClient.Service)
+			// Add custom header for analytics
+			session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
+		}
+	})
 
 	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
+	session.lazy("UkoV4", func() {
+		var err error
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+		}
 
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
-	}
+		// Construct the service client.
+		session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "UkoV4", session.ukoClient.Service)
+			// Add custom header for analytics
+			session.ukoClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
+	})
 
 	// APP ID Service
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
-	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.appidAPI = appIDClient
+	appIDEndpoint = endpoints.url("IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", appIDEndpoint)
+	session.lazy("AppIDAPI", func() {
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "AppIDAPI", appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.appidAPI = appIDClient
+	})
 
 	// Construct an "options" struct for creating Context Based Restrictions service client.
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
@@ -1880,52 +2124,56 @@ func (c *Config) ClientSession() (interface{}, error) {
 			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
+	cbrURL = endpoints.url("IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", cbrURL)
+	session.lazy("ContextBasedRestrictionsV1", func() {
+		var err error
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		}
 
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
-		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
-	}
+		// Construct the service client.
+		session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err == nil && session.contextBasedRestrictionsClient != nil {
+			// Enable retries for API calls
+			session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ContextBasedRestrictionsV1", session.contextBasedRestrictionsClient.Service)
+			// Add custom header for analytics
+			session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
+	})
 
 	// PARTNER CENTER SELL (product lifecycle) service
 	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
 	if c.Visibility == "private" {
 		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
-	}
-	partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
-	if err != nil {
-		session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
-	}
-	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
-		// Enable retries for API calls
-		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	partnerCenterSellURL = endpoints.url("IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", partnerCenterSellURL)
+	session.lazy("PartnerCenterSellV1", func() {
+		var err error
+		partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
+		if err != nil {
+			session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
+		}
+		if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
+			// Enable retries for API calls
+			session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "PartnerCenterSellV1", session.partnerCenterSellClient.Service)
+			// Add custom header for analytics
+			session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	//Usage Reports Service Client
 	usageReportsURL := usagereportsv4.DefaultServiceURL
@@ -1945,53 +2193,56 @@ func (c *Config) ClientSession() (interface{}, error) {
 			usageReportsURL = usagereportsv4.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
-	}
-	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
-	}
-	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
-	if err != nil {
-		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
-	}
-	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		usageReportsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.usageReportsClient = usageReportsClient
+	usageReportsURL = endpoints.url("IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", usageReportsURL)
+	session.lazy("UsageReportsV4", func() {
+		usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+		}
+		usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+		if err != nil {
+			session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+		}
+		if usageReportsClient != nil && usageReportsClient.Service != nil {
+			usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "UsageReportsV4", usageReportsClient.Service)
+			usageReportsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.usageReportsClient = usageReportsClient
+	})
 
 	// CATALOG MANAGEMENT Service
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// ATRACKER Version 2
-	var atrackerClientV2URL string
+	catalogManagementURL = endpoints.url("IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", catalogManagementURL)
+	session.lazy("CatalogManagementV1", func() {
+		var err error
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		}
+		if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CatalogManagementV1", session.catalogManagementClient.Service)
+			// Add custom header for analytics
+			session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	// ATRACKER Version 2
+	var atrackerClientV2URL string
 	var atrackerURLV2Err error
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2005,29 +2256,31 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if atrackerURLV2Err != nil {
 		atrackerClientV2URL = atrackerv2.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
-		session.atrackerClientV2Err = atrackerURLV2Err
-	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
-		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
-	}
+	atrackerClientV2URL = endpoints.url("IBMCLOUD_ATRACKER_API_ENDPOINT", atrackerClientV2URL)
+	session.lazy("AtrackerV2", func() {
+		var err error
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
+		// This should technically never happen as we default this for v2
+		if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
+			session.atrackerClientV2Err = atrackerURLV2Err
+		}
+		session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err == nil {
+			// Enable retries for API calls
+			session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "AtrackerV2", session.atrackerClientV2.Service)
+			// Add custom header for analytics
+			session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
+	})
 
 	// Construct an "options" struct for creating the service client for Metrics Router
 	var metricsRouterClientURL string
@@ -2044,26 +2297,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if metricsRouterURLV3Err != nil {
 		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
-	}
-	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
-	}
+	metricsRouterClientURL = endpoints.url("IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", metricsRouterClientURL)
+	session.lazy("MetricsRouterV3", func() {
+		var err error
+		metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
+		}
 
-	// Construct the service client.
-	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
-	}
+		// Construct the service client.
+		session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "MetricsRouterV3", session.metricsRouterClient.Service)
+			// Add custom header for analytics
+			session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
+		}
+	})
 
 	// SCC (Security and Compliance Center) Service
 	sccApiClientURL := scc.DefaultServiceURL
@@ -2071,23 +2326,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
 		sccApiClientURL = regionURL
 	}
-	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
-	}
+	session.lazy("SecurityAndComplianceCenterV3", func() {
+		var err error
+		sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
+		}
 
-	// Construct the service client.
-	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
-	}
+		// Construct the service client.
+		session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "SecurityAndComplianceCenterV3", session.securityAndComplianceCenterClient.Service)
+			// Add custom header for analytics
+			session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
+		}
+	})
 
 	// SCHEMATICS Service
 	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
@@ -2095,91 +2354,97 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
-	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.schematicsClient = schematicsClient
+	schematicsEndpoint = endpoints.url("IBMCLOUD_SCHEMATICS_API_ENDPOINT", schematicsEndpoint)
+	session.lazy("SchematicsV1", func() {
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
+		// Enable retries for API calls
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "SchematicsV1", schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.schematicsClient = schematicsClient
+	})
 
 	// VPC Service
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcAPI = vpcclient
+	vpcurl = endpoints.url("IBMCLOUD_IS_NG_API_ENDPOINT", vpcurl)
+	session.lazy("VpcV1API", func() {
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "VpcV1API", vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcAPI = vpcclient
+	})
 
-	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
-	if err != nil {
-		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
-	}
-	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcBetaAPI = vpcbetaclient
+	session.lazy("VpcV1BetaAPI", func() {
+		vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
+		if err != nil {
+			session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
+		}
+		if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+			vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "VpcV1BetaAPI", vpcbetaclient.Service)
+			vpcbetaclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcBetaAPI = vpcbetaclient
+	})
 
 	// PUSH NOTIFICATIONS Service
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
-	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.pushServiceClient = pnclient
+	pnurl = endpoints.url("IBMCLOUD_PUSH_API_ENDPOINT", pnurl)
+	session.lazy("PushServiceV1", func() {
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "PushServiceV1", pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.pushServiceClient = pnclient
+	})
 
 	// event notifications
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
@@ -2188,48 +2453,51 @@ func (c *Config) ClientSession() (interface{}, error) {
 		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 	}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
-	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	enurl = endpoints.url("IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", enurl)
+	session.lazy("EventNotificationsApiV1", func() {
+		var err error
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		}
+		// Construct the service client.
+		session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			// Enable {
+			session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "EventNotificationsApiV1", session.eventNotificationsApiClient.Service)
+			session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// APP CONFIGURATION Service
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
+	appconfigurl = endpoints.url("IBMCLOUD_APP_CONFIG_ENDPOINT", appconfigurl)
+	session.lazy("AppConfigurationV1", func() {
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
+			Authenticator: authenticator,
+		}
 
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
-		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
-	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
-	}
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient != nil {
+			// Enable retries for API calls
+			appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "AppConfigurationV1", appConfigClient.Service)
+			session.appConfigurationClient = appConfigClient
+		} else {
+			session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		}
+	})
 
 	// CONTAINER REGISTRY Service
 	// Construct an "options" struct for creating the service client.
@@ -2243,54 +2511,63 @@ func (c *Config) ClientSession() (interface{}, error) {
 			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
-		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	containerRegistryClientURL = endpoints.url("IBMCLOUD_CR_API_ENDPOINT", containerRegistryClientURL)
+	session.lazy("ContainerRegistryV1", func() {
+		var err error
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+			Account:       core.StringPtr(userConfig.UserAccount),
+		}
+		// Construct the service client.
+		session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
+		if err != nil {
+			session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ContainerRegistryV1", session.containerRegistryClient.Service)
+			// Add custom header for analytics
+			session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
+	cosconfigurl = endpoints.url("IBMCLOUD_COS_CONFIG_ENDPOINT", cosconfigurl)
+	session.lazy("CosConfigV1API", func() {
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+		}
+		if cosconfigclient != nil {
+			wrapServiceTransport(middleware, "CosConfigV1API", cosconfigclient.Service)
+		}
+		session.cosConfigAPI = cosconfigclient
+	})
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
+	session.lazy("GlobalSearchAPI", func() {
+		globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+		if err != nil {
+			session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		session.globalSearchServiceAPI = globalSearchAPI
+	})
 	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
+	session.lazy("GlobalTaggingAPI", func() {
+		globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+		if err != nil {
+			session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		session.globalTaggingServiceAPI = globalTaggingAPI
+	})
 
 	// GLOBAL TAGGING Service
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
@@ -2303,24 +2580,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	globalTaggingEndpoint = endpoints.url("IBMCLOUD_GT_API_ENDPOINT", globalTaggingEndpoint)
+	session.lazy("GlobalTaggingAPIv1", func() {
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "GlobalTaggingAPIv1", session.globalTaggingServiceAPIV1.Service)
+			session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 	// GLOBAL TAGGING Service
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2332,30 +2610,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
-	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
-	if err != nil {
-		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	globalSearchEndpoint = endpoints.url("IBMCLOUD_GS_API_ENDPOINT", searchv2.DefaultServiceURL)
+	session.lazy("GlobalSearchAPIV2", func() {
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+			session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "GlobalSearchAPIV2", session.globalSearchServiceAPIV2.Service)
+			session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
+	session.lazy("ICDAPI", func() {
+		icdAPI, err := icdv4.New(sess.BluemixSession)
+		if err != nil {
+			session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+		session.icdServiceAPI = icdAPI
+	})
 
 	var cloudDatabasesEndpoint string
 
@@ -2366,98 +2647,122 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
-	}
+	session.lazy("CloudDatabasesV5", func() {
+		var err error
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+			Authenticator: authenticator,
+		}
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
+		// Construct the service client.
+		session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CloudDatabasesV5", session.cloudDatabasesClient.Service)
+			// Add custom header for analytics
+			session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
+	})
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	session.lazy("ResourceCatalogAPI", func() {
+		resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
+		}
+		session.resourceCatalogServiceAPI = resourceCatalogAPI
+	})
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
+	session.lazy("ResourceManagementAPIv2", func() {
+		resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
+		}
+		session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	})
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	session.lazy("ResourceControllerAPI", func() {
+		resourceControllerAPI, err := controller.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		session.resourceControllerServiceAPI = resourceControllerAPI
+	})
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
+	session.lazy("ResourceControllerAPIV2", func() {
+		ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+		session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	})
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
+	session.lazy("UserManagementAPI", func() {
+		userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
+		}
+		session.userManagementAPI = userManagementAPI
+	})
 
-	// POWER SYSTEMS Service
+	session.lazy("FunctionIAMNamespaceAPI", func() {
+		namespaceFunction, err := functions.New(sess.BluemixSession)
+		if err != nil {
+			session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+		session.functionIAMNamespaceAPI = namespaceFunction
+	})
+
+	// POWER SYSTEMS Service
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
-	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
-		Debug:         os.Getenv("TF_LOG") != "",
-		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
-		Zone:          c.Zone,
-	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
-	}
-	session.ibmpiSession = ibmpisession
+	session.lazy("IBMPISession", func() {
+		ibmPIOptions := &ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			Region:        c.Region,
+			URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+			UserAccount:   userConfig.UserAccount,
+			Zone:          c.Zone,
+		}
+		ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err != nil {
+			session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		if ibmpisession != nil && ibmpisession.Power != nil {
+			if transport, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+				transport.Transport = wrapTransport(middleware, "IBMPISession", transport.Transport)
+			}
+		}
+		session.ibmpiSession = ibmpisession
+	})
 
 	// PRIVATE DNS Service
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	pdnsURL = endpoints.url("IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", pdnsURL)
+	session.lazy("PrivateDNSClientSession", func() {
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+			Authenticator: authenticator,
+		}
+		session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
+		if session.pDNSErr != nil {
+			session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
+		}
+		if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+			session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "PrivateDNSClientSession", session.pDNSClient.Service)
+			session.pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// DIRECT LINK Service
 	ver := time.Now().Format("2006-01-02")
@@ -2465,121 +2770,130 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	dlURL = endpoints.url("IBMCLOUD_DL_API_ENDPOINT", dlURL)
+	session.lazy("DirectlinkV1API", func() {
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
+		if session.directlinkErr != nil {
+			session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
+		}
+		if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+			session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "DirectlinkV1API", session.directlinkAPI.Service)
+			session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// DIRECT LINK PROVIDER Service
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	dlproviderURL = endpoints.url("IBMCLOUD_DL_PROVIDER_API_ENDPOINT", dlproviderURL)
+	session.lazy("DirectlinkProviderV2API", func() {
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if session.dlProviderErr != nil {
+			session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
+		}
+		if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+			session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "DirectlinkProviderV2API", session.dlProviderAPI.Service)
+			session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// TRANSIT GATEWAY Service
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
-	}
+	tgURL = endpoints.url("IBMCLOUD_TG_API_ENDPOINT", tgURL)
+	session.lazy("TransitGatewayV1API", func() {
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if session.transitgatewayErr != nil {
+			session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
+		}
+		if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+			session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "TransitGatewayV1API", session.transitgatewayAPI.Service)
+			// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
+	})
 
 	// Construct an instance of the 'Configuration Aggregator' service.
 	configBaseURL := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		configBaseURL = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		configBaseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, configBaseURL)
-	}
-	configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, configBaseURL),
-	}
-
-	// Construct the service client.
-	session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
-	}
-
-	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
-	if session.db2saasClientErr == nil {
-		// Construct the service options.
-		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
-		db2saasClientOptions := &db2saasv1.Db2saasV1Options{
-			URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+	configBaseURL = endpoints.url("IBMCLOUD_APP_CONFIG_ENDPOINT", configBaseURL)
+	session.lazy("ConfigurationAggregatorV1", func() {
+		var err error
+		configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, configBaseURL),
 		}
 
 		// Construct the service client.
-		session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+		session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ConfigurationAggregatorV1", session.configurationAggregatorClient.Service)
 			// Add custom header for analytics
-			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+			session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
 		}
-	}
+	})
+
+	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+	session.lazy("Db2saasV1", func() {
+		var err error
+		if session.db2saasClientErr == nil {
+			// Construct the service options.
+			defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
+			db2saasClientOptions := &db2saasv1.Db2saasV1Options{
+				URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+				wrapServiceTransport(middleware, "Db2saasV1", session.db2saasClient.Service)
+				// Add custom header for analytics
+				session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			}
+		}
+	})
 
 	// CIS Service instances starts here.
 	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
@@ -2611,634 +2925,731 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
+	cisURL = endpoints.url("IBMCLOUD_CIS_API_ENDPOINT", cisURL)
 	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
 
 	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisZonesV1ClientSession", func() {
+		cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
+		if session.cisZonesErr != nil {
+			session.cisZonesErr = fmt.Errorf(
+				"Error occured while configuring CIS Zones service: %s",
+				session.cisZonesErr)
+		}
+		if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+			session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisZonesV1ClientSession", session.cisZonesV1Client.Service)
+			session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisDNSRecordClientSession", func() {
+		cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
+		if session.cisDNSErr != nil {
+			session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
+		}
+		if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+			session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisDNSRecordClientSession", session.cisDNSRecordsClient.Service)
+			session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisDNSRecordBulkClientSession", func() {
+		cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
+		if session.cisDNSBulkErr != nil {
+			session.cisDNSBulkErr = fmt.Errorf(
+				"Error occured while configuration CIS DNS bulk service : %s",
+				session.cisDNSBulkErr)
+		}
+		if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+			session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisDNSRecordBulkClientSession", session.cisDNSRecordBulkClient.Service)
+			session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
-			session.cisGLBPoolErr)
-	}
-	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisGLBPoolClientSession", func() {
+		cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
+		if session.cisGLBPoolErr != nil {
+			session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
+				session.cisGLBPoolErr)
+		}
+		if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+			session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisGLBPoolClientSession", session.cisGLBPoolClient.Service)
+			session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
-			session.cisGLBErr)
-	}
-	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisGLBClientSession", func() {
+		cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
+		session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
+		if session.cisGLBErr != nil {
+			session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
+				session.cisGLBErr)
+		}
+		if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+			session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisGLBClientSession", session.cisGLBClient.Service)
+			session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
-			session.cisGLBHealthCheckErr)
-	}
-	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisGLBHealthCheckClientSession", func() {
+		cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
+		if session.cisGLBHealthCheckErr != nil {
+			session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
+				session.cisGLBHealthCheckErr)
+		}
+		if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+			session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisGLBHealthCheckClientSession", session.cisGLBHealthCheckClient.Service)
+			session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisIPClientSession", func() {
+		cisIPOpt := &cisipv1.CisIpApiV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
+		if session.cisIPErr != nil {
+			session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
+				session.cisIPErr)
+		}
+		if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+			session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisIPClientSession", session.cisIPClient.Service)
+			session.cisIPClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisRLClientSession", func() {
+		cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
+		if session.cisRLErr != nil {
+			session.cisRLErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Zone Rate Limit service: %s",
+				session.cisRLErr)
+		}
+		if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+			session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisRLClientSession", session.cisRLClient.Service)
+			session.cisRLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 	// IBM Network CIS Alerts
-	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
-	if session.cisAlertsErr != nil {
-		session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
-			session.cisAlertsErr)
-	}
-	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisAlertsSession", func() {
+		cisAlertsOpt := &cisalertsv1.AlertsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
+		if session.cisAlertsErr != nil {
+			session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
+				session.cisAlertsErr)
+		}
+		if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+			session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisAlertsSession", session.cisAlertsClient.Service)
+			session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Rulesets
-	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
-	if session.cisRulesetsErr != nil {
-		session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
-			session.cisRulesetsErr)
-	}
-	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
-		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisRulesetsSession", func() {
+		cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
+		if session.cisRulesetsErr != nil {
+			session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
+				session.cisRulesetsErr)
+		}
+		if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
+			session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisRulesetsSession", session.cisRulesetsClient.Service)
+			session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisPageRuleClientSession", func() {
+		cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
+		if session.cisPageRuleErr != nil {
+			session.cisPageRuleErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Page Rule service: %s",
+				session.cisPageRuleErr)
+		}
+		if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+			session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisPageRuleClientSession", session.cisPageRuleClient.Service)
+			session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
-			session.cisEdgeFunctionErr)
-	}
-	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisEdgeFunctionClientSession", func() {
+		cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
+		if session.cisEdgeFunctionErr != nil {
+			session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
+				session.cisEdgeFunctionErr)
+		}
+		if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+			session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisEdgeFunctionClientSession", session.cisEdgeFunctionClient.Service)
+			session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
+	session.lazy("CisSSLClientSession", func() {
+		cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
 
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
-			session.cisSSLErr)
-	}
-	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
+		if session.cisSSLErr != nil {
+			session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
+				session.cisSSLErr)
+		}
+		if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+			session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisSSLClientSession", session.cisSSLClient.Service)
+			session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
-			session.cisWAFPackageErr)
-	}
-	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisWAFPackageClientSession", func() {
+		cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
+		if session.cisWAFPackageErr != nil {
+			session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
+				session.cisWAFPackageErr)
+		}
+		if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+			session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisWAFPackageClientSession", session.cisWAFPackageClient.Service)
+			session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
-			session.cisDomainSettingsErr)
-	}
-	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisDomainSettingsClientSession", func() {
+		cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
+		if session.cisDomainSettingsErr != nil {
+			session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
+				session.cisDomainSettingsErr)
+		}
+		if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+			session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisDomainSettingsClientSession", session.cisDomainSettingsClient.Service)
+			session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
-			session.cisRoutingErr)
-	}
-	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisRoutingClientSession", func() {
+		cisRoutingOpt := &cisroutingv1.RoutingV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
+		if session.cisRoutingErr != nil {
+			session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
+				session.cisRoutingErr)
+		}
+		if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+			session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisRoutingClientSession", session.cisRoutingClient.Service)
+			session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
-			session.cisWAFGroupErr)
-	}
-	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisWAFGroupClientSession", func() {
+		cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
+		if session.cisWAFGroupErr != nil {
+			session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
+				session.cisWAFGroupErr)
+		}
+		if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+			session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisWAFGroupClientSession", session.cisWAFGroupClient.Service)
+			session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
-			session.cisCacheErr)
-	}
-	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisCacheClientSession", func() {
+		cisCacheOpt := &ciscachev1.CachingApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
+		if session.cisCacheErr != nil {
+			session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
+				session.cisCacheErr)
+		}
+		if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+			session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisCacheClientSession", session.cisCacheClient.Service)
+			session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
+	session.lazy("CisCustomPageClientSession", func() {
+		cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
 
-	session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
-			session.cisCustomPageErr)
-	}
-	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
+		if session.cisCustomPageErr != nil {
+			session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
+				session.cisCustomPageErr)
+		}
+		if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+			session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisCustomPageClientSession", session.cisCustomPageClient.Service)
+			session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
-			session.cisAccessRuleErr)
-	}
-	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisAccessRuleClientSession", func() {
+		cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
+		if session.cisAccessRuleErr != nil {
+			session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
+				session.cisAccessRuleErr)
+		}
+		if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+			session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisAccessRuleClientSession", session.cisAccessRuleClient.Service)
+			session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-			session.cisUARuleErr)
-	}
-	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisUARuleClientSession", func() {
+		cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
+		if session.cisUARuleErr != nil {
+			session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
+				session.cisUARuleErr)
+		}
+		if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+			session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisUARuleClientSession", session.cisUARuleClient.Service)
+			session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-			session.cisLockdownErr)
-	}
-	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisLockdownClientSession", func() {
+		cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
+		if session.cisLockdownErr != nil {
+			session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
+				session.cisLockdownErr)
+		}
+		if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+			session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisLockdownClientSession", session.cisLockdownClient.Service)
+			session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
-			session.cisRangeAppErr)
-	}
-	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisRangeAppClientSession", func() {
+		cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
+		if session.cisRangeAppErr != nil {
+			session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
+				session.cisRangeAppErr)
+		}
+		if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+			session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisRangeAppClientSession", session.cisRangeAppClient.Service)
+			session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisWAFRuleClientSession", func() {
+		cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
+		if session.cisWAFRuleErr != nil {
+			session.cisWAFRuleErr = fmt.Errorf(
+				"Error occured while configuring CIS WAF Rules service: %s",
+				session.cisWAFRuleErr)
+		}
+		if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+			session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisWAFRuleClientSession", session.cisWAFRuleClient.Service)
+			session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS LogpushJobs
-	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
-	if session.cisLogpushJobsErr != nil {
-		session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
-			session.cisLogpushJobsErr)
-	}
-	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisLogpushJobsSession", func() {
+		cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Dataset:       core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
+		if session.cisLogpushJobsErr != nil {
+			session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
+				session.cisLogpushJobsErr)
+		}
+		if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+			session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisLogpushJobsSession", session.cisLogpushJobsClient.Service)
+			session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM MTLS Session
-	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
-	if session.cisMtlsErr != nil {
-		session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
-			session.cisMtlsErr)
-	}
-	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisMtlsSession", func() {
+		cisMtlsOpt := &cismtlsv1.MtlsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
+		if session.cisMtlsErr != nil {
+			session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
+				session.cisMtlsErr)
+		}
+		if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+			session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisMtlsSession", session.cisMtlsClient.Service)
+			session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Bot Management
-	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
-	if session.cisBotManagementErr != nil {
-		session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
-			session.cisBotManagementErr)
-	}
-	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisBotManagementSession", func() {
+		cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
+		if session.cisBotManagementErr != nil {
+			session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
+				session.cisBotManagementErr)
+		}
+		if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
+			session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisBotManagementSession", session.cisBotManagementClient.Service)
+			session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Bot Analytics
-	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
-	if session.cisBotAnalyticsErr != nil {
-		session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
-			session.cisBotAnalyticsErr)
-	}
-	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisBotAnalyticsSession", func() {
+		cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
+		if session.cisBotAnalyticsErr != nil {
+			session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
+				session.cisBotAnalyticsErr)
+		}
+		if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
+			session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisBotAnalyticsSession", session.cisBotAnalyticsClient.Service)
+			session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Webhooks
-	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
-	if session.cisWebhooksErr != nil {
-		session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
-			session.cisWebhooksErr)
-	}
-	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisWebhookSession", func() {
+		cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
+		if session.cisWebhooksErr != nil {
+			session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
+				session.cisWebhooksErr)
+		}
+		if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+			session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisWebhookSession", session.cisWebhooksClient.Service)
+			session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 	// IBM Network CIS Filters
-	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
-	if session.cisFiltersErr != nil {
-		session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
-			session.cisFiltersErr)
-	}
-	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisFiltersSession", func() {
+		cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+		if session.cisFiltersErr != nil {
+			session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
+				session.cisFiltersErr)
+		}
+		if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+			session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisFiltersSession", session.cisFiltersClient.Service)
+			session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Firewall rules
-	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
-	if session.cisFirewallRulesErr != nil {
-		session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
-			session.cisFirewallRulesErr)
-	}
-	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisFirewallRulesSession", func() {
+		cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
+		if session.cisFirewallRulesErr != nil {
+			session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
+				session.cisFirewallRulesErr)
+		}
+		if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+			session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisFirewallRulesSession", session.cisFirewallRulesClient.Service)
+			session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Authenticated Origin Pull
-	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
+	session.lazy("CisOrigAuthSession", func() {
+		cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
 
-	session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
-	if session.cisOriginAuthPullErr != nil {
-		session.cisOriginAuthPullErr = fmt.Errorf(
-			"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
-			session.cisOriginAuthPullErr)
-	}
-	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
+		if session.cisOriginAuthPullErr != nil {
+			session.cisOriginAuthPullErr = fmt.Errorf(
+				"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
+				session.cisOriginAuthPullErr)
+		}
+		if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+			session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisOrigAuthSession", session.cisOriginAuthClient.Service)
+			session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IBM Network CIS Lists
-	cisListsOpt := &cislistsapiv1.ListsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-		ItemID:        core.StringPtr(""),
-		ListID:        core.StringPtr(""),
-		OperationID:   core.StringPtr(""),
-	}
-	session.cisListsClient, session.cisListsErr = cislistsapiv1.NewListsApiV1(cisListsOpt)
-	if session.cisListsErr != nil {
-		session.cisListsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Lists : %s",
-			session.cisListsErr)
-	}
-	if session.cisListsClient != nil && session.cisListsClient.Service != nil {
-		session.cisListsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisListsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("CisListsSession", func() {
+		cisListsOpt := &cislistsapiv1.ListsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+			ItemID:        core.StringPtr(""),
+			ListID:        core.StringPtr(""),
+			OperationID:   core.StringPtr(""),
+		}
+		session.cisListsClient, session.cisListsErr = cislistsapiv1.NewListsApiV1(cisListsOpt)
+		if session.cisListsErr != nil {
+			session.cisListsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Lists : %s",
+				session.cisListsErr)
+		}
+		if session.cisListsClient != nil && session.cisListsClient.Service != nil {
+			session.cisListsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CisListsSession", session.cisListsClient.Service)
+			session.cisListsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// IAM IDENTITY Service
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
@@ -3250,24 +3661,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
-	}
-	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamIdentityAPI = iamIdentityClient
+	iamIdenityURL = endpoints.url("IBMCLOUD_IAM_API_ENDPOINT", iamIdenityURL)
+	session.lazy("IAMIdentityV1API", func() {
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		}
+		iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
+		}
+		if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+			iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "IAMIdentityV1API", iamIdentityClient.Service)
+			iamIdentityClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamIdentityAPI = iamIdentityClient
+	})
 
 	// IAM POLICY MANAGEMENT Service
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
@@ -3278,24 +3690,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
-	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
-	}
-	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
-	if err != nil {
-		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
-	}
-	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamPolicyManagementAPI = iamPolicyManagementClient
+	iamPolicyManagementURL = endpoints.url("IBMCLOUD_IAM_API_ENDPOINT", iamPolicyManagementURL)
+	session.lazy("IAMPolicyManagementV1API", func() {
+		iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		}
+		iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
+		if err != nil {
+			session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
+		}
+		if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+			iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "IAMPolicyManagementV1API", iamPolicyManagementClient.Service)
+			iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamPolicyManagementAPI = iamPolicyManagementClient
+	})
 
 	// IAM ACCESS GROUP
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
@@ -3305,25 +3718,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 		} else {
 			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
-	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
-	}
-	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
-	if err != nil {
-		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
-	}
-	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamAccessGroupsAPI = iamAccessGroupsClient
+	}
+	iamAccessGroupsURL = endpoints.url("IBMCLOUD_IAM_API_ENDPOINT", iamAccessGroupsURL)
+	session.lazy("IAMAccessGroupsV2", func() {
+		iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		}
+		iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
+		if err != nil {
+			session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
+		}
+		if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+			iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "IAMAccessGroupsV2", iamAccessGroupsClient.Service)
+			iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamAccessGroupsAPI = iamAccessGroupsClient
+	})
 
 	// RESOURCE MANAGEMENT Service
 	rmURL := resourcemanager.DefaultServiceURL
@@ -3342,44 +3756,47 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rmURL = resourcemanager.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
+	rmURL = endpoints.url("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", rmURL)
+	session.lazy("ResourceManagerV2API", func() {
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		}
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
+		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ResourceManagerV2API", resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceManagerAPI = resourceManagerClient
+	})
 
 	// CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
-	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	cloudShellUrl = endpoints.url("IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", cloudShellUrl)
+	session.lazy("IBMCloudShellV1", func() {
+		var err error
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		}
+		session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+			session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "IBMCloudShellV1", session.ibmCloudShellClient.Service)
+			session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// ENTERPRISE Service
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
@@ -3399,24 +3816,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
+	enterpriseURL = endpoints.url("IBMCLOUD_ENTERPRISE_API_ENDPOINT", enterpriseURL)
+	session.lazy("EnterpriseManagementV1", func() {
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		}
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
+		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "EnterpriseManagementV1", enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.enterpriseManagementClient = enterpriseManagementClient
+	})
 
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
@@ -3435,45 +3853,50 @@ func (c *Config) ClientSession() (interface{}, error) {
 			rcURL = resourcecontroller.DefaultServiceURL
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
-
-	// Construct an instance of the 'DrAutomation Service' service.
-	if session.drAutomationServiceClientErr == nil {
-		// Construct the service options.
-		drAutomationServiceClientOptions := &drautomationservicev1.DrAutomationServiceV1Options{
+	rcURL = endpoints.url("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", rcURL)
+	session.lazy("ResourceControllerV2API", func() {
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
 		}
-
-		// Construct the service client.
-		session.drAutomationServiceClient, err = drautomationservicev1.NewDrAutomationServiceV1(drAutomationServiceClientOptions)
-		if err == nil {
-			// Enable retries for API calls
-			session.drAutomationServiceClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-			// Add custom header for analytics
-			session.drAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ResourceControllerV2API", resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
-		} else {
-			session.drAutomationServiceClientErr = fmt.Errorf("error occurred while constructing 'DrAutomation Service' service client: %q", err)
 		}
-	}
+		session.resourceControllerAPI = resourceControllerClient
+	})
+
+	// Construct an instance of the 'DrAutomation Service' service.
+	session.lazy("DrAutomationServiceV1", func() {
+		var err error
+		if session.drAutomationServiceClientErr == nil {
+			// Construct the service options.
+			drAutomationServiceClientOptions := &drautomationservicev1.DrAutomationServiceV1Options{
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.drAutomationServiceClient, err = drautomationservicev1.NewDrAutomationServiceV1(drAutomationServiceClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				session.drAutomationServiceClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+				wrapServiceTransport(middleware, "DrAutomationServiceV1", session.drAutomationServiceClient.Service)
+				// Add custom header for analytics
+				session.drAutomationServiceClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.drAutomationServiceClientErr = fmt.Errorf("error occurred while constructing 'DrAutomation Service' service client: %q", err)
+			}
+		}
+	})
 
 	// SECRETS MANAGER Service V2
 	// Construct an "options" struct for creating the service client.
@@ -3484,48 +3907,54 @@ func (c *Config) ClientSession() (interface{}, error) {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
 	}
 
-	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
-		Authenticator: authenticator,
-		URL:           smBaseUrl,
-	}
+	session.lazy("SecretsManagerV2", func() {
+		var err error
+		secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: authenticator,
+			URL:           smBaseUrl,
+		}
 
-	// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
-	if err == nil {
-		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
-	}
+		// Construct the service client.
+		session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
+		if err == nil {
+			// Enable retries for API calls
+			session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "SecretsManagerV2", session.secretsManagerClient.Service)
+			// Add custom header for analytics
+			session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
+		}
+	})
 
 	// SATELLITE Service
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
-	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
+	containerEndpoint = endpoints.url("IBMCLOUD_SATELLITE_API_ENDPOINT", containerEndpoint)
+	session.lazy("SatelliteClientSession", func() {
+		var err error
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
+		}
 
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// Enable retries for API calls
+		if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+			session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "SatelliteClientSession", session.satelliteClient.Service)
+			session.satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// SATELLITE LINK Service
 	// Construct an "options" struct for creating the service client.
@@ -3533,58 +3962,68 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
-	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	satelliteLinkEndpoint = endpoints.url("IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", satelliteLinkEndpoint)
+	session.lazy("SatellitLinkClientSession", func() {
+		var err error
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "SatellitLinkClientSession", session.satelliteLinkClient.Service)
+			// Add custom header for analytics
+			session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
-	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("ESschemaRegistrySession", func() {
+		var err error
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
+		}
+		session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+			session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ESschemaRegistrySession", session.esSchemaRegistryClient.Service)
+			session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
-		Authenticator: authenticator,
-	}
-	session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
-	if err != nil {
-		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
-	}
-	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
-		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("ESadminRestSession", func() {
+		var err error
+		esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
+			Authenticator: authenticator,
+		}
+		session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
+		if err != nil {
+			session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
+		}
+		if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+			session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "ESadminRestSession", session.esAdminRestClient.Service)
+			session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	// Construct an "options" struct for creating the service client.
 	var cdToolchainClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+	if url := endpoints.url("IBMCLOUD_TOOLCHAIN_ENDPOINT", ""); url != "" {
+		cdToolchainClientURL = url
 	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
 		if err != nil && c.Visibility == "public-and-private" {
@@ -3596,28 +4035,32 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
+	session.lazy("CdToolchainV2", func() {
+		var err error
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
+		}
 
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
+		// Construct the service client.
+		session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CdToolchainV2", session.cdToolchainClient.Service)
+			// Add custom header for analytics
+			session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
+	})
 
 	// Construct an "options" struct for creating the tekton pipeline service client.
 	var cdTektonPipelineClientURL string
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
+	if url := endpoints.url("IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", ""); url != "" {
+		cdTektonPipelineClientURL = url
 	} else if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
 		if err != nil && c.Visibility == "public-and-private" {
@@ -3629,121 +4072,137 @@ func (c *Config) ClientSession() (interface{}, error) {
 	if err != nil {
 		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
-	}
+	session.lazy("CdTektonPipelineV2", func() {
+		var err error
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
+		}
+		// Construct the service client.
+		session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CdTektonPipelineV2", session.cdTektonPipelineClient.Service)
+			// Add custom header for analytics
+			session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
+		}
+	})
 
 	// MQaaS Service Configuration
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
-	}
-
-	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
-	}
-
-	// Construct the service client for MQ SaaS.
-	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.mqcloudClientErr = fmt.Errorf("Error occurred while constructing 'MQ SaaS' service client: %q", err)
-	}
+	mqCloudURL = endpoints.url("IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", mqCloudURL)
 
-	// VMware Cloud Foundation as a Service
-	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
-	if session.vmwareClientErr == nil {
-		// Construct the service options.
-		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
-		vmwareClientOptions := &vmwarev1.VmwareV1Options{
+	session.lazy("MqcloudV1", func() {
+		var err error
+		mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
 			Authenticator: authenticator,
-			URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_URL"}, vmwareURL),
+			URL:           EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
 		}
 
-		// Construct the service client.
-		session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
+		// Construct the service client for MQ SaaS.
+		session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "MqcloudV1", session.mqcloudClient.Service)
 			// Add custom header for analytics
-			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+			session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
+			session.mqcloudClientErr = fmt.Errorf("Error occurred while constructing 'MQ SaaS' service client: %q", err)
 		}
-	}
+	})
+
+	// VMware Cloud Foundation as a Service
+	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
+	session.lazy("VmwareV1", func() {
+		var err error
+		if session.vmwareClientErr == nil {
+			// Construct the service options.
+			vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
+			vmwareClientOptions := &vmwarev1.VmwareV1Options{
+				Authenticator: authenticator,
+				URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_URL"}, vmwareURL),
+			}
+
+			// Construct the service client.
+			session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+				wrapServiceTransport(middleware, "VmwareV1", session.vmwareClient.Service)
+				// Add custom header for analytics
+				session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
+			}
+		}
+	})
 
 	// Construct the service options.
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
-	}
-	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
-		Version:       core.StringPtr("2025-01-10"),
-	}
-
-	// Construct the service client.
-	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
-	}
-
-	// Construct an instance of the 'sdsaas' service.
-	if session.sdsaasClientErr == nil {
-		// Construct the service options.
-		sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
+	codeEngineEndpoint = endpoints.url("IBMCLOUD_CODE_ENGINE_API_ENDPOINT", codeEngineEndpoint)
+	session.lazy("CodeEngineV2", func() {
+		var err error
+		codeEngineClientOptions := &codeengine.CodeEngineV2Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
+			Version:       core.StringPtr("2025-01-10"),
 		}
 
 		// Construct the service client.
-		session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
+		session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "CodeEngineV2", session.codeEngineClient.Service)
 			// Add custom header for analytics
-			session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
+			session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
+			session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 		}
-	}
+	})
+
+	// Construct an instance of the 'sdsaas' service.
+	session.lazy("SdsaasV1", func() {
+		var err error
+		if session.sdsaasClientErr == nil {
+			// Construct the service options.
+			sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+				wrapServiceTransport(middleware, "SdsaasV1", session.sdsaasClient.Service)
+				// Add custom header for analytics
+				session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
+			}
+		}
+	})
 
 	// CATALOG MANAGEMENT Service
 	globalcatalogURL := globalcatalogv1.DefaultServiceURL
@@ -3754,9 +4213,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			globalcatalogURL = ContructEndpoint("private.us-south.globalcatalog", fmt.Sprintf("%s", cloudEndpoint))
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalcatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", c.Region, globalcatalogURL)
-	}
+	globalcatalogURL = endpoints.url("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", globalcatalogURL)
 	gurl := EnvFallBack([]string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"}, globalcatalogURL)
 	parsedURL, err := url.Parse(gurl)
 	if err != nil {
@@ -3769,23 +4226,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 		gurl = gurl + globalURL.Path
 	}
-	globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
-		URL:           gurl,
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.globalCatalogClient, err = globalcatalogv1.NewGlobalCatalogV1(globalCatalogClientOptions)
-	if err != nil {
-		session.globalCatalogClientErr = fmt.Errorf("[ERROR] Error occurred while configuring global catalog API service: %q", err)
-	}
-	if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
-		// Enable retries for API calls
-		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("GlobalCatalogV1API", func() {
+		var err error
+		globalCatalogClientOptions := &globalcatalogv1.GlobalCatalogV1Options{
+			URL:           gurl,
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.globalCatalogClient, err = globalcatalogv1.NewGlobalCatalogV1(globalCatalogClientOptions)
+		if err != nil {
+			session.globalCatalogClientErr = fmt.Errorf("[ERROR] Error occurred while configuring global catalog API service: %q", err)
+		}
+		if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
+			// Enable retries for API calls
+			session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			wrapServiceTransport(middleware, "GlobalCatalogV1API", session.globalCatalogClient.Service)
+			// Add custom header for analytics
+			session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
 	if os.Getenv("TF_LOG") != "" {
 		logDestination := log.Writer()
@@ -3795,7 +4256,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// setting UserAgent for vpc-go-sdk common
 	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
+	return &session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3804,17 +4265,18 @@ func CreateVersionDate() *string {
 	return &version
 }
 
-func newSession(c *Config) (*Session, map[string]interface{}, error) {
+func newSession(c *Config, middleware *transportMiddleware, endpoints *serviceEndpoints) (*Session, error) {
 	ibmSession := &Session{}
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    c.RetryCount,
+		RetryWait:  c.RetryDelay,
+		HTTPClient: newHTTPClient(middleware, "SoftLayerSession", c.SoftLayerTimeout),
 	}
 
 	if c.IAMToken != "" {
@@ -3832,22 +4294,6 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 
 	var authenticator core.Authenticator
 	var err error
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
-		}
-	}
 	iamURL := EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, IAMURL)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3857,16 +4303,21 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
 		}
 	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	iamURL = endpoints.url("IBMCLOUD_IAM_API_ENDPOINT", iamURL)
+	authenticator, err = newComputeResourceAuthenticator(c, iamURL, middleware)
+	if err != nil {
+		return nil, err
 	}
-	if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
+	if authenticator != nil {
+		log.Printf("Configuring Session with the trusted profile of the %s compute resource", c.IAMComputeResource)
+	} else if (c.BluemixAPIKey != "") && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
 		if c.IAMTrustedProfileID != "" {
 			log.Println("Configuring Session with Trusted Profile ID")
 			authenticator, err = core.NewIamAssumeAuthenticatorBuilder().
 				SetApiKey(c.BluemixAPIKey).
 				SetIAMProfileID(c.IAMTrustedProfileID).
 				SetURL(iamURL).
+				SetClient(newHTTPClient(middleware, "IAMAuthenticator", 0)).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder. Error: %s", err)
@@ -3878,6 +4329,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				SetIAMProfileName(c.IAMTrustedProfileName).
 				SetIAMAccountID(c.Account).
 				SetURL(iamURL).
+				SetClient(newHTTPClient(middleware, "IAMAuthenticator", 0)).
 				Build()
 			if err != nil {
 				log.Fatalf("Error in authenticating using NewIamAssumeAuthenticatorBuilder with trusted profile name. Error: %s", err)
@@ -3891,6 +4343,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				URL:          iamURL,
 				ClientId:     "bx",
 				ClientSecret: "bx",
+				Client:       newHTTPClient(middleware, "IAMAuthenticator", 0),
 			}
 		} else {
 			// Construct the IamAuthenticator with the IAM refresh token.
@@ -3900,6 +4353,7 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 				ClientId:     "bx",
 				ClientSecret: "bx",
 				URL:          iamURL,
+				Client:       newHTTPClient(middleware, "IAMAuthenticator", 0),
 			}
 		}
 	} else if strings.HasPrefix(c.IAMToken, "Bearer") {
@@ -3914,6 +4368,14 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		}
 	}
 
+	if len(c.AssumeTrustedProfiles) > 0 {
+		log.Printf("Configuring Session with a chain of %d trusted profiles", len(c.AssumeTrustedProfiles))
+		authenticator, err = newTrustedProfileChain(c, authenticator, iamURL, middleware)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var sess *bxsession.Session
 	bmxConfig := &bluemix.Config{
 		IAMAccessToken:  c.IAMToken,
@@ -3931,14 +4393,16 @@ func newSession(c *Config) (*Session, map[string]interface{}, error) {
 		EndpointsFile:       c.EndpointsFile,
 		UserAgent:           fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
 		Authenticator:       authenticator,
+		HTTPClient:          newHTTPClient(middleware, "BluemixSession", c.BluemixTimeout),
 	}
 	sess, err = bxsession.New(bmxConfig)
 	if err != nil {
-		return nil, fileMap, err
+		return nil, err
 	}
+	sess.Config.EndpointLocator = endpoints.locator(sess.Config.EndpointLocator)
 	ibmSession.BluemixSession = sess
 
-	return ibmSession, fileMap, err
+	return ibmSession, err
 }
 
 /*func authenticateAPIKey(sess *bxsession.Session) error {
@@ -3972,6 +4436,7 @@ func authenticateAssume(sess *bxsession.Session) error {
 func fetchAuthorizationData(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -4027,6 +4492,7 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 func RefreshToken(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -4048,41 +4514,13 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	return defaultValue
 }
 
-func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := io.ReadAll(jsonFile)
-		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
-		}
-	}
-
-	return fileFallBack(fileMap, visibility, key, region, defaultValue)
-}
-
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
-	}
-	return defaultValue
-}
-
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
-	transport := &gohttp.Transport{
+	return wrapTransport(nil, "", defaultTransport())
+}
+
+func defaultTransport() gohttp.RoundTripper {
+	return &gohttp.Transport{
 		Proxy:               gohttp.ProxyFromEnvironment,
 		DisableKeepAlives:   true,
 		MaxIdleConnsPerHost: -1,
@@ -4090,7 +4528,6 @@ func DefaultTransport() gohttp.RoundTripper {
 			InsecureSkipVerify: false,
 		},
 	}
-	return transport
 }
 
 func isRetryable(err error) bool {
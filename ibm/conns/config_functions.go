@@ -27,7 +27,7 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(functionHTTPClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -35,6 +35,23 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
+// functionHTTPClient returns the client of the Bluemix session, so that the
+// calls to Cloud Functions go through the same middleware, e.g. the
+// http_trace, as the other Bluemix calls.
+func functionHTTPClient(c *bluemix.Config) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// NewOpenWhiskClient returns a Cloud Functions client configured with config
+// that goes through the same middleware as the Bluemix session sess, e.g.
+// for another namespace than the one of SetupOpenWhiskClientConfig.
+func NewOpenWhiskClient(sess *bxsession.Session, config *whisk.Config) (*whisk.Client, error) {
+	return whisk.NewClient(functionHTTPClient(sess.Config), config)
+}
+
 // getBaseURL ..
 func getBaseURL(region string) string {
 	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
@@ -54,7 +71,7 @@ func getBaseURL(region string) string {
  */
 func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", sess.Config.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(functionHTTPClient(sess.Config), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
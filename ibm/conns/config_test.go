@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"io"
+	gohttp "net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+func testIAMToken(t *testing.T) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-test",
+		"email":   "test@example.com",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "0123456789abcdef"},
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte("test-signing-key"))
+	if err != nil {
+		t.Fatalf("Error signing test IAM token: %s", err)
+	}
+	return signed
+}
+
+func testClientSession(t *testing.T) *clientSession {
+	for _, env := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+		"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+		"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH", "TF_LOG"} {
+		t.Setenv(env, "")
+	}
+	c := &Config{
+		IAMToken:       "Bearer " + testIAMToken(t),
+		Region:         "us-south",
+		BluemixTimeout: time.Minute,
+		RetryCount:     0,
+		RetryDelay:     time.Millisecond,
+	}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring client session: %s", err)
+	}
+	sess, ok := meta.(*clientSession)
+	if !ok {
+		t.Fatalf("Expected *clientSession, got %T", meta)
+	}
+	return sess
+}
+
+func TestClientSessionDefersClientConstruction(t *testing.T) {
+	sess := testClientSession(t)
+
+	if sess.vpcAPI != nil || sess.cisDNSRecordsClient != nil || sess.schematicsClient != nil {
+		t.Fatal("Expected service clients not to be constructed before they are requested")
+	}
+
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		t.Fatalf("Error getting VPC client: %s", err)
+	}
+	if vpcClient == nil {
+		t.Fatal("Expected VPC client to be constructed on first use")
+	}
+	if again, _ := sess.VpcV1API(); again != vpcClient {
+		t.Fatal("Expected VPC client to be constructed only once")
+	}
+
+	if sess.cisDNSRecordsClient != nil || sess.schematicsClient != nil || sess.vpcBetaAPI != nil {
+		t.Fatal("Expected requesting one service not to construct the others")
+	}
+}
+
+func TestClientSessionConcurrentFirstUse(t *testing.T) {
+	sess := testClientSession(t)
+
+	var wg sync.WaitGroup
+	clients := make(chan interface{}, 10)
+	for i := 0; i < cap(clients); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, _ := sess.IAMIdentityV1API()
+			clients <- client
+		}()
+	}
+	wg.Wait()
+	close(clients)
+
+	first := <-clients
+	for client := range clients {
+		if client != first {
+			t.Fatal("Expected concurrent callers to share a single IAM Identity client")
+		}
+	}
+}
+
+type roundTripperFunc func(*gohttp.Request) (*gohttp.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	return f(req)
+}
+
+func TestClientSessionAcceptanceTestTransport(t *testing.T) {
+	var requests []string
+	AcceptanceTestTransport = func(next gohttp.RoundTripper) gohttp.RoundTripper {
+		return roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			return &gohttp.Response{
+				StatusCode: gohttp.StatusOK,
+				Header:     gohttp.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"vpcs": []}`)),
+				Request:    req,
+			}, nil
+		})
+	}
+	defer func() { AcceptanceTestTransport = nil }()
+
+	sess := testClientSession(t)
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		t.Fatalf("Error getting VPC client: %s", err)
+	}
+	if _, _, err := vpcClient.ListVpcs(&vpc.ListVpcsOptions{}); err != nil {
+		t.Fatalf("Error listing VPCs: %s", err)
+	}
+	if len(requests) != 1 || requests[0] != "GET /v1/vpcs" {
+		t.Fatalf("Expected the VPC request to go through the acceptance test transport, got %v", requests)
+	}
+
+	if sess.SoftLayerSession().HTTPClient == nil {
+		t.Error("Expected the SoftLayer session to use the acceptance test transport")
+	}
+	bxSession, err := sess.BluemixSession()
+	if err != nil {
+		t.Fatalf("Error getting Bluemix session: %s", err)
+	}
+	if bxSession.Config.HTTPClient == nil {
+		t.Error("Expected the Bluemix session to use the acceptance test transport")
+	}
+}
+
+func TestClientSessionKeyManagementAPIError(t *testing.T) {
+	t.Setenv("IBMCLOUD_KP_API_ENDPOINT", "")
+	sess := testClientSession(t)
+
+	client, err := sess.KeyManagementAPI()
+	if err != nil {
+		t.Fatalf("Error getting key management client: %s", err)
+	}
+	if client == nil {
+		t.Fatal("Expected a key management client")
+	}
+
+	// The client is rebuilt on every call, from an endpoint that may have
+	// changed since the session was configured.
+	t.Setenv("IBMCLOUD_KP_API_ENDPOINT", "https://[kms")
+	client, err = sess.KeyManagementAPI()
+	if err == nil {
+		t.Fatal("Expected an error for an endpoint that is not a URL")
+	}
+	if client != nil {
+		t.Fatalf("Expected no client with the error, got %v", client)
+	}
+	if _, again := sess.KeyManagementAPI(); again == nil {
+		t.Fatal("Expected the error to be kept for later calls")
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+// EndpointServices maps the arguments of the endpoints block of the provider
+// to the keys of the services they override. The keys are the same as those
+// of the endpoints file and of the environment variables.
+var EndpointServices = map[string]string{
+	"account_management":         "IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"app_config":                 "IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"appid":                      "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"atracker":                   "IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"backup_recovery":            "IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"backup_recovery_connector":  "IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"backup_recovery_manager":    "IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT",
+	"catalog_management":         "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"certificate_manager":        "IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"cis":                        "IBMCLOUD_CIS_API_ENDPOINT",
+	"cloud_shell":                "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"code_engine":                "IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"container":                  "IBMCLOUD_CS_API_ENDPOINT",
+	"container_registry":         "IBMCLOUD_CR_API_ENDPOINT",
+	"context_based_restrictions": "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"cos_config":                 "IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"directlink":                 "IBMCLOUD_DL_API_ENDPOINT",
+	"directlink_provider":        "IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"enterprise":                 "IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"event_notifications":        "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"functions":                  "IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"global_search":              "IBMCLOUD_GS_API_ENDPOINT",
+	"global_tagging":             "IBMCLOUD_GT_API_ENDPOINT",
+	"hpcs":                       "IBMCLOUD_HPCS_API_ENDPOINT",
+	"iam":                        "IBMCLOUD_IAM_API_ENDPOINT",
+	"iam_pap":                    "IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"icd":                        "IBMCLOUD_ICD_API_ENDPOINT",
+	"kms":                        "IBMCLOUD_KP_API_ENDPOINT",
+	"logs":                       "IBMCLOUD_LOGS_API_ENDPOINT",
+	"logs_routing":               "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT",
+	"metrics_routing":            "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"mqcloud":                    "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"partner_center_sell":        "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"private_dns":                "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"project":                    "IBMCLOUD_PROJECT_API_ENDPOINT",
+	"push":                       "IBMCLOUD_PUSH_API_ENDPOINT",
+	"resource_catalog":           "IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"resource_controller":        "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"resource_manager":           "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"satellite":                  "IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"satellite_link":             "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"schematics":                 "IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"tekton_pipeline":            "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"toolchain":                  "IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"transit_gateway":            "IBMCLOUD_TG_API_ENDPOINT",
+	"uaa":                        "IBMCLOUD_UAA_ENDPOINT",
+	"usage_reports":              "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"user_management":            "IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+	"vpc":                        "IBMCLOUD_IS_NG_API_ENDPOINT",
+}
+
+// otherEndpointKeys are the keys of the endpoints file that are read for
+// services that cannot be overridden in the endpoints block.
+var otherEndpointKeys = []string{
+	"IBMCLOUD_CF_API_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CSE_ENDPOINT",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_SAT_API_ENDPOINT",
+}
+
+// endpointVisibilities are the visibilities of the endpoints file.
+var endpointVisibilities = []string{"public", "private", "public-and-private"}
+
+// endpointRegion matches the regions and locations of the endpoints file,
+// e.g. us-south, eu-de-1, mon01 or us-geo.
+var endpointRegion = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var (
+	endpointsFilesMutex sync.Mutex
+	// endpointsFiles holds the endpoints files that were parsed, by path.
+	endpointsFiles = map[string]*endpointsFile{}
+)
+
+// endpointsFile is a parsed endpoints file. It maps the key of a service to
+// its URLs by visibility and region.
+type endpointsFile struct {
+	urls     map[string]map[string]map[string]string
+	warnings []string
+	err      error
+}
+
+// EndpointsFilePath returns the path of the endpoints file of the provider,
+// which the environment overrides.
+func EndpointsFilePath(endpointsFile string) string {
+	return EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile)
+}
+
+// ValidateEndpointsFile parses the endpoints file at path and returns the
+// warnings about its unknown keys, visibilities and regions, or the error
+// that makes it unusable.
+func ValidateEndpointsFile(path string) ([]string, error) {
+	file := loadEndpointsFile(path)
+	if file == nil {
+		return nil, nil
+	}
+	return file.warnings, file.err
+}
+
+// loadEndpointsFile returns the endpoints file at path, which is parsed the
+// first time it is loaded. It returns nil when path is empty.
+func loadEndpointsFile(path string) *endpointsFile {
+	if path == "" {
+		return nil
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	endpointsFilesMutex.Lock()
+	defer endpointsFilesMutex.Unlock()
+	if file, ok := endpointsFiles[path]; ok {
+		return file
+	}
+	file := parseEndpointsFile(path)
+	endpointsFiles[path] = file
+	return file
+}
+
+func parseEndpointsFile(path string) *endpointsFile {
+	file := &endpointsFile{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		file.err = fmt.Errorf("[ERROR] Unable to read the endpoints file: %s", err)
+		return file
+	}
+	var services map[string]map[string]map[string]interface{}
+	if err := json.Unmarshal(data, &services); err != nil {
+		file.err = fmt.Errorf("[ERROR] Unable to parse the endpoints file %s, expected an object of services, visibilities and regions: %s", path, err)
+		return file
+	}
+
+	known := map[string]bool{}
+	for _, key := range EndpointServices {
+		known[key] = true
+	}
+	for _, key := range otherEndpointKeys {
+		known[key] = true
+	}
+
+	file.urls = map[string]map[string]map[string]string{}
+	for _, key := range sortedKeys(services) {
+		if !known[key] {
+			file.warnings = append(file.warnings, fmt.Sprintf("Unknown service %q in the endpoints file %s", key, path))
+		}
+		file.urls[key] = map[string]map[string]string{}
+		for _, visibility := range sortedKeys(services[key]) {
+			if !contains(endpointVisibilities, visibility) {
+				file.warnings = append(file.warnings, fmt.Sprintf("Unknown visibility %q of %s in the endpoints file %s, expected one of %v", visibility, key, path, endpointVisibilities))
+			}
+			file.urls[key][visibility] = map[string]string{}
+			for _, region := range sortedKeys(services[key][visibility]) {
+				url, ok := services[key][visibility][region].(string)
+				if !ok {
+					file.err = fmt.Errorf("[ERROR] The %s endpoint of %s in %s in the endpoints file %s must be a string", visibility, key, region, path)
+					return file
+				}
+				if !endpointRegion.MatchString(region) {
+					file.warnings = append(file.warnings, fmt.Sprintf("Unexpected region %q of %s in the endpoints file %s", region, key, path))
+				}
+				file.urls[key][visibility][region] = url
+			}
+		}
+	}
+	return file
+}
+
+// url returns the URL of the service with key in region for visibility, or
+// defaultValue when the file has none.
+func (f *endpointsFile) url(visibility, key, region, defaultValue string) string {
+	if f == nil || f.err != nil {
+		return defaultValue
+	}
+	if url := f.urls[key][visibility][region]; url != "" {
+		return url
+	}
+	return defaultValue
+}
+
+// serviceEndpoints resolves the endpoints of the services from the endpoints
+// block of the provider and from the endpoints file. The environment
+// variables of the services take precedence over both.
+type serviceEndpoints struct {
+	visibility string
+	region     string
+	overrides  map[string]string
+	file       *endpointsFile
+}
+
+func newServiceEndpoints(c *Config) (*serviceEndpoints, error) {
+	e := &serviceEndpoints{
+		visibility: c.Visibility,
+		region:     c.Region,
+		overrides:  map[string]string{},
+		file:       loadEndpointsFile(EndpointsFilePath(c.EndpointsFile)),
+	}
+	if e.file != nil && e.file.err != nil {
+		return nil, e.file.err
+	}
+	for service, url := range c.Endpoints {
+		key, ok := EndpointServices[service]
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] Unknown service %q in the endpoints of the provider", service)
+		}
+		if url != "" {
+			e.overrides[key] = url
+		}
+	}
+	return e, nil
+}
+
+// url returns the endpoint of the service with key in the region and for the
+// visibility of the provider.
+func (e *serviceEndpoints) url(key, defaultValue string) string {
+	return e.lookup(key, e.visibility, e.region, defaultValue)
+}
+
+// lookup returns the endpoint of the service with key: its override, else its
+// URL in region for visibility in the endpoints file, else defaultValue. The
+// endpoints file is not read when the visibility is public-and-private.
+func (e *serviceEndpoints) lookup(key, visibility, region, defaultValue string) string {
+	if url := e.overrides[key]; url != "" {
+		return url
+	}
+	if visibility == "public-and-private" {
+		return defaultValue
+	}
+	return e.file.url(visibility, key, region, defaultValue)
+}
+
+// ServiceEndpoint returns the endpoint of the service with key in region for
+// visibility the way the clients of ClientSession resolve theirs, for the
+// resources that build the endpoints of their own regions or instances: its
+// URL in the endpoints block of the provider, else in the endpoints file,
+// else defaultValue.
+func ServiceEndpoint(sess *bxsession.Session, visibility, key, region, defaultValue string) string {
+	if l, ok := sess.Config.EndpointLocator.(*endpointLocator); ok {
+		return l.endpoints.lookup(key, visibility, region, defaultValue)
+	}
+	e := &serviceEndpoints{file: loadEndpointsFile(EndpointsFilePath(sess.Config.EndpointsFile))}
+	return e.lookup(key, visibility, region, defaultValue)
+}
+
+// locator returns an endpoint locator of the Bluemix session that applies the
+// overrides to the services it locates.
+func (e *serviceEndpoints) locator(next endpoints.EndpointLocator) endpoints.EndpointLocator {
+	return &endpointLocator{EndpointLocator: next, endpoints: e}
+}
+
+// endpointLocator overrides the endpoints that the locator of the Bluemix
+// session finds from the environment, the endpoints file and the region.
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	endpoints *serviceEndpoints
+}
+
+func (l *endpointLocator) locate(key string, next func() (string, error)) (string, error) {
+	if url := l.endpoints.overrides[key]; url != "" && os.Getenv(key) == "" {
+		return url, nil
+	}
+	return next()
+}
+
+func (l *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointLocator) ContainerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointLocator) CisEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointLocator) IAMEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointLocator) ICDEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointLocator) UAAEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointLocator) SchematicsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointLocator) UserManagementEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointLocator) HpcsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointLocator) FunctionsEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *endpointLocator) SatelliteEndpoint() (string, error) {
+	return l.locate("IBMCLOUD_SATELLITE_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing the endpoints file: %s", err)
+	}
+	return path
+}
+
+func TestValidateEndpointsFile(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://us-south.private.iaas.example.com/v1"}},
+		"IBMCLOUD_VPC_API_ENDPOINT": {"public": {"us-south": "https://us-south.iaas.example.com/v1"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"privat": {"us-south": "https://private.iam.example.com"}, "public": {"US South": "https://iam.example.com"}}
+	}`)
+	warnings, err := ValidateEndpointsFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("Expected 3 warnings, got %q", warnings)
+	}
+	for i, expected := range []string{`visibility "privat"`, `region "US South"`, `service "IBMCLOUD_VPC_API_ENDPOINT"`} {
+		if !strings.Contains(warnings[i], expected) {
+			t.Errorf("Expected warning %d to be about %s, got %q", i, expected, warnings[i])
+		}
+	}
+
+	for name, content := range map[string]string{
+		"not JSON":         `{"IBMCLOUD_IAM_API_ENDPOINT":`,
+		"not an object":    `["IBMCLOUD_IAM_API_ENDPOINT"]`,
+		"no visibilities":  `{"IBMCLOUD_IAM_API_ENDPOINT": "https://iam.example.com"}`,
+		"URL not a string": `{"IBMCLOUD_IAM_API_ENDPOINT": {"private": {"us-south": 443}}}`,
+	} {
+		if _, err := ValidateEndpointsFile(writeEndpointsFile(t, content)); err == nil {
+			t.Errorf("Expected an error for an endpoints file that is %s", name)
+		}
+	}
+	if _, err := ValidateEndpointsFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing endpoints file")
+	}
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://file.vpc.example.com/v1"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"private": {"us-south": "https://file.iam.example.com"}}
+	}`)
+	c := &Config{
+		Region:        "us-south",
+		Visibility:    "private",
+		EndpointsFile: path,
+		Endpoints:     map[string]string{"iam": "https://inline.iam.example.com", "kms": ""},
+	}
+	e, err := newServiceEndpoints(c)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for key, expected := range map[string]string{
+		"IBMCLOUD_IAM_API_ENDPOINT":   "https://inline.iam.example.com",
+		"IBMCLOUD_IS_NG_API_ENDPOINT": "https://file.vpc.example.com/v1",
+		"IBMCLOUD_KP_API_ENDPOINT":    "https://default.example.com",
+	} {
+		if url := e.url(key, "https://default.example.com"); url != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, key, url)
+		}
+	}
+
+	c.Visibility = "public-and-private"
+	e, _ = newServiceEndpoints(c)
+	if url := e.url("IBMCLOUD_IS_NG_API_ENDPOINT", "https://default.example.com"); url != "https://default.example.com" {
+		t.Errorf("Expected the endpoints file to be ignored for public-and-private, got %s", url)
+	}
+	if url := e.url("IBMCLOUD_IAM_API_ENDPOINT", "https://default.example.com"); url != "https://inline.iam.example.com" {
+		t.Errorf("Expected the endpoints block to apply to public-and-private, got %s", url)
+	}
+
+	c.Endpoints = map[string]string{"vpcs": "https://vpc.example.com"}
+	if _, err := newServiceEndpoints(c); err == nil {
+		t.Error("Expected an unknown service of the endpoints block to be rejected")
+	}
+	c.Endpoints = nil
+	c.EndpointsFile = writeEndpointsFile(t, `{`)
+	if _, err := newServiceEndpoints(c); err == nil {
+		t.Error("Expected an endpoints file that cannot be parsed to be rejected")
+	}
+}
+
+func TestEndpointLocator(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IBMCLOUD_IAM_API_ENDPOINT", "")
+	t.Setenv("IBMCLOUD_CS_API_ENDPOINT", "")
+	e, err := newServiceEndpoints(&Config{
+		Region:     "us-south",
+		Visibility: "public",
+		Endpoints:  map[string]string{"container": "https://containers.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	locator := e.locator(endpoints.NewEndpointLocator("us-south", "public", ""))
+	if url, _ := locator.ContainerEndpoint(); url != "https://containers.example.com" {
+		t.Errorf("Expected the endpoints block to override the container endpoint, got %s", url)
+	}
+	if url, _ := locator.IAMEndpoint(); url != "https://iam.cloud.ibm.com" {
+		t.Errorf("Expected the default IAM endpoint, got %s", url)
+	}
+
+	t.Setenv("IBMCLOUD_CS_API_ENDPOINT", "https://env.containers.example.com")
+	if url, _ := locator.ContainerEndpoint(); url != "https://env.containers.example.com" {
+		t.Errorf("Expected the environment to take precedence over the endpoints block, got %s", url)
+	}
+}
+
+func TestServiceEndpoint(t *testing.T) {
+	t.Setenv("IBMCLOUD_ENDPOINTS_FILE_PATH", "")
+	t.Setenv("IC_ENDPOINTS_FILE_PATH", "")
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_COS_ENDPOINT": {"private": {"us-geo": "s3.private.us.example.com"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"private": {"eu-de": "https://file.iam.example.com"}}
+	}`)
+	e, err := newServiceEndpoints(&Config{
+		Region:        "us-south",
+		Visibility:    "public",
+		EndpointsFile: path,
+		Endpoints:     map[string]string{"logs_routing": "https://logs-router.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	sess := &bxsession.Session{Config: &bluemix.Config{
+		EndpointsFile:   path,
+		EndpointLocator: e.locator(endpoints.NewEndpointLocator("us-south", "public", path)),
+	}}
+	if url := ServiceEndpoint(sess, "private", "IBMCLOUD_COS_ENDPOINT", "us-geo", "default"); url != "s3.private.us.example.com" {
+		t.Errorf("Expected the URL of the endpoints file in the region and visibility of the resource, got %s", url)
+	}
+	if url := ServiceEndpoint(sess, "public", "IBMCLOUD_IAM_API_ENDPOINT", "eu-de", "default"); url != "default" {
+		t.Errorf("Expected the default URL, got %s", url)
+	}
+	if url := ServiceEndpoint(sess, "private", "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", "eu-de", "default"); url != "https://logs-router.example.com" {
+		t.Errorf("Expected the endpoints block to override the endpoints file, got %s", url)
+	}
+	if url := ServiceEndpoint(sess, "public-and-private", "IBMCLOUD_COS_ENDPOINT", "us-geo", "default"); url != "default" {
+		t.Errorf("Expected the endpoints file to be ignored for public-and-private, got %s", url)
+	}
+
+	sess = &bxsession.Session{Config: &bluemix.Config{EndpointsFile: path}}
+	if url := ServiceEndpoint(sess, "private", "IBMCLOUD_COS_ENDPOINT", "us-geo", "default"); url != "s3.private.us.example.com" {
+		t.Errorf("Expected the URL of the endpoints file of a session without the endpoints of the provider, got %s", url)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import "sync"
+
+// lazyClient defers the construction of a service client until the first
+// time it is requested through the ClientSession.
+type lazyClient struct {
+	once sync.Once
+	init func()
+}
+
+// lazy registers init as the constructor of the client served by accessor.
+// init runs at most once, on the first call to load for the same accessor.
+func (sess *clientSession) lazy(accessor string, init func()) {
+	if sess.clients == nil {
+		sess.clients = make(map[string]*lazyClient)
+	}
+	sess.clients[accessor] = &lazyClient{init: init}
+}
+
+// load constructs the client served by accessor if it has not been built yet.
+// Accessors without a registered constructor (e.g. when no credentials were
+// configured) keep returning the error recorded by ClientSession.
+func (sess *clientSession) load(accessor string) {
+	if client, ok := sess.clients[accessor]; ok {
+		client.once.Do(client.init)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is the rate_limit of the provider.
+type RateLimit struct {
+	// RequestsPerSecond is the rate of the HTTP calls of all the clients.
+	// There is no limit when it is 0.
+	RequestsPerSecond float64
+
+	// Burst is the number of calls that can be made at once. It defaults to
+	// the rate, rounded up.
+	Burst int
+
+	// PerService limits the rate of the clients whose names, as in the
+	// records of the http_trace, start with a key, e.g. "vpc" for VpcV1API
+	// or "cis" for every CIS client. The clients of a key share its limit,
+	// and the longest key that a client name starts with applies.
+	PerService map[string]float64
+}
+
+const (
+	// rateLimitBaseDelay is the delay before the first retry of a throttled
+	// call without a Retry-After header. It doubles with each retry.
+	rateLimitBaseDelay = time.Second
+
+	// rateLimitMaxDelay is the longest delay before a retry.
+	rateLimitMaxDelay = time.Minute
+
+	// rateLimitRecovery is the fraction of its configured rate that a
+	// throttled limiter recovers with each call that is not throttled, and
+	// the lowest fraction it is slowed down to.
+	rateLimitRecovery = 16
+)
+
+// rateLimiter limits the rate of the HTTP calls of the clients of a
+// ClientSession, and retries the calls that are throttled.
+type rateLimiter struct {
+	global     *adaptiveLimiter
+	services   map[string]*adaptiveLimiter
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// newRateLimiter returns the rate limiter of limit, or nil when limit is nil.
+// Throttled calls are retried up to maxRetries times.
+func newRateLimiter(limit *RateLimit, maxRetries int) *rateLimiter {
+	if limit == nil {
+		return nil
+	}
+	l := &rateLimiter{
+		global:     newAdaptiveLimiter(limit.RequestsPerSecond, limit.Burst),
+		services:   map[string]*adaptiveLimiter{},
+		maxRetries: maxRetries,
+		baseDelay:  rateLimitBaseDelay,
+		maxDelay:   rateLimitMaxDelay,
+	}
+	for service, requestsPerSecond := range limit.PerService {
+		l.services[strings.ToLower(service)] = newAdaptiveLimiter(requestsPerSecond, limit.Burst)
+	}
+	return l
+}
+
+// wrap returns a transport that limits the rate of the calls that service
+// sends through next.
+func (l *rateLimiter) wrap(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	t := &rateLimitedTransport{limiter: l, service: service, next: next}
+	longest := -1
+	for prefix, limiter := range l.services {
+		if strings.HasPrefix(strings.ToLower(service), prefix) && len(prefix) > longest {
+			t.serviceLimiter = limiter
+			longest = len(prefix)
+		}
+	}
+	return t
+}
+
+// backoff returns the delay before the retry of a call that was throttled
+// attempt times before: the delay of the Retry-After header of resp, else a
+// delay that doubles with each attempt, with jitter.
+func (l *rateLimiter) backoff(attempt int, resp *gohttp.Response) time.Duration {
+	if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+		return time.Duration(math.Min(float64(delay), float64(l.maxDelay)))
+	}
+	delay := math.Min(float64(l.baseDelay)*math.Pow(2, float64(attempt)), float64(l.maxDelay))
+	return time.Duration(delay/2 + rand.Float64()*delay/2)
+}
+
+// retryAfter parses a Retry-After header, which holds either a number of
+// seconds or a date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(header); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isThrottled reports whether the API rejected a call because of its rate.
+func isThrottled(resp *gohttp.Response) bool {
+	return resp.StatusCode == gohttp.StatusTooManyRequests ||
+		resp.StatusCode == gohttp.StatusServiceUnavailable && resp.Header.Get("Retry-After") != ""
+}
+
+// skipThrottled returns a retry policy of go-sdk-core services that leaves
+// the throttled calls to the rate limiter.
+func skipThrottled(check retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	if check == nil {
+		check = retryablehttp.DefaultRetryPolicy
+	}
+	return func(ctx context.Context, resp *gohttp.Response, err error) (bool, error) {
+		if err == nil && resp != nil && isThrottled(resp) {
+			return false, nil
+		}
+		return check(ctx, resp, err)
+	}
+}
+
+type rateLimitedTransport struct {
+	limiter        *rateLimiter
+	service        string
+	serviceLimiter *adaptiveLimiter
+	next           gohttp.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.global.wait(ctx); err != nil {
+			return nil, err
+		}
+		if err := t.serviceLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		if !isThrottled(resp) {
+			t.limiter.global.succeeded()
+			t.serviceLimiter.succeeded()
+			return resp, nil
+		}
+		t.limiter.global.throttled()
+		t.serviceLimiter.throttled()
+
+		rewindable := req.Body == nil || req.Body == gohttp.NoBody || req.GetBody != nil
+		if attempt >= t.limiter.maxRetries || !rewindable {
+			return resp, nil
+		}
+		delay := t.limiter.backoff(attempt, resp)
+		log.Printf("[DEBUG] %s %s of %s was throttled with status %d, retrying in %s", req.Method, RedactURL(req.URL.String()), t.service, resp.StatusCode, delay)
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// adaptiveLimiter is a token bucket whose rate is halved each time a call is
+// throttled, and recovers as calls succeed.
+type adaptiveLimiter struct {
+	mutex   sync.Mutex
+	limiter *rate.Limiter
+	max     rate.Limit
+}
+
+// newAdaptiveLimiter returns a limiter of requestsPerSecond, or nil when
+// there is no limit.
+func newAdaptiveLimiter(requestsPerSecond float64, burst int) *adaptiveLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	return &adaptiveLimiter{
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst),
+		max:     rate.Limit(requestsPerSecond),
+	}
+}
+
+func (l *adaptiveLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	return l.limiter.Wait(ctx)
+}
+
+func (l *adaptiveLimiter) throttled() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	limit := l.limiter.Limit() / 2
+	if floor := l.max / rateLimitRecovery; limit < floor {
+		limit = floor
+	}
+	l.limiter.SetLimit(limit)
+}
+
+func (l *adaptiveLimiter) succeeded() {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if limit := l.limiter.Limit(); limit < l.max {
+		l.limiter.SetLimit(min(limit+l.max/rateLimitRecovery, l.max))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	gohttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func throttlingAPI(t *testing.T, throttled int, calls *int) gohttp.RoundTripper {
+	return roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		*calls++
+		if req.Body != nil {
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != `{"name":"vpc"}` {
+				t.Errorf("Expected the body to be sent with every call, got %q", body)
+			}
+		}
+		resp := &gohttp.Response{
+			StatusCode: gohttp.StatusOK,
+			Header:     gohttp.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Request:    req,
+		}
+		if *calls <= throttled {
+			resp.StatusCode = gohttp.StatusTooManyRequests
+			resp.Header.Set("Retry-After", "0")
+		}
+		return resp, nil
+	})
+}
+
+func TestRateLimiterRetriesThrottledCalls(t *testing.T) {
+	limiter := newRateLimiter(&RateLimit{RequestsPerSecond: 1000}, 3)
+	calls := 0
+	transport := limiter.wrap("VpcV1API", throttlingAPI(t, 2, &calls))
+
+	req, _ := gohttp.NewRequest(gohttp.MethodPost, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", strings.NewReader(`{"name":"vpc"}`))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if resp.StatusCode != gohttp.StatusOK || calls != 3 {
+		t.Errorf("Expected the call to succeed on the third attempt, got %d after %d calls", resp.StatusCode, calls)
+	}
+	if limit := limiter.global.limiter.Limit(); limit >= 1000 || limit < 1000/rateLimitRecovery {
+		t.Errorf("Expected the throttled rate to be slowed down, got %v", limit)
+	}
+
+	calls = 0
+	transport = limiter.wrap("VpcV1API", throttlingAPI(t, 10, &calls))
+	req, _ = gohttp.NewRequest(gohttp.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	resp, err = transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if resp.StatusCode != gohttp.StatusTooManyRequests || calls != 4 {
+		t.Errorf("Expected the throttled response after 3 retries, got %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRateLimiterLimitsRate(t *testing.T) {
+	limiter := newRateLimiter(&RateLimit{PerService: map[string]float64{"vpc": 50, "vpcv1beta": 1000}, Burst: 1}, 0)
+	calls := 0
+	for service, expected := range map[string]rate.Limit{"VpcV1API": 50, "VpcV1BetaAPI": 1000, "CisZonesV1ClientSession": 0} {
+		transport := limiter.wrap(service, throttlingAPI(t, 0, &calls)).(*rateLimitedTransport)
+		var limit rate.Limit
+		if transport.serviceLimiter != nil {
+			limit = transport.serviceLimiter.max
+		}
+		if limit != expected {
+			t.Errorf("Expected the limit of %s to be %v, got %v", service, expected, limit)
+		}
+	}
+
+	transport := limiter.wrap("VpcV1API", throttlingAPI(t, 0, &calls))
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		req, _ := gohttp.NewRequest(gohttp.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected 6 calls at 50 per second to take at least 100ms, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := gohttp.NewRequestWithContext(ctx, gohttp.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Error("Expected a canceled call to fail")
+	}
+}
+
+func TestRateLimiterBackoff(t *testing.T) {
+	limiter := newRateLimiter(&RateLimit{}, 10)
+	resp := &gohttp.Response{Header: gohttp.Header{"Retry-After": {"7"}}}
+	if delay := limiter.backoff(0, resp); delay != 7*time.Second {
+		t.Errorf("Expected the delay of Retry-After, got %s", delay)
+	}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(gohttp.TimeFormat))
+	if delay := limiter.backoff(0, resp); delay != rateLimitMaxDelay {
+		t.Errorf("Expected the delay of a Retry-After date to be capped, got %s", delay)
+	}
+	resp.Header.Del("Retry-After")
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if delay := limiter.backoff(attempt, resp); delay < max/2 || delay > max {
+			t.Errorf("Expected the delay of attempt %d to be between %s and %s, got %s", attempt, max/2, max, delay)
+		}
+	}
+	if delay := limiter.backoff(20, resp); delay > rateLimitMaxDelay {
+		t.Errorf("Expected the delay to be capped, got %s", delay)
+	}
+}
+
+func TestSkipThrottled(t *testing.T) {
+	check := skipThrottled(nil)
+	if retry, _ := check(context.Background(), &gohttp.Response{StatusCode: gohttp.StatusTooManyRequests}, nil); retry {
+		t.Error("Expected a throttled call to be left to the rate limiter")
+	}
+	if retry, _ := check(context.Background(), &gohttp.Response{StatusCode: gohttp.StatusBadGateway}, nil); !retry {
+		t.Error("Expected a server error to be retried")
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	if limiter := newRateLimiter(nil, 10); limiter != nil {
+		t.Errorf("Expected no rate limiter without a rate_limit, got %v", limiter)
+	}
+	if transport := wrapTransport(&transportMiddleware{limiter: newRateLimiter(&RateLimit{}, 10)}, "VpcV1API", gohttp.DefaultTransport); transport == gohttp.DefaultTransport {
+		t.Error("Expected the transport to be wrapped by the rate limiter")
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	gohttp "net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces the secrets redacted from the API traffic that is
+// traced or recorded.
+const Redacted = "REDACTED"
+
+var (
+	// secretHeaders are the headers that carry credentials.
+	secretHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+		"Refresh-Token",
+		"Set-Cookie",
+		"X-Api-Key",
+		"X-Auth-Refresh-Token",
+		"X-Auth-Token",
+		"X-Auth-User-Token",
+	}
+
+	// secretKey matches the names of JSON fields, form fields and query
+	// parameters that hold secrets, e.g. apikey, password, client_secret or
+	// refresh_token, but not token_type. It also matches the payload of
+	// Secrets Manager secrets and Key Protect keys, the plaintext that Key
+	// Protect wraps and unwraps, and kubeconfigs.
+	secretKey = regexp.MustCompile(`(?i)^(.*[_-])?(api_?key|apikey|password|passphrase|secret|private_?key|token|payload|plaintext|kube_?config)$`)
+
+	// secretDataKeys are the fields of the Secrets Manager objects that can
+	// hold the data of a key-value secret. data is only a secret in those
+	// objects: other APIs, e.g. CIS DNS records, use it for plain values.
+	secretDataKeys = map[string]bool{
+		"data":                    true,
+		"custom_metadata":         true,
+		"version_custom_metadata": true,
+	}
+
+	// kubeconfigSecret matches the credentials of a kubeconfig.
+	kubeconfigSecret = regexp.MustCompile(`(?m)^(\s*(?:- )?(?:client-key-data|token|id-token|refresh-token|client-secret|password):[ \t]*)\S.*$`)
+
+	// privateKeyPEM matches a PEM encoded private key.
+	privateKeyPEM = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[^-]*-----END [A-Z ]*PRIVATE KEY-----`)
+
+	// jwtPattern matches a JSON web token.
+	jwtPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+$`)
+
+	// xmlrpcAPIKey matches the API key that the SoftLayer XML-RPC transport
+	// sends in the body of every request.
+	xmlrpcAPIKey = regexp.MustCompile(`(<name>apiKey</name>\s*<value>(?:<string>)?)[^<]*`)
+)
+
+// RedactHeaders returns a copy of headers without the credentials they
+// carry.
+func RedactHeaders(headers gohttp.Header) gohttp.Header {
+	if headers == nil {
+		return nil
+	}
+	headers = headers.Clone()
+	for _, name := range secretHeaders {
+		if _, ok := headers[name]; ok {
+			headers.Set(name, Redacted)
+		}
+	}
+	return headers
+}
+
+// RedactURL removes the user info and the secret query parameters of rawURL.
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	if u.User != nil {
+		u.User = url.User(Redacted)
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = redactValues(u.Query()).Encode()
+	}
+	return u.String()
+}
+
+// RedactBody removes the secret fields of a JSON, form or SoftLayer XML-RPC
+// body of type contentType. The credentials of kubeconfigs and private keys
+// are removed from other text bodies.
+func RedactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	switch {
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		return []byte(redactValues(values).Encode())
+	case strings.Contains(contentType, "xml"):
+		return xmlrpcAPIKey.ReplaceAll(body, []byte("${1}"+Redacted))
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return []byte(redactText(string(body)))
+	}
+	if !redactJSON(value) {
+		return body
+	}
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if secretKey.MatchString(key) {
+			for i := range values[key] {
+				values[key][i] = redactSecret(values[key][i])
+			}
+		}
+	}
+	return values
+}
+
+// redactJSON redacts the secret fields of value in place and reports whether
+// it changed anything. A secret field that holds an object or an array is
+// redacted as a whole.
+func redactJSON(value interface{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		secretData := isSecretsManagerObject(v)
+		for key, field := range v {
+			if secretKey.MatchString(key) || (secretData && key == "data") {
+				if redacted, ok := redactField(field); ok {
+					v[key] = redacted
+					changed = true
+				}
+				continue
+			}
+			if s, ok := field.(string); ok {
+				if redacted := redactText(s); redacted != s {
+					v[key] = redacted
+					changed = true
+				}
+				continue
+			}
+			changed = redactJSON(field) || changed
+		}
+	case []interface{}:
+		for i, item := range v {
+			if s, ok := item.(string); ok {
+				if redacted := redactText(s); redacted != s {
+					v[i] = redacted
+					changed = true
+				}
+				continue
+			}
+			changed = redactJSON(item) || changed
+		}
+	}
+	return changed
+}
+
+// isSecretsManagerObject reports whether object is a Secrets Manager secret
+// or secret version, or the prototype of a new version of a key-value
+// secret, which only has data and metadata.
+func isSecretsManagerObject(object map[string]interface{}) bool {
+	if _, ok := object["secret_type"]; ok {
+		return true
+	}
+	if _, ok := object["data"]; !ok {
+		return false
+	}
+	for key := range object {
+		if !secretDataKeys[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// redactField redacts the value of a secret field and reports whether it
+// changed it. Empty values are kept, so that the trace still shows that the
+// secret was not set, and so are numbers and booleans.
+func redactField(field interface{}) (interface{}, bool) {
+	switch v := field.(type) {
+	case string:
+		redacted := redactSecret(v)
+		return redacted, redacted != v
+	case map[string]interface{}:
+		return Redacted, len(v) > 0
+	case []interface{}:
+		return Redacted, len(v) > 0
+	}
+	return field, false
+}
+
+// redactText removes the credentials of a kubeconfig and private keys from
+// text.
+func redactText(text string) string {
+	if strings.Contains(text, "PRIVATE KEY-----") {
+		text = privateKeyPEM.ReplaceAllString(text, "-----BEGIN ${1}PRIVATE KEY-----"+Redacted+"-----END ${1}PRIVATE KEY-----")
+	}
+	if strings.Contains(text, "apiVersion") {
+		text = kubeconfigSecret.ReplaceAllString(text, "${1}"+Redacted)
+	}
+	return text
+}
+
+// redactSecret redacts a secret. The claims of a JSON web token are kept,
+// since the SDKs read the account and expiry from them, but its signature is
+// removed so that the token cannot be used.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return secret
+	}
+	if jwtPattern.MatchString(secret) {
+		return secret[:strings.LastIndex(secret, ".")+1] + Redacted
+	}
+	return Redacted
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HTTPTraceFormatJSONL writes one JSON record per line to the trace file.
+const HTTPTraceFormatJSONL = "jsonl"
+
+// HTTPTraceFormats are the formats the http_trace of the provider supports.
+var HTTPTraceFormats = []string{HTTPTraceFormatJSONL}
+
+// maxTraceBody is the size of the largest body that is traced. Larger
+// bodies, and bodies that are not text, are left out of the trace.
+const maxTraceBody = 64 * 1024
+
+// requestIDHeaders are the headers that identify a request to IBM Cloud
+// support, in the order they are looked up.
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Correlation-Id",
+	"X-Global-Transaction-Id",
+	"Transaction-Id",
+}
+
+var (
+	httpTracersMutex sync.Mutex
+	// httpTracers holds a tracer per trace file, so that the provider
+	// configurations sharing a file do not interleave partial records.
+	httpTracers = map[string]*httpTracer{}
+)
+
+// httpTracer writes a record of every HTTP call made by the clients of a
+// ClientSession to a trace file.
+type httpTracer struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+// traceRecord is the record of an HTTP call. The credentials in its URL,
+// headers and bodies are redacted.
+type traceRecord struct {
+	Time            time.Time     `json:"time"`
+	Service         string        `json:"service"`
+	Resource        string        `json:"resource,omitempty"`
+	ResourceID      string        `json:"resource_id,omitempty"`
+	Operation       string        `json:"operation,omitempty"`
+	Method          string        `json:"method"`
+	URL             string        `json:"url"`
+	Status          int           `json:"status,omitempty"`
+	LatencyMS       float64       `json:"latency_ms"`
+	RequestID       string        `json:"request_id,omitempty"`
+	Error           string        `json:"error,omitempty"`
+	RequestHeaders  gohttp.Header `json:"request_headers,omitempty"`
+	RequestBody     string        `json:"request_body,omitempty"`
+	ResponseHeaders gohttp.Header `json:"response_headers,omitempty"`
+	ResponseBody    string        `json:"response_body,omitempty"`
+}
+
+// newHTTPTracer returns the tracer that writes to the file at path, or nil
+// when path is empty.
+func newHTTPTracer(path, format string) (*httpTracer, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if format == "" {
+		format = HTTPTraceFormatJSONL
+	}
+	if format != HTTPTraceFormatJSONL {
+		return nil, fmt.Errorf("[ERROR] Unsupported http_trace format %q, expected one of %s", format, strings.Join(HTTPTraceFormats, ", "))
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error resolving the http_trace path: %s", err)
+	}
+
+	httpTracersMutex.Lock()
+	defer httpTracersMutex.Unlock()
+	if tracer, ok := httpTracers[path]; ok {
+		return tracer, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error opening the http_trace file: %s", err)
+	}
+	tracer := &httpTracer{out: file}
+	httpTracers[path] = tracer
+	return tracer, nil
+}
+
+// wrap returns a transport that traces the calls that service sends through
+// next.
+func (t *httpTracer) wrap(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	return &tracingTransport{tracer: t, service: service, next: next}
+}
+
+func (t *httpTracer) write(record *traceRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] Error encoding the trace of %s %s: %s", record.Method, record.URL, err)
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, err := t.out.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error writing the trace of %s %s: %s", record.Method, record.URL, err)
+	}
+}
+
+type traceResourceKey struct{}
+
+// traceResource is the resource whose operation made a call.
+type traceResource struct {
+	resource, id, operation string
+}
+
+// WithTraceResource returns a context that attributes the HTTP calls made
+// with it to the operation, e.g. "create", of the resource with the given
+// type and ID in the http_trace.
+func WithTraceResource(ctx context.Context, resource, id, operation string) context.Context {
+	return context.WithValue(ctx, traceResourceKey{}, traceResource{resource: resource, id: id, operation: operation})
+}
+
+type tracingTransport struct {
+	tracer  *httpTracer
+	service string
+	next    gohttp.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	record := &traceRecord{
+		Time:           time.Now().UTC(),
+		Service:        t.service,
+		Method:         req.Method,
+		URL:            RedactURL(req.URL.String()),
+		RequestHeaders: RedactHeaders(req.Header),
+	}
+	if resource, ok := req.Context().Value(traceResourceKey{}).(traceResource); ok {
+		record.Resource = resource.resource
+		record.ResourceID = resource.id
+		record.Operation = resource.operation
+	}
+
+	body, restored, err := peekBody(req.Header.Get("Content-Type"), req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req = req.Clone(req.Context())
+		req.Body = restored
+	}
+	record.RequestBody = string(RedactBody(req.Header.Get("Content-Type"), body))
+
+	resp, err := t.next.RoundTrip(req)
+	record.LatencyMS = float64(time.Since(record.Time).Microseconds()) / 1000
+	record.RequestID = requestID(req.Header)
+	if err != nil {
+		record.Error = err.Error()
+		t.tracer.write(record)
+		return resp, err
+	}
+
+	record.Status = resp.StatusCode
+	record.ResponseHeaders = RedactHeaders(resp.Header)
+	if id := requestID(resp.Header); id != "" {
+		record.RequestID = id
+	}
+	body, resp.Body, err = peekBody(resp.Header.Get("Content-Type"), resp.Body)
+	if err != nil {
+		record.Error = err.Error()
+		t.tracer.write(record)
+		return nil, err
+	}
+	record.ResponseBody = string(RedactBody(resp.Header.Get("Content-Type"), body))
+	t.tracer.write(record)
+	return resp, nil
+}
+
+func requestID(headers gohttp.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := headers.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// peekBody returns the content of a text body of at most maxTraceBody bytes,
+// along with a body that still reads the whole content. The body is closed
+// when it cannot be read.
+func peekBody(contentType string, body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	if body == nil || body == gohttp.NoBody || !isTextContent(contentType) {
+		return nil, body, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxTraceBody+1))
+	if err != nil {
+		body.Close()
+		return nil, nil, err
+	}
+	restored := struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), body), body}
+	if len(data) > maxTraceBody {
+		return nil, restored, nil
+	}
+	return data, restored, nil
+}
+
+func isTextContent(contentType string) bool {
+	for _, text := range []string{"json", "xml", "text/", "x-www-form-urlencoded"} {
+		if strings.Contains(contentType, text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestHTTPTracer(t *testing.T) {
+	var out bytes.Buffer
+	tracer := &httpTracer{out: &out}
+	transport := tracer.wrap("IAMIdentityV1API", roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		if string(body) != `{"apikey":"secret-key","name":"test"}` {
+			t.Errorf("Expected the request body to reach the API, got %s", body)
+		}
+		return &gohttp.Response{
+			StatusCode: gohttp.StatusCreated,
+			Header: gohttp.Header{
+				"Content-Type": {"application/json"},
+				"X-Request-Id": {"req-1"},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"id":"ApiKey-1","apikey":"created-key"}`)),
+			Request: req,
+		}, nil
+	}))
+
+	ctx := WithTraceResource(context.Background(), "ibm_iam_api_key", "", "create")
+	req, _ := gohttp.NewRequestWithContext(ctx, gohttp.MethodPost, "https://iam.cloud.ibm.com/v1/apikeys?refresh_token=abc",
+		strings.NewReader(`{"apikey":"secret-key","name":"test"}`))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"id":"ApiKey-1","apikey":"created-key"}` {
+		t.Errorf("Expected the response body to reach the SDK, got %s", body)
+	}
+
+	var record traceRecord
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %s: %s", out.String(), err)
+	}
+	if record.Service != "IAMIdentityV1API" || record.Resource != "ibm_iam_api_key" || record.Operation != "create" {
+		t.Errorf("Expected the call to be attributed to the create of ibm_iam_api_key by IAMIdentityV1API, got %+v", record)
+	}
+	if record.Status != gohttp.StatusCreated || record.RequestID != "req-1" || record.Method != gohttp.MethodPost {
+		t.Errorf("Unexpected record %+v", record)
+	}
+	if record.URL != "https://iam.cloud.ibm.com/v1/apikeys?refresh_token="+Redacted {
+		t.Errorf("Expected the token in the URL to be redacted, got %s", record.URL)
+	}
+	if record.RequestHeaders.Get("Authorization") != Redacted {
+		t.Errorf("Expected the Authorization header to be redacted, got %s", record.RequestHeaders.Get("Authorization"))
+	}
+	if strings.Contains(out.String(), "secret-key") || strings.Contains(out.String(), "created-key") {
+		t.Errorf("Expected the API keys to be redacted, got %s", out.String())
+	}
+}
+
+func TestHTTPTracerLeavesOutLargeBodies(t *testing.T) {
+	var out bytes.Buffer
+	tracer := &httpTracer{out: &out}
+	large := `{"data":"` + strings.Repeat("a", maxTraceBody) + `"}`
+	transport := tracer.wrap("CosConfigV1API", roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		return &gohttp.Response{
+			StatusCode: gohttp.StatusOK,
+			Header:     gohttp.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(large)),
+			Request:    req,
+		}, nil
+	}))
+
+	req, _ := gohttp.NewRequest(gohttp.MethodGet, "https://config.cloud-object-storage.cloud.ibm.com/v1/b/bucket", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != large {
+		t.Errorf("Expected the whole response body to reach the SDK, got %d bytes", len(body))
+	}
+	var record traceRecord
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %s: %s", out.String(), err)
+	}
+	if record.ResponseBody != "" {
+		t.Errorf("Expected the large response body to be left out, got %d bytes", len(record.ResponseBody))
+	}
+}
+
+func TestHTTPTracerRedactsSecrets(t *testing.T) {
+	var out bytes.Buffer
+	tracer := &httpTracer{out: &out}
+	secret := `{"id":"s-1","secret_type":"kv","data":{"user":"admin","key":"kv-value"}}`
+	transport := tracer.wrap("SecretsManagerV2", roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+		return &gohttp.Response{
+			StatusCode: gohttp.StatusOK,
+			Header:     gohttp.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(secret)),
+			Request:    req,
+		}, nil
+	}))
+
+	req, _ := gohttp.NewRequest(gohttp.MethodGet, "https://instance.us-south.secrets-manager.appdomain.cloud/api/v2/secrets/s-1", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != secret {
+		t.Errorf("Expected the secret to reach the SDK, got %s", body)
+	}
+	var record traceRecord
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %s: %s", out.String(), err)
+	}
+	if record.ResponseBody != `{"data":"REDACTED","id":"s-1","secret_type":"kv"}` {
+		t.Errorf("Expected the data of the secret to be redacted, got %s", record.ResponseBody)
+	}
+}
+
+func TestClientSessionHTTPTrace(t *testing.T) {
+	for _, env := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+		"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+		"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH", "TF_LOG"} {
+		t.Setenv(env, "")
+	}
+	AcceptanceTestTransport = func(next gohttp.RoundTripper) gohttp.RoundTripper {
+		return roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+			return &gohttp.Response{
+				StatusCode: gohttp.StatusOK,
+				Header:     gohttp.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"vpcs": []}`)),
+				Request:    req,
+			}, nil
+		})
+	}
+	defer func() { AcceptanceTestTransport = nil }()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	token := testIAMToken(t)
+	c := &Config{
+		IAMToken:        "Bearer " + token,
+		Region:          "us-south",
+		BluemixTimeout:  time.Minute,
+		RetryDelay:      time.Millisecond,
+		HTTPTracePath:   path,
+		HTTPTraceFormat: HTTPTraceFormatJSONL,
+	}
+	meta, err := c.ClientSession()
+	if err != nil {
+		t.Fatalf("Error configuring client session: %s", err)
+	}
+	vpcClient, err := meta.(ClientSession).VpcV1API()
+	if err != nil {
+		t.Fatalf("Error getting VPC client: %s", err)
+	}
+	ctx := WithTraceResource(context.Background(), "data.ibm_is_vpcs", "", "read")
+	if _, _, err := vpcClient.ListVpcsWithContext(ctx, &vpc.ListVpcsOptions{}); err != nil {
+		t.Fatalf("Error listing VPCs: %s", err)
+	}
+
+	trace, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading the trace: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(trace)), "\n")
+	var record traceRecord
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %s: %s", trace, err)
+	}
+	if record.Service != "VpcV1API" || record.Resource != "data.ibm_is_vpcs" || !strings.Contains(record.URL, "/v1/vpcs") {
+		t.Errorf("Expected the VPC call to be traced, got %+v", record)
+	}
+	if strings.Contains(string(trace), token) {
+		t.Error("Expected the IAM token to be redacted from the trace")
+	}
+}
+
+func TestNewHTTPTracerFormat(t *testing.T) {
+	if _, err := newHTTPTracer(filepath.Join(t.TempDir(), "trace.txt"), "text"); err == nil {
+		t.Error("Expected an unsupported format to be rejected")
+	}
+	if tracer, err := newHTTPTracer("", ""); tracer != nil || err != nil {
+		t.Errorf("Expected no tracer without a path, got %v, %v", tracer, err)
+	}
+}
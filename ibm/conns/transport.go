@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	gohttp "net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// TransportMiddleware wraps the transport of an HTTP client.
+type TransportMiddleware func(gohttp.RoundTripper) gohttp.RoundTripper
+
+// AcceptanceTestTransport, when set, wraps the transport of every HTTP client
+// built by ClientSession: the go-sdk-core services and authenticators, the
+// bluemix-go session and the SoftLayer session. The acceptance tests use it
+// to record and replay the API traffic of a test.
+var AcceptanceTestTransport TransportMiddleware
+
+// transportMiddleware is the middleware configured in the provider that the
+// transports of the clients of a ClientSession are wrapped in.
+type transportMiddleware struct {
+	tracer  *httpTracer
+	limiter *rateLimiter
+}
+
+func (m *transportMiddleware) empty() bool {
+	return m == nil || m.tracer == nil && m.limiter == nil
+}
+
+// wrapTransport routes the requests of service through the acceptance test
+// middleware, the tracer of the http_trace and the rate limiter, when they
+// are configured. Each attempt of a throttled request is traced.
+func wrapTransport(middleware *transportMiddleware, service string, transport gohttp.RoundTripper) gohttp.RoundTripper {
+	if AcceptanceTestTransport != nil {
+		transport = AcceptanceTestTransport(transport)
+	}
+	if middleware == nil {
+		return transport
+	}
+	if middleware.tracer != nil {
+		transport = middleware.tracer.wrap(service, transport)
+	}
+	if middleware.limiter != nil {
+		transport = middleware.limiter.wrap(service, transport)
+	}
+	return transport
+}
+
+// newHTTPClient returns a client whose transport is wrapped by the configured
+// middleware, or nil when there is none so that the SDKs keep building their
+// own default clients.
+func newHTTPClient(middleware *transportMiddleware, service string, timeout time.Duration) *gohttp.Client {
+	if AcceptanceTestTransport == nil && middleware.empty() {
+		return nil
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	return &gohttp.Client{
+		Transport: wrapTransport(middleware, service, transport),
+		Timeout:   timeout,
+	}
+}
+
+// wrapServiceTransport routes the requests of a go-sdk-core service through
+// the configured middleware. The transport the service was built with, e.g.
+// one that skips SSL verification, is kept underneath the middleware. When
+// the rate is limited, the retries of the service no longer retry throttled
+// requests, since the rate limiter retries them.
+func wrapServiceTransport(middleware *transportMiddleware, name string, service *core.BaseService) {
+	if AcceptanceTestTransport == nil && middleware.empty() || service == nil {
+		return
+	}
+	client := service.GetHTTPClient()
+	transport := client.Transport
+	if transport == nil {
+		transport = gohttp.DefaultTransport
+	}
+	service.SetHTTPClient(&gohttp.Client{
+		Transport:     wrapTransport(middleware, name, transport),
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+		Timeout:       client.Timeout,
+	})
+	if middleware != nil && middleware.limiter != nil {
+		if retries, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+			retries.Client.CheckRetry = skipThrottled(retries.Client.CheckRetry)
+		}
+	}
+}
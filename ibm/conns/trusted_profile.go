@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TrustedProfile is a trusted profile of an assume_trusted_profile block of
+// the provider.
+type TrustedProfile struct {
+	// ProfileID is the ID of the trusted profile.
+	ProfileID string
+
+	// ProfileName is the name of the trusted profile in AccountID.
+	ProfileName string
+
+	// AccountID is the account of the trusted profile. It is required with
+	// ProfileName, and with ProfileID the token of the profile is checked to
+	// belong to it.
+	AccountID string
+}
+
+func (p TrustedProfile) String() string {
+	if p.ProfileID != "" {
+		return p.ProfileID
+	}
+	return fmt.Sprintf("%s of account %s", p.ProfileName, p.AccountID)
+}
+
+// trustedProfileRefreshWindow is the fraction of the lifetime of a token of
+// a trusted profile left when it is refreshed.
+const trustedProfileRefreshWindow = 0.2
+
+// newTrustedProfileChain returns the authenticator of the last of the
+// trusted profiles of c, each of which is assumed with the token of the one
+// before it, and the first with base. The chain belongs to the ClientSession
+// of c: each provider configuration runs in its own plugin process, so there
+// is nothing to share with the other aliases.
+func newTrustedProfileChain(c *Config, base core.Authenticator, iamURL string, middleware *transportMiddleware) (core.Authenticator, error) {
+	client := newHTTPClient(middleware, "IAMAuthenticator", 0)
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	authenticator := base
+	for i, profile := range c.AssumeTrustedProfiles {
+		if profile.ProfileID == "" && profile.ProfileName == "" || profile.ProfileID != "" && profile.ProfileName != "" {
+			return nil, fmt.Errorf("[ERROR] Trusted profile %d of assume_trusted_profile requires one of profile_id or profile_name", i+1)
+		}
+		if profile.ProfileName != "" && profile.AccountID == "" {
+			return nil, fmt.Errorf("[ERROR] Trusted profile %q of assume_trusted_profile requires its account_id", profile.ProfileName)
+		}
+		authenticator = &trustedProfileAuthenticator{
+			parent:  authenticator,
+			profile: profile,
+			url:     strings.TrimSuffix(iamURL, "/") + "/identity/token",
+			client:  client,
+		}
+	}
+	return authenticator, nil
+}
+
+// trustedProfileAuthenticator authenticates with the token of a trusted
+// profile assumed with the token of its parent, which it caches until the
+// token is about to expire.
+type trustedProfileAuthenticator struct {
+	parent  core.Authenticator
+	profile TrustedProfile
+	url     string
+	client  *gohttp.Client
+
+	mutex     sync.Mutex
+	token     string
+	refreshAt time.Time
+	expiresAt time.Time
+}
+
+func (a *trustedProfileAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM_ASSUME
+}
+
+func (a *trustedProfileAuthenticator) Validate() error {
+	return a.parent.Validate()
+}
+
+func (a *trustedProfileAuthenticator) Authenticate(req *gohttp.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns the token of the trusted profile, which is refreshed when
+// most of its lifetime has passed. A token that cannot be refreshed is used
+// until it expires.
+func (a *trustedProfileAuthenticator) GetToken() (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	now := time.Now()
+	if a.token != "" && now.Before(a.refreshAt) {
+		return a.token, nil
+	}
+	token, expiresIn, err := a.requestToken()
+	if err != nil {
+		if a.token != "" && now.Before(a.expiresAt) {
+			log.Printf("[WARN] Error refreshing the token of the trusted profile %s, using the current token until it expires: %s", a.profile, err)
+			return a.token, nil
+		}
+		return "", err
+	}
+	a.token = token
+	a.expiresAt = now.Add(expiresIn)
+	a.refreshAt = now.Add(time.Duration(float64(expiresIn) * (1 - trustedProfileRefreshWindow)))
+	return a.token, nil
+}
+
+// requestToken exchanges the token of the parent for one of the trusted
+// profile, and returns it with its lifetime.
+func (a *trustedProfileAuthenticator) requestToken() (string, time.Duration, error) {
+	parentToken, err := bearerToken(a.parent)
+	if err != nil {
+		return "", 0, fmt.Errorf("[ERROR] Error getting the token to assume the trusted profile %s with: %s", a.profile, err)
+	}
+	form := url.Values{
+		"grant_type":   {"urn:ibm:params:oauth:grant-type:assume"},
+		"access_token": {parentToken},
+	}
+	if a.profile.ProfileID != "" {
+		form.Set("profile_id", a.profile.ProfileID)
+	} else {
+		form.Set("profile_name", a.profile.ProfileName)
+		form.Set("account", a.profile.AccountID)
+	}
+	req, err := gohttp.NewRequest(gohttp.MethodPost, a.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: %s", a.profile, err)
+	}
+	defer resp.Body.Close()
+
+	var tokens core.IamTokenServerResponse
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var iamError struct {
+			ErrorMessage string `json:"errorMessage"`
+		}
+		json.NewDecoder(resp.Body).Decode(&iamError)
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: status %d %s", a.profile, resp.StatusCode, iamError.ErrorMessage)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil || tokens.AccessToken == "" {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: the response holds no token", a.profile)
+	}
+	if a.profile.AccountID != "" {
+		if account := tokenAccount(tokens.AccessToken); account != "" && account != a.profile.AccountID {
+			return "", 0, fmt.Errorf("[ERROR] The trusted profile %s belongs to account %s, not %s", a.profile, account, a.profile.AccountID)
+		}
+	}
+	return tokens.AccessToken, time.Duration(tokens.ExpiresIn) * time.Second, nil
+}
+
+// bearerToken returns the token that authenticator authenticates requests
+// with.
+func bearerToken(authenticator core.Authenticator) (string, error) {
+	req := &gohttp.Request{Header: gohttp.Header{}}
+	if err := authenticator.Authenticate(req); err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "), nil
+}
+
+// tokenAccount returns the account of an IAM token, or "" when it has none.
+func tokenAccount(token string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return ""
+	}
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		if bss, ok := account["bss"].(string); ok {
+			return bss
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// trustedProfileIAM stubs the assume operation of IAM, which issues a token
+// of the account of each profile to the token of the profile assumed before
+// it, and counts the calls by profile.
+func trustedProfileIAM(t *testing.T, accounts map[string]string, parents map[string]string, expiresIn int, calls map[string]int) func(gohttp.RoundTripper) gohttp.RoundTripper {
+	token := func(profile string) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub":     profile,
+			"account": map[string]interface{}{"bss": accounts[profile]},
+		}).SignedString([]byte("test-signing-key"))
+		if err != nil {
+			t.Fatalf("Error signing test IAM token: %s", err)
+		}
+		return signed
+	}
+	return func(next gohttp.RoundTripper) gohttp.RoundTripper {
+		return roundTripperFunc(func(req *gohttp.Request) (*gohttp.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			form, _ := url.ParseQuery(string(body))
+			profile := form.Get("profile_id")
+			if profile == "" {
+				profile = form.Get("account") + "/" + form.Get("profile_name")
+			}
+			calls[profile]++
+			if form.Get("grant_type") != "urn:ibm:params:oauth:grant-type:assume" {
+				return nil, fmt.Errorf("unexpected grant type %q", form.Get("grant_type"))
+			}
+			if parent := parents[profile]; form.Get("access_token") != parent && form.Get("access_token") != token(parent) {
+				return nil, fmt.Errorf("expected %s to be assumed with the token of %s", profile, parent)
+			}
+			response, _ := json.Marshal(map[string]interface{}{"access_token": token(profile), "expires_in": expiresIn})
+			return &gohttp.Response{
+				StatusCode: gohttp.StatusOK,
+				Header:     gohttp.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(string(response))),
+				Request:    req,
+			}, nil
+		})
+	}
+}
+
+func TestTrustedProfileChain(t *testing.T) {
+	calls := map[string]int{}
+	AcceptanceTestTransport = trustedProfileIAM(t,
+		map[string]string{"Profile-enterprise": "enterprise", "Profile-child1": "child1", "child2/deployer": "child2"},
+		map[string]string{"Profile-enterprise": "user-token", "Profile-child1": "Profile-enterprise", "child2/deployer": "Profile-enterprise"},
+		3600, calls)
+	defer func() { AcceptanceTestTransport = nil }()
+
+	base := &core.BearerTokenAuthenticator{BearerToken: "user-token"}
+	chain := func(profiles ...TrustedProfile) core.Authenticator {
+		c := &Config{IAMToken: "user-token", AssumeTrustedProfiles: profiles}
+		authenticator, err := newTrustedProfileChain(c, base, "https://iam.test.cloud.ibm.com", nil)
+		if err != nil {
+			t.Fatalf("Error configuring the chain of trusted profiles: %s", err)
+		}
+		return authenticator
+	}
+	enterprise := TrustedProfile{ProfileID: "Profile-enterprise"}
+	for _, config := range []struct {
+		authenticator core.Authenticator
+		account       string
+	}{
+		{chain(enterprise, TrustedProfile{ProfileID: "Profile-child1", AccountID: "child1"}), "child1"},
+		{chain(enterprise, TrustedProfile{ProfileName: "deployer", AccountID: "child2"}), "child2"},
+		{chain(enterprise, TrustedProfile{ProfileID: "Profile-child1", AccountID: "child1"}), "child1"},
+	} {
+		for i := 0; i < 2; i++ {
+			token, err := bearerToken(config.authenticator)
+			if err != nil {
+				t.Fatalf("Error assuming the chain of trusted profiles: %s", err)
+			}
+			if account := tokenAccount(token); account != config.account {
+				t.Errorf("Expected a token of account %s, got %s", config.account, account)
+			}
+		}
+	}
+	if calls["Profile-enterprise"] != 3 || calls["Profile-child1"] != 2 || calls["child2/deployer"] != 1 {
+		t.Errorf("Expected each chain to assume its own trusted profiles, got %v", calls)
+	}
+
+	mismatch := chain(TrustedProfile{ProfileID: "Profile-enterprise"}, TrustedProfile{ProfileID: "Profile-child1", AccountID: "child2"})
+	if _, err := bearerToken(mismatch); err == nil || !strings.Contains(err.Error(), "belongs to account child1") {
+		t.Errorf("Expected an error for a trusted profile of another account, got %v", err)
+	}
+
+	for _, profile := range []TrustedProfile{{}, {ProfileID: "Profile-child1", ProfileName: "deployer"}, {ProfileName: "deployer"}} {
+		c := &Config{AssumeTrustedProfiles: []TrustedProfile{profile}}
+		if _, err := newTrustedProfileChain(c, base, "https://iam.test.cloud.ibm.com", nil); err == nil {
+			t.Errorf("Expected an error for the trusted profile %#v", profile)
+		}
+	}
+}
+
+func TestTrustedProfileAuthenticatorRefresh(t *testing.T) {
+	calls := map[string]int{}
+	AcceptanceTestTransport = trustedProfileIAM(t, map[string]string{}, map[string]string{"Profile-1": "user-token"}, 3600, calls)
+	defer func() { AcceptanceTestTransport = nil }()
+
+	c := &Config{IAMToken: "refresh-user-token", AssumeTrustedProfiles: []TrustedProfile{{ProfileID: "Profile-1"}}}
+	authenticator, err := newTrustedProfileChain(c, &core.BearerTokenAuthenticator{BearerToken: "user-token"}, "https://iam.test.cloud.ibm.com", nil)
+	if err != nil {
+		t.Fatalf("Error configuring the chain of trusted profiles: %s", err)
+	}
+	assumed := authenticator.(*trustedProfileAuthenticator)
+	for i := 0; i < 3; i++ {
+		if _, err := assumed.GetToken(); err != nil {
+			t.Fatalf("Error assuming the trusted profile: %s", err)
+		}
+	}
+	if calls["Profile-1"] != 1 {
+		t.Errorf("Expected the token to be cached, got %d calls", calls["Profile-1"])
+	}
+	if lifetime := assumed.refreshAt.Sub(time.Now()); lifetime < 47*time.Minute || lifetime > 48*time.Minute {
+		t.Errorf("Expected the token to be refreshed after 80%% of its lifetime, got %s", lifetime)
+	}
+
+	assumed.refreshAt = time.Now().Add(-time.Second)
+	AcceptanceTestTransport = func(gohttp.RoundTripper) gohttp.RoundTripper {
+		return roundTripperFunc(func(*gohttp.Request) (*gohttp.Response, error) {
+			return nil, fmt.Errorf("IAM is unavailable")
+		})
+	}
+	assumed.client = newHTTPClient(nil, "IAMAuthenticator", 0)
+	if _, err := assumed.GetToken(); err != nil {
+		t.Errorf("Expected the token to be used until it expires, got %s", err)
+	}
+	assumed.expiresAt = time.Now().Add(-time.Second)
+	if _, err := assumed.GetToken(); err == nil {
+		t.Error("Expected an error for an expired token that cannot be refreshed")
+	}
+}
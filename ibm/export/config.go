@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueName returns a Terraform resource name derived from the name of a
+// resource, or from its type and index when it has none, that is not in
+// names yet.
+func uniqueName(names map[string]bool, name, resourceType string, index int) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = fmt.Sprintf("%s_%d", strings.TrimPrefix(resourceType, "ibm_"), index+1)
+	}
+	if c := base[0]; c >= '0' && c <= '9' {
+		base = "r_" + base
+	}
+	unique := base
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", base, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+func appendImportBlock(body *hclwrite.Body, r Resource) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: r.Type},
+		hcl.TraverseAttr{Name: r.Name},
+	})
+	block.SetAttributeValue("id", cty.StringVal(r.ImportID))
+}
+
+func appendResourceBlock(body *hclwrite.Body, r Resource, resource *schema.Resource) {
+	block := body.AppendNewBlock("resource", []string{r.Type, r.Name}).Body()
+	appendArguments(block, resource.Schema, func(name string) interface{} {
+		return r.Data.Get(name)
+	})
+}
+
+// appendArguments writes the arguments of a resource or of a nested block.
+// Computed attributes, deprecated arguments, arguments that conflict with
+// an argument already written and optional arguments that are unset or set
+// to their default are left out. Sensitive arguments are never read back
+// into the configuration, a comment marks those that are set.
+func appendArguments(body *hclwrite.Body, arguments map[string]*schema.Schema, get func(string) interface{}) {
+	names := make([]string, 0, len(arguments))
+	for name, s := range arguments {
+		if (s.Required || s.Optional) && s.Deprecated == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	written := map[string]bool{}
+	for _, name := range names {
+		s := arguments[name]
+		if conflicts(s, written) {
+			continue
+		}
+		value := get(name)
+		if !s.Required && isDefault(s, value) {
+			continue
+		}
+		written[name] = true
+
+		if s.Sensitive {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{
+				{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s is sensitive and must be set\n", name))},
+			})
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, element := range elements(value) {
+				m, _ := element.(map[string]interface{})
+				appendArguments(body.AppendNewBlock(name, nil).Body(), elem.Schema, func(name string) interface{} {
+					return m[name]
+				})
+			}
+			continue
+		}
+		if v, ok := ctyValue(value); ok {
+			body.SetAttributeValue(name, v)
+		}
+	}
+}
+
+func conflicts(s *schema.Schema, written map[string]bool) bool {
+	for _, other := range append(append([]string{}, s.ConflictsWith...), s.ExactlyOneOf...) {
+		if written[other] {
+			return true
+		}
+	}
+	return false
+}
+
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(value, s.Default)
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func elements(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// ctyValue converts the value of an attribute returned by ResourceData.Get
+// to a cty value. Lists and sets become tuples and maps become objects, so
+// that their elements need not have the same type.
+func ctyValue(value interface{}) (cty.Value, bool) {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v), true
+	case int:
+		return cty.NumberIntVal(int64(v)), true
+	case float64:
+		return cty.NumberFloatVal(v), true
+	case bool:
+		return cty.BoolVal(v), true
+	case []interface{}, *schema.Set:
+		var values []cty.Value
+		for _, element := range elements(v) {
+			if element, ok := ctyValue(element); ok {
+				values = append(values, element)
+			}
+		}
+		if len(values) == 0 {
+			return cty.EmptyTupleVal, true
+		}
+		return cty.TupleVal(values), true
+	case map[string]interface{}:
+		values := map[string]cty.Value{}
+		for key, element := range v {
+			if element, ok := ctyValue(element); ok {
+				values[key] = element
+			}
+		}
+		if len(values) == 0 {
+			return cty.EmptyObjectVal, true
+		}
+		return cty.ObjectVal(values), true
+	}
+	return cty.NilVal, false
+}
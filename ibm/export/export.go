@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package export generates the Terraform configuration of existing account
+// resources. Resources are enumerated with the plural data sources of the
+// provider, then imported and read with the resources themselves, so the
+// configuration matches what the provider would plan against.
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Scope limits the resources that are exported.
+type Scope struct {
+	// ResourceGroup is the ID of the resource group of the resources.
+	// Resources that do not belong to a resource group are not filtered.
+	ResourceGroup string
+
+	// Tags that the resources must all have. Resources without tags are
+	// excluded when Tags is not empty.
+	Tags []string
+
+	// Args are the arguments of the data sources that list resources under
+	// a parent, e.g. cis_id, domain_id or access_group_id.
+	Args map[string]string
+}
+
+// Item is a resource returned by the data source of a Lister.
+type Item struct {
+	ImportID string
+	Name     string
+
+	// Attributes of the element of the data source list.
+	Attributes map[string]interface{}
+}
+
+// Resource is an exported resource.
+type Resource struct {
+	Type     string
+	Name     string
+	ImportID string
+	Data     *schema.ResourceData
+}
+
+// Exporter exports resources with the resources and data sources of a
+// configured provider.
+type Exporter struct {
+	Resources   map[string]*schema.Resource
+	DataSources map[string]*schema.Resource
+	Listers     map[string]Lister
+
+	// Meta is the client session returned by the configuration of the
+	// provider.
+	Meta interface{}
+}
+
+// New returns an Exporter for the resources of p, which must be configured.
+func New(p *schema.Provider) *Exporter {
+	return &Exporter{
+		Resources:   p.ResourcesMap,
+		DataSources: p.DataSourcesMap,
+		Listers:     Listers,
+		Meta:        p.Meta(),
+	}
+}
+
+// Types returns the resource types that can be exported, sorted.
+func (e *Exporter) Types() []string {
+	types := make([]string, 0, len(e.Listers))
+	for resourceType := range e.Listers {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// List returns the resources of a type in scope, as returned by the data
+// source of its Lister.
+func (e *Exporter) List(ctx context.Context, resourceType string, scope Scope) ([]Item, error) {
+	lister, ok := e.Listers[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] Resource %s cannot be exported", resourceType)
+	}
+	dataSource, ok := e.DataSources[lister.DataSource]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] Data source %s of %s does not exist", lister.DataSource, resourceType)
+	}
+
+	args := map[string]string{}
+	for _, arg := range lister.Args {
+		value, ok := scope.Args[arg]
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] Listing %s requires the %s argument", resourceType, arg)
+		}
+		args[arg] = value
+	}
+	if _, ok := dataSource.Schema["resource_group"]; ok && scope.ResourceGroup != "" {
+		args["resource_group"] = scope.ResourceGroup
+	}
+	diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{}}
+	for arg, value := range args {
+		diff.Attributes[arg] = &terraform.ResourceAttrDiff{New: value}
+	}
+
+	state, diags := dataSource.ReadDataApply(ctx, diff, e.Meta)
+	if err := diagError(diags); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing %s with %s: %s", resourceType, lister.DataSource, err)
+	}
+	elements, _ := dataSource.Data(state).Get(lister.List).([]interface{})
+
+	items := make([]Item, 0, len(elements))
+	for _, element := range elements {
+		attributes, ok := element.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if known, match := matchScope(attributes, scope); known && !match {
+			continue
+		}
+		name, _ := attributes["name"].(string)
+		items = append(items, Item{
+			ImportID:   lister.importID(attributes, scope.Args),
+			Name:       name,
+			Attributes: attributes,
+		})
+	}
+	return items, nil
+}
+
+// Read imports the resource with an import ID and reads it. It returns nil
+// when the resource no longer exists.
+func (e *Exporter) Read(ctx context.Context, resourceType, importID string) (*schema.ResourceData, error) {
+	r, ok := e.Resources[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] Resource %s does not exist", resourceType)
+	}
+	if r.Importer == nil {
+		return nil, fmt.Errorf("[ERROR] Resource %s does not support import", resourceType)
+	}
+
+	d := r.Data(nil)
+	d.SetId(importID)
+	imported := []*schema.ResourceData{d}
+	var err error
+	switch {
+	case r.Importer.StateContext != nil:
+		imported, err = r.Importer.StateContext(ctx, d, e.Meta)
+	case r.Importer.State != nil:
+		imported, err = r.Importer.State(d, e.Meta)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error importing %s %s: %s", resourceType, importID, err)
+	}
+	if len(imported) == 0 {
+		return nil, nil
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), e.Meta)
+	if err := diagError(diags); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading %s %s: %s", resourceType, importID, err)
+	}
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+	return r.Data(state), nil
+}
+
+// Export lists the resources of a type in scope and reads each of them. A
+// resource that cannot be read is reported in the returned errors and
+// skipped.
+func (e *Exporter) Export(ctx context.Context, resourceType string, scope Scope) ([]Resource, []error) {
+	items, err := e.List(ctx, resourceType, scope)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var resources []Resource
+	var errs []error
+	names := map[string]bool{}
+	for i, item := range items {
+		d, err := e.Read(ctx, resourceType, item.ImportID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if d == nil {
+			continue
+		}
+		if known, match := matchScope(d.State().Attributes, scope); known && !match {
+			continue
+		} else if !known && len(scope.Tags) > 0 {
+			continue
+		}
+
+		name := item.Name
+		if name == "" {
+			name, _ = d.Get("name").(string)
+		}
+		resources = append(resources, Resource{
+			Type:     resourceType,
+			Name:     uniqueName(names, name, resourceType, i),
+			ImportID: item.ImportID,
+			Data:     d,
+		})
+	}
+	return resources, errs
+}
+
+// Config returns the import and resource blocks of exported resources.
+func (e *Exporter) Config(resources []Resource) *hclwrite.File {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		appendImportBlock(body, r)
+		body.AppendNewline()
+		appendResourceBlock(body, r, e.Resources[r.Type])
+	}
+	return f
+}
+
+// matchScope reports whether the resource group and tags of a resource
+// match the scope, and whether they are known. Attributes are either those
+// of a data source list element or the flat attributes of a state.
+func matchScope(attributes interface{}, scope Scope) (known, match bool) {
+	known, match = true, true
+	if scope.ResourceGroup != "" {
+		if group, ok := resourceGroupID(attributes); ok {
+			match = match && group == scope.ResourceGroup
+		}
+	}
+	if len(scope.Tags) > 0 {
+		tags, ok := tagSet(attributes)
+		if !ok {
+			return false, false
+		}
+		for _, tag := range scope.Tags {
+			match = match && tags[tag]
+		}
+	}
+	return known, match
+}
+
+func resourceGroupID(attributes interface{}) (string, bool) {
+	switch attributes := attributes.(type) {
+	case map[string]interface{}:
+		switch group := attributes["resource_group"].(type) {
+		case string:
+			return group, group != ""
+		case []interface{}:
+			if len(group) > 0 {
+				if m, ok := group[0].(map[string]interface{}); ok {
+					id, _ := m["id"].(string)
+					return id, id != ""
+				}
+			}
+		}
+	case map[string]string:
+		if group, ok := attributes["resource_group"]; ok && group != "" {
+			return group, true
+		}
+		if group, ok := attributes["resource_group.0.id"]; ok && group != "" {
+			return group, true
+		}
+	}
+	return "", false
+}
+
+func tagSet(attributes interface{}) (map[string]bool, bool) {
+	tags := map[string]bool{}
+	switch attributes := attributes.(type) {
+	case map[string]interface{}:
+		var list []interface{}
+		switch v := attributes["tags"].(type) {
+		case []interface{}:
+			list = v
+		case *schema.Set:
+			list = v.List()
+		default:
+			return nil, false
+		}
+		for _, tag := range list {
+			if tag, ok := tag.(string); ok {
+				tags[tag] = true
+			}
+		}
+	case map[string]string:
+		if _, ok := attributes["tags.#"]; !ok {
+			return nil, false
+		}
+		for key, tag := range attributes {
+			if strings.HasPrefix(key, "tags.") && key != "tags.#" {
+				tags[tag] = true
+			}
+		}
+	}
+	return tags, true
+}
+
+func diagError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			messages = append(messages, d.Summary)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
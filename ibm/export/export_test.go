@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package export
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+type fakeThing struct {
+	name          string
+	resourceGroup string
+	tags          []string
+	size          int
+	secret        string
+	rules         []interface{}
+}
+
+var fakeThings = map[string]fakeThing{
+	"r006-1": {name: "web-vpc", resourceGroup: "rg1", tags: []string{"env:prod"}, size: 20, secret: "s3cr3t",
+		rules: []interface{}{map[string]interface{}{"port": 22, "protocol": "tcp"}}},
+	"r006-2": {name: "Web VPC", resourceGroup: "rg1", tags: []string{"env:dev"}, size: 10},
+	"r006-3": {name: "db", resourceGroup: "rg2"},
+	// r006-4 is listed but deleted before it is read
+}
+
+func fakeExporter() *Exporter {
+	things := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			group := d.Get("resource_group").(string)
+			var list []interface{}
+			for _, id := range []string{"r006-1", "r006-2", "r006-3", "r006-4"} {
+				thing := fakeThings[id]
+				if group != "" && thing.resourceGroup != "" && thing.resourceGroup != group {
+					continue
+				}
+				list = append(list, map[string]interface{}{"id": id, "name": thing.name, "tags": thing.tags})
+			}
+			d.SetId("things")
+			d.Set("things", list)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_group": {Type: schema.TypeString, Optional: true},
+			"things": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id":   {Type: schema.TypeString, Computed: true},
+					"name": {Type: schema.TypeString, Computed: true},
+					"tags": {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+				}},
+			},
+		},
+	}
+	rules := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("rules")
+			d.Set("rules", []interface{}{map[string]interface{}{"id": "rule-1"}})
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"thing_id": {Type: schema.TypeString, Required: true},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: map[string]*schema.Schema{"id": {Type: schema.TypeString, Computed: true}}},
+			},
+		},
+	}
+	thing := &schema.Resource{
+		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			thing, ok := fakeThings[d.Id()]
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			d.Set("name", thing.name)
+			d.Set("resource_group", thing.resourceGroup)
+			d.Set("tags", thing.tags)
+			d.Set("size", thing.size)
+			d.Set("enabled", true)
+			d.Set("secret", thing.secret)
+			d.Set("vpc", "vpc-1")
+			d.Set("vpc_crn", "crn:vpc-1")
+			d.Set("legacy", "legacy")
+			d.Set("href", "https://example.com/"+d.Id())
+			d.Set("rule", thing.rules)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":           {Type: schema.TypeString, Required: true},
+			"resource_group": {Type: schema.TypeString, Optional: true, Computed: true},
+			"tags":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+			"size":           {Type: schema.TypeInt, Optional: true, Default: 10},
+			"enabled":        {Type: schema.TypeBool, Optional: true, Default: true},
+			"secret":         {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"vpc":            {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"vpc_crn"}},
+			"vpc_crn":        {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"vpc"}},
+			"legacy":         {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+			"href":           {Type: schema.TypeString, Computed: true},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"port":     {Type: schema.TypeInt, Required: true},
+					"protocol": {Type: schema.TypeString, Optional: true},
+				}},
+			},
+		},
+	}
+	return &Exporter{
+		Resources:   map[string]*schema.Resource{"ibm_thing": thing},
+		DataSources: map[string]*schema.Resource{"ibm_things": things, "ibm_thing_rules": rules},
+		Listers: map[string]Lister{
+			"ibm_thing":      {DataSource: "ibm_things", List: "things"},
+			"ibm_thing_rule": {DataSource: "ibm_thing_rules", List: "rules", Args: []string{"thing_id"}, ImportID: "{thing_id}/{id}"},
+		},
+	}
+}
+
+func TestListers(t *testing.T) {
+	p := provider.Provider()
+	for resourceType, lister := range Listers {
+		r, ok := p.ResourcesMap[resourceType]
+		if !ok || r.Importer == nil {
+			t.Errorf("%s is not an importable resource", resourceType)
+		}
+		dataSource, ok := p.DataSourcesMap[lister.DataSource]
+		if !ok {
+			t.Errorf("%s: data source %s does not exist", resourceType, lister.DataSource)
+			continue
+		}
+		var elem *schema.Resource
+		if list, ok := dataSource.Schema[lister.List]; ok {
+			elem, _ = list.Elem.(*schema.Resource)
+		}
+		if elem == nil {
+			t.Errorf("%s: %s is not a list of %s", resourceType, lister.List, lister.DataSource)
+			continue
+		}
+		for _, arg := range lister.Args {
+			if s, ok := dataSource.Schema[arg]; !ok || !(s.Required || s.Optional) {
+				t.Errorf("%s: %s is not an argument of %s", resourceType, arg, lister.DataSource)
+			}
+		}
+		template := lister.ImportID
+		if template == "" {
+			template = "{id}"
+		}
+		for _, part := range importIDPart.FindAllStringSubmatch(template, -1) {
+			if _, ok := elem.Schema[part[1]]; !ok && !slices.Contains(lister.Args, part[1]) {
+				t.Errorf("%s: import ID part %s is neither an attribute of %s nor an argument", resourceType, part[1], lister.List)
+			}
+		}
+	}
+}
+
+// listedIDs are, for the listers whose data source takes arguments, the
+// attributes of an element of List as the data source sets them, the Args,
+// and the import ID in the format of the importer of the resource.
+var listedIDs = map[string]struct {
+	attributes map[string]interface{}
+	args       map[string]string
+	want       string
+}{
+	// data_source_ibm_iam_access_group_policy.go sets the id to
+	// <access_group_id>/<policy_id>, the ID of the resource.
+	"ibm_iam_access_group_policy": {
+		attributes: map[string]interface{}{"id": "AccessGroupId-1/policy-1"},
+		args:       map[string]string{"access_group_id": "AccessGroupId-1"},
+		want:       "AccessGroupId-1/policy-1",
+	},
+	// The CIS data sources set the id to <id>:<domain_id>:<cis_id> or
+	// <id>:<cis_id>, as flex.ConvertCisToTfThreeVar and ConvertCisToTfTwoVar
+	// do for the resources.
+	"ibm_cis_dns_record": {
+		attributes: map[string]interface{}{"id": "record-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		args:       map[string]string{"cis_id": "crn:v1:bluemix:public:internet-svcs:global:a/1::", "domain_id": "zone-1"},
+		want:       "record-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::",
+	},
+	"ibm_cis_global_load_balancer": {
+		attributes: map[string]interface{}{"id": "glb-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		args:       map[string]string{"cis_id": "crn:v1:bluemix:public:internet-svcs:global:a/1::", "domain_id": "zone-1"},
+		want:       "glb-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::",
+	},
+	"ibm_cis_healthcheck": {
+		attributes: map[string]interface{}{"id": "monitor-1:crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		args:       map[string]string{"cis_id": "crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		want:       "monitor-1:crn:v1:bluemix:public:internet-svcs:global:a/1::",
+	},
+	"ibm_cis_origin_pool": {
+		attributes: map[string]interface{}{"id": "pool-1:crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		args:       map[string]string{"cis_id": "crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		want:       "pool-1:crn:v1:bluemix:public:internet-svcs:global:a/1::",
+	},
+	"ibm_cis_page_rule": {
+		attributes: map[string]interface{}{"id": "rule-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::"},
+		args:       map[string]string{"cis_id": "crn:v1:bluemix:public:internet-svcs:global:a/1::", "domain_id": "zone-1"},
+		want:       "rule-1:zone-1:crn:v1:bluemix:public:internet-svcs:global:a/1::",
+	},
+}
+
+func TestListerImportIDs(t *testing.T) {
+	for resourceType, lister := range Listers {
+		listed, ok := listedIDs[resourceType]
+		if !ok {
+			if len(lister.Args) > 0 || lister.ImportID != "" {
+				t.Errorf("%s: add the import ID of its elements to listedIDs", resourceType)
+			}
+			continue
+		}
+		if got := lister.importID(listed.attributes, listed.args); got != listed.want {
+			t.Errorf("%s: import ID %s, want %s", resourceType, got, listed.want)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	e := fakeExporter()
+	ctx := context.Background()
+
+	items, err := e.List(ctx, "ibm_thing", Scope{ResourceGroup: "rg1", Tags: []string{"env:prod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ImportID != "r006-1" || items[0].Name != "web-vpc" {
+		t.Errorf("Unexpected items %+v", items)
+	}
+
+	if _, err := e.List(ctx, "ibm_thing_rule", Scope{}); err == nil || !strings.Contains(err.Error(), "requires the thing_id argument") {
+		t.Errorf("Expected a missing argument error, got %v", err)
+	}
+	items, err = e.List(ctx, "ibm_thing_rule", Scope{Args: map[string]string{"thing_id": "r006-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].ImportID != "r006-1/rule-1" {
+		t.Errorf("Unexpected items %+v", items)
+	}
+
+	if _, err := e.List(ctx, "ibm_other", Scope{}); err == nil {
+		t.Error("Expected an error for a type without lister")
+	}
+}
+
+func TestExport(t *testing.T) {
+	e := fakeExporter()
+	ctx := context.Background()
+
+	resources, errs := e.Export(ctx, "ibm_thing", Scope{ResourceGroup: "rg1"})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	var names []string
+	for _, r := range resources {
+		names = append(names, r.Name+"="+r.ImportID)
+	}
+	if got := strings.Join(names, " "); got != "web_vpc=r006-1 web_vpc_2=r006-2" {
+		t.Errorf("Unexpected resources %s", got)
+	}
+
+	resources, _ = e.Export(ctx, "ibm_thing", Scope{Tags: []string{"env:prod"}})
+	if len(resources) != 1 || resources[0].ImportID != "r006-1" {
+		t.Fatalf("Unexpected resources %+v", resources)
+	}
+
+	want := `import {
+  to = ibm_thing.web_vpc
+  id = "r006-1"
+}
+
+resource "ibm_thing" "web_vpc" {
+  name           = "web-vpc"
+  resource_group = "rg1"
+  rule {
+    port     = 22
+    protocol = "tcp"
+  }
+  # secret is sensitive and must be set
+  size = 20
+  tags = ["env:prod"]
+  vpc  = "vpc-1"
+}
+`
+	if got := string(e.Config(resources).Bytes()); got != want {
+		t.Errorf("Unexpected configuration:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	names := map[string]bool{}
+	for _, tc := range []struct {
+		name, want string
+	}{
+		{"my-vpc", "my_vpc"},
+		{"My VPC", "my_vpc_2"},
+		{"10.0.0.0/24", "r_10_0_0_0_24"},
+		{"", "thing_4"},
+	} {
+		if got := uniqueName(names, tc.name, "ibm_thing", 3); got != tc.want {
+			t.Errorf("uniqueName(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package export
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Lister lists the resources of a type with a data source of the provider.
+type Lister struct {
+	// DataSource is the data source that lists the resources, and List is
+	// its list attribute.
+	DataSource string
+	List       string
+
+	// Args are the required arguments of the data source, taken from the
+	// Args of the Scope.
+	Args []string
+
+	// ImportID is the template of the import ID of the resources, with the
+	// attributes of the element of List and the Args in braces, e.g.
+	// "{vpc}/{id}". It is "{id}" when empty, which suits the data sources
+	// that already set the id of the elements to the import ID.
+	ImportID string
+}
+
+var importIDPart = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+func (l Lister) importID(attributes map[string]interface{}, args map[string]string) string {
+	template := l.ImportID
+	if template == "" {
+		template = "{id}"
+	}
+	return importIDPart.ReplaceAllStringFunc(template, func(part string) string {
+		name := part[1 : len(part)-1]
+		if value, ok := attributes[name]; ok && value != nil {
+			return fmt.Sprint(value)
+		}
+		return args[name]
+	})
+}
+
+// Listers are the resource types that can be exported.
+var Listers = map[string]Lister{
+	// VPC
+	"ibm_is_floating_ip":              {DataSource: "ibm_is_floating_ips", List: "floating_ips"},
+	"ibm_is_instance":                 {DataSource: "ibm_is_instances", List: "instances"},
+	"ibm_is_instance_template":        {DataSource: "ibm_is_instance_templates", List: "templates"},
+	"ibm_is_lb":                       {DataSource: "ibm_is_lbs", List: "load_balancers"},
+	"ibm_is_network_acl":              {DataSource: "ibm_is_network_acls", List: "network_acls"},
+	"ibm_is_public_gateway":           {DataSource: "ibm_is_public_gateways", List: "public_gateways"},
+	"ibm_is_security_group":           {DataSource: "ibm_is_security_groups", List: "security_groups"},
+	"ibm_is_ssh_key":                  {DataSource: "ibm_is_ssh_keys", List: "keys"},
+	"ibm_is_subnet":                   {DataSource: "ibm_is_subnets", List: "subnets"},
+	"ibm_is_virtual_endpoint_gateway": {DataSource: "ibm_is_virtual_endpoint_gateways", List: "virtual_endpoint_gateways"},
+	"ibm_is_volume":                   {DataSource: "ibm_is_volumes", List: "volumes"},
+	"ibm_is_vpc":                      {DataSource: "ibm_is_vpcs", List: "vpcs"},
+
+	// IAM and resource management
+	"ibm_iam_access_group":        {DataSource: "ibm_iam_access_group", List: "groups"},
+	"ibm_iam_access_group_policy": {DataSource: "ibm_iam_access_group_policy", List: "policies", Args: []string{"access_group_id"}},
+	"ibm_iam_trusted_profile":     {DataSource: "ibm_iam_trusted_profiles", List: "profiles"},
+	"ibm_resource_group":          {DataSource: "ibm_resource_groups", List: "resource_groups"},
+
+	// CIS
+	"ibm_cis_dns_record":           {DataSource: "ibm_cis_dns_records", List: "cis_dns_records", Args: []string{"cis_id", "domain_id"}},
+	"ibm_cis_global_load_balancer": {DataSource: "ibm_cis_global_load_balancers", List: "cis_glb", Args: []string{"cis_id", "domain_id"}},
+	"ibm_cis_healthcheck":          {DataSource: "ibm_cis_healthchecks", List: "cis_healthchecks", Args: []string{"cis_id"}},
+	"ibm_cis_origin_pool":          {DataSource: "ibm_cis_origin_pools", List: "cis_origin_pools", Args: []string{"cis_id"}},
+	"ibm_cis_page_rule":            {DataSource: "ibm_cis_page_rules", List: "cis_page_rules", Args: []string{"cis_id", "domain_id"}},
+}
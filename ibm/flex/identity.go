@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// IdentityRegion is the name of the optional identity attribute that holds
+// the region of a regional resource.
+const IdentityRegion = "region"
+
+// IdentitySpec describes how the ID of a resource is assembled from its
+// identity attributes.
+type IdentitySpec struct {
+	// Attributes are the identity attributes, in the order they appear in
+	// the resource ID.
+	Attributes []string
+
+	// Separator joins the attributes in the resource ID. Defaults to "/".
+	Separator string
+
+	// Regional adds an optional "region" attribute that is set to the
+	// provider region and checked against it on import.
+	Regional bool
+}
+
+func (spec IdentitySpec) separator() string {
+	if spec.Separator == "" {
+		return "/"
+	}
+	return spec.Separator
+}
+
+func (spec IdentitySpec) schema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(spec.Attributes)+1)
+	for _, attr := range spec.Attributes {
+		s[attr] = &schema.Schema{
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		}
+	}
+	if spec.Regional {
+		s[IdentityRegion] = &schema.Schema{
+			Type:              schema.TypeString,
+			OptionalForImport: true,
+			Description:       "The region of the resource. Defaults to the provider region.",
+		}
+	}
+	return s
+}
+
+// ID joins the identity attributes into a resource ID.
+func (spec IdentitySpec) ID(identity *schema.IdentityData) (string, error) {
+	parts := make([]string, len(spec.Attributes))
+	for i, attr := range spec.Attributes {
+		v, ok := identity.GetOk(attr)
+		if !ok {
+			return "", fmt.Errorf("[ERROR] Identity attribute %s must be set", attr)
+		}
+		parts[i] = v.(string)
+	}
+	return strings.Join(parts, spec.separator()), nil
+}
+
+// SetIdentity splits the resource ID into the identity attributes and stores
+// them on d.
+func (spec IdentitySpec) SetIdentity(d *schema.ResourceData, meta interface{}) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(d.Id(), spec.separator(), len(spec.Attributes))
+	if len(parts) != len(spec.Attributes) {
+		return fmt.Errorf("[ERROR] Resource ID %q does not match the format %s", d.Id(), spec.format())
+	}
+	for i, attr := range spec.Attributes {
+		if err := identity.Set(attr, parts[i]); err != nil {
+			return err
+		}
+	}
+	if spec.Regional {
+		if err := identity.Set(IdentityRegion, providerRegion(meta)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (spec IdentitySpec) format() string {
+	parts := make([]string, len(spec.Attributes))
+	for i, attr := range spec.Attributes {
+		parts[i] = "<" + attr + ">"
+	}
+	return strings.Join(parts, spec.separator())
+}
+
+func providerRegion(meta interface{}) string {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return ""
+	}
+	bmxSess, err := sess.BluemixSession()
+	if err != nil || bmxSess == nil || bmxSess.Config == nil {
+		return ""
+	}
+	return bmxSess.Config.Region
+}
+
+// WithIdentity adds a resource identity described by spec to r. The identity
+// is derived from the resource ID after every successful create, read and
+// update, and an import by identity assembles the resource ID from it before
+// the existing importer runs. An ID that does not match spec is logged and
+// leaves the identity unset. r must support import.
+func WithIdentity(r *schema.Resource, spec IdentitySpec) *schema.Resource {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: spec.schema,
+	}
+
+	r.CreateContext = spec.wrapContext(r.CreateContext)
+	r.CreateWithoutTimeout = spec.wrapContext(r.CreateWithoutTimeout)
+	r.Create = spec.wrap(r.Create)
+	r.ReadContext = spec.wrapContext(r.ReadContext)
+	r.ReadWithoutTimeout = spec.wrapContext(r.ReadWithoutTimeout)
+	r.Read = spec.wrap(r.Read)
+	r.UpdateContext = spec.wrapContext(r.UpdateContext)
+	r.UpdateWithoutTimeout = spec.wrapContext(r.UpdateWithoutTimeout)
+	r.Update = spec.wrap(r.Update)
+
+	if r.Importer != nil {
+		r.Importer = &schema.ResourceImporter{
+			StateContext: spec.wrapImporter(r.Importer),
+		}
+	}
+	return r
+}
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func (spec IdentitySpec) wrapContext(f contextFunc) contextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		spec.setIdentity(d, meta)
+		return diags
+	}
+}
+
+type legacyFunc = func(*schema.ResourceData, interface{}) error
+
+func (spec IdentitySpec) wrap(f legacyFunc) legacyFunc {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			spec.setIdentity(d, meta)
+		}
+		return nil
+	}
+}
+
+// setIdentity sets the identity of d after a successful operation. The
+// operation has succeeded, so an identity that cannot be derived from the
+// resource ID is only logged and left unset.
+func (spec IdentitySpec) setIdentity(d *schema.ResourceData, meta interface{}) {
+	if err := spec.SetIdentity(d, meta); err != nil {
+		log.Printf("[WARN] Not setting the identity of %s: %s", d.Id(), err)
+	}
+}
+
+func (spec IdentitySpec) wrapImporter(importer *schema.ResourceImporter) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			id, err := spec.ID(identity)
+			if err != nil {
+				return nil, err
+			}
+			if spec.Regional {
+				region, ok := identity.GetOk(IdentityRegion)
+				if current := providerRegion(meta); ok && current != "" && region.(string) != current {
+					return nil, fmt.Errorf("[ERROR] Resource is in region %s but the provider is configured for region %s", region, current)
+				}
+			}
+			d.SetId(id)
+		}
+
+		switch {
+		case importer.StateContext != nil:
+			return importer.StateContext(ctx, d, meta)
+		case importer.State != nil:
+			return importer.State(d, meta)
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,116 @@
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIdentityResource(spec IdentitySpec) *schema.Resource {
+	return WithIdentity(&schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("name", "test"))
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("imported_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}, spec)
+}
+
+func TestWithIdentityRead(t *testing.T) {
+	r := testIdentityResource(IdentitySpec{
+		Attributes: []string{"record_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
+	assert.NoError(t, r.Identity.InternalIdentityValidate())
+
+	d := r.Data(&terraform.InstanceState{ID: "record:domain:crn:v1:bluemix:public:internet-svcs:global:a/1234::"})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "record", identity.Get("record_id"))
+	assert.Equal(t, "domain", identity.Get("domain_id"))
+	assert.Equal(t, "crn:v1:bluemix:public:internet-svcs:global:a/1234::", identity.Get("cis_id"))
+}
+
+func TestWithIdentityReadMalformedID(t *testing.T) {
+	r := testIdentityResource(IdentitySpec{Attributes: []string{"vpc_id", "address_prefix_id"}})
+
+	// The read succeeds without an identity
+	d := r.Data(&terraform.InstanceState{ID: "vpc"})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	assert.Equal(t, "test", d.Get("name"))
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Empty(t, identity.Get("vpc_id"))
+}
+
+func TestWithIdentityImport(t *testing.T) {
+	r := testIdentityResource(IdentitySpec{
+		Attributes: []string{"security_group_id", "rule_id"},
+		Separator:  ".",
+		Regional:   true,
+	})
+	assert.NoError(t, r.Identity.InternalIdentityValidate())
+	assert.Contains(t, r.Identity.SchemaMap(), IdentityRegion)
+
+	d := r.Data(nil)
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.NoError(t, identity.Set("security_group_id", "sg"))
+	require.NoError(t, identity.Set("rule_id", "rule"))
+
+	results, err := r.Importer.StateContext(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "sg.rule", results[0].Id())
+	assert.Equal(t, "sg.rule", results[0].Get("imported_id"))
+
+	// The identity assembled on import must survive the read that follows it.
+	assert.False(t, r.ReadContext(context.Background(), results[0], nil).HasError())
+	identity, err = results[0].Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "sg", identity.Get("security_group_id"))
+	assert.Equal(t, "rule", identity.Get("rule_id"))
+}
+
+func TestWithIdentityImportByID(t *testing.T) {
+	r := testIdentityResource(IdentitySpec{Attributes: []string{"id"}})
+
+	d := r.Data(nil)
+	d.SetId("r006-1234")
+	results, err := r.Importer.StateContext(context.Background(), d, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "r006-1234", results[0].Get("imported_id"))
+}
+
+func TestWithIdentityImportMissingAttribute(t *testing.T) {
+	r := testIdentityResource(IdentitySpec{Attributes: []string{"lb_id", "pool_id"}})
+
+	d := r.Data(nil)
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.NoError(t, identity.Set("lb_id", "lb"))
+
+	_, err = r.Importer.StateContext(context.Background(), d, nil)
+	assert.ErrorContains(t, err, "pool_id")
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportIdParts returns an importer for resources whose ID is made of the
+// arguments names joined by separator, in that order. The arguments are only
+// known to the read function through the ID, so they are set from it on
+// import. Parts whose name is empty are not set, and the last part keeps any
+// further separators.
+func ImportIdParts(separator string, names ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := SetIdParts(d, separator, names...); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ImportIdPrefix returns an importer like ImportIdParts for resources whose ID
+// starts with the arguments names and goes on with a varying number of parts.
+func ImportIdPrefix(separator string, names ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), separator, len(names)+1)
+		if len(parts) < len(names) {
+			return nil, fmt.Errorf("[ERROR] Incorrect ID %s: the ID should start with %s", d.Id(), strings.Join(idFormat(names), separator))
+		}
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			if err := d.Set(name, parts[i]); err != nil {
+				return nil, fmt.Errorf("[ERROR] Error setting %s: %s", name, err)
+			}
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// SetIdParts sets the arguments names to the parts of the ID of d, as
+// described by ImportIdParts, for importers that need more than the ID.
+func SetIdParts(d *schema.ResourceData, separator string, names ...string) error {
+	parts := strings.SplitN(d.Id(), separator, len(names))
+	if len(parts) != len(names) {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: the ID should be in the format %s", d.Id(), strings.Join(idFormat(names), separator))
+	}
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+		if err := d.Set(name, parts[i]); err != nil {
+			return fmt.Errorf("[ERROR] Error setting %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func idFormat(names []string) []string {
+	format := make([]string, len(names))
+	for i, name := range names {
+		if name == "" {
+			name = "id"
+		}
+		format[i] = "<" + name + ">"
+	}
+	return format
+}
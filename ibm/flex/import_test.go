@@ -0,0 +1,75 @@
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImportResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func TestImportIdParts(t *testing.T) {
+	r := testImportResource()
+
+	d := r.Data(&terraform.InstanceState{ID: "instance/rule/name/with/slashes"})
+	imported, err := ImportIdParts("/", "instance_id", "", "name")(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "instance/rule/name/with/slashes", imported[0].Id())
+	assert.Equal(t, "instance", imported[0].Get("instance_id"))
+	assert.Equal(t, "name/with/slashes", imported[0].Get("name"))
+}
+
+func TestImportIdPartsWholeID(t *testing.T) {
+	r := testImportResource()
+
+	d := r.Data(&terraform.InstanceState{ID: "crn:v1:bluemix:public:secrets-manager:us-south:a/1234:5678::"})
+	_, err := ImportIdParts(":", "instance_id")(context.Background(), d, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "crn:v1:bluemix:public:secrets-manager:us-south:a/1234:5678::", d.Get("instance_id"))
+}
+
+func TestImportIdPartsMalformedID(t *testing.T) {
+	r := testImportResource()
+
+	d := r.Data(&terraform.InstanceState{ID: "instance"})
+	_, err := ImportIdParts("/", "instance_id", "", "name")(context.Background(), d, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "<instance_id>/<id>/<name>")
+	assert.Empty(t, d.Get("instance_id"))
+}
+
+func TestImportIdPrefix(t *testing.T) {
+	r := testImportResource()
+
+	for _, id := range []string{"instance/name", "instance/name/member"} {
+		d := r.Data(&terraform.InstanceState{ID: id})
+		_, err := ImportIdPrefix("/", "instance_id", "name")(context.Background(), d, nil)
+		require.NoError(t, err)
+		assert.Equal(t, id, d.Id())
+		assert.Equal(t, "instance", d.Get("instance_id"))
+		assert.Equal(t, "name", d.Get("name"))
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "instance"})
+	_, err := ImportIdPrefix("/", "instance_id", "name")(context.Background(), d, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "<instance_id>/<name>")
+}
@@ -2646,6 +2646,28 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// String returns the CRN in its canonical colon separated form, the reverse
+// of Parse.
+func (c CRN) String() string {
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		c.Scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
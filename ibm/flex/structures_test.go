@@ -84,3 +84,17 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestCRNString(t *testing.T) {
+	crns := []string{
+		"crn:v1:bluemix:public:is:us-south:a/0123456789abcdef::vpc:r006-1234",
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef:1a2b3c4d::",
+		"crn:v1:bluemix:public:iam::::role:Viewer",
+		"crn:v1:bluemix:public:globalcatalog:global:global::offering:abc",
+	}
+	for _, s := range crns {
+		c, err := Parse(s)
+		assert.Nil(t, err)
+		assert.Equal(t, s, c.String())
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetWriteOnlyString returns the value configured for the WriteOnly string
+// attribute at key, or "" if it is not set. WriteOnly values are never kept in
+// the plan or the state, so they can only be read from the raw configuration
+// during create and update. The key uses the same dotted form as
+// ResourceData.Get, e.g. "parameters.0.api_token_wo".
+func GetWriteOnlyString(d *schema.ResourceData, key string) (string, error) {
+	var path cty.Path
+	for _, step := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("[ERROR] Error reading write-only attribute %s: %s", key, diags[0].Detail)
+	}
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
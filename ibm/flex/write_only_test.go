@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWriteOnlyString(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_token_wo": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							WriteOnly: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	d := resource.Data(&terraform.InstanceState{
+		ID: "id",
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"id":          cty.NullVal(cty.String),
+			"password_wo": cty.StringVal("secret"),
+			"parameters": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"api_token_wo": cty.StringVal("token"),
+			})}),
+		}),
+	})
+
+	value, err := GetWriteOnlyString(d, "password_wo")
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", value)
+
+	value, err = GetWriteOnlyString(d, "parameters.0.api_token_wo")
+	assert.Nil(t, err)
+	assert.Equal(t, "token", value)
+
+	_, err = GetWriteOnlyString(d, "missing_wo")
+	assert.NotNil(t, err)
+}
+
+func TestGetWriteOnlyStringUnset(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+	d := resource.Data(&terraform.InstanceState{
+		ID: "id",
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"id":          cty.NullVal(cty.String),
+			"password_wo": cty.NullVal(cty.String),
+		}),
+	})
+
+	value, err := GetWriteOnlyString(d, "password_wo")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+type buildCRNFunction struct{}
+
+var _ function.Function = buildCRNFunction{}
+
+func NewBuildCRNFunction() function.Function {
+	return buildCRNFunction{}
+}
+
+func (f buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a Cloud Resource Name",
+		Description: "Joins the segments of a Cloud Resource Name (CRN), as returned by `parse_crn`, back into a CRN.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "segments",
+				Description:    "The segments of the CRN. Empty strings leave the segment empty.",
+				AttributeTypes: crnAttributeTypes,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var segments crnModel
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &segments))
+	if resp.Error != nil {
+		return
+	}
+
+	crn := segments.CRN().String()
+	// Round trip through the parser so only well-formed CRNs are returned.
+	if _, err := flex.Parse(crn); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The segments do not form a valid CRN %q: %s", crn, err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crn))
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func crnSegments(t *testing.T, segments map[string]string) attr.Value {
+	attributes := make(map[string]attr.Value, len(crnAttributeTypes))
+	for name := range crnAttributeTypes {
+		attributes[name] = types.StringValue(segments[name])
+	}
+	value, diags := types.ObjectValue(crnAttributeTypes, attributes)
+	if diags.HasError() {
+		t.Fatalf("Error building the segments: %v", diags)
+	}
+	return value
+}
+
+func TestBuildCRNFunction(t *testing.T) {
+	segments := crnSegments(t, map[string]string{
+		"scheme":           "crn",
+		"version":          "v1",
+		"cname":            "bluemix",
+		"ctype":            "public",
+		"service_name":     "secrets-manager",
+		"region":           "eu-de",
+		"scope_type":       "a",
+		"scope":            "0123456789abcdef",
+		"service_instance": "b49ad24d",
+		"resource_type":    "secret",
+		"resource":         "6ebc4224",
+	})
+	value, funcErr := runFunction(NewBuildCRNFunction(), types.StringUnknown(), segments)
+	if funcErr != nil {
+		t.Fatalf("Unexpected error: %s", funcErr)
+	}
+
+	expected := "crn:v1:bluemix:public:secrets-manager:eu-de:a/0123456789abcdef:b49ad24d:secret:6ebc4224"
+	if got := value.(types.String).ValueString(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestBuildCRNFunctionRoundTrip(t *testing.T) {
+	crn := "crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef:1a2b3c4d::"
+	parsed, funcErr := runFunction(NewParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), types.StringValue(crn))
+	if funcErr != nil {
+		t.Fatalf("Unexpected error parsing: %s", funcErr)
+	}
+	value, funcErr := runFunction(NewBuildCRNFunction(), types.StringUnknown(), parsed)
+	if funcErr != nil {
+		t.Fatalf("Unexpected error building: %s", funcErr)
+	}
+	if got := value.(types.String).ValueString(); got != crn {
+		t.Errorf("Expected %q, got %q", crn, got)
+	}
+}
+
+func TestBuildCRNFunctionInvalid(t *testing.T) {
+	segments := crnSegments(t, map[string]string{"service_name": "is", "region": "us-south"})
+	if _, funcErr := runFunction(NewBuildCRNFunction(), types.StringUnknown(), segments); funcErr == nil {
+		t.Error("Expected an error building a CRN without the crn scheme")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type cidrFitsVPCAddressPrefixFunction struct{}
+
+var _ function.Function = cidrFitsVPCAddressPrefixFunction{}
+
+func NewCIDRFitsVPCAddressPrefixFunction() function.Function {
+	return cidrFitsVPCAddressPrefixFunction{}
+}
+
+func (f cidrFitsVPCAddressPrefixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_fits_vpc_address_prefix"
+}
+
+func (f cidrFitsVPCAddressPrefixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check that a CIDR fits in a VPC address prefix",
+		Description: "Returns true if every address of the CIDR block, such as the `ipv4_cidr_block` of a subnet, is within the CIDR of a VPC address prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The IPv4 CIDR block to check, for example `10.240.0.0/24`.",
+			},
+			function.StringParameter{
+				Name:        "address_prefix",
+				Description: "The CIDR of the VPC address prefix, for example `10.240.0.0/18`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrFitsVPCAddressPrefixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, addressPrefix string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr, &addressPrefix))
+	if resp.Error != nil {
+		return
+	}
+
+	_, network, err := parseIPv4CIDR(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	_, prefix, err := parseIPv4CIDR(addressPrefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	networkOnes, _ := network.Mask.Size()
+	prefixOnes, _ := prefix.Mask.Size()
+	fits := networkOnes >= prefixOnes && prefix.Contains(network.IP)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, fits))
+}
+
+// parseIPv4CIDR parses an IPv4 CIDR block. VPC address prefixes and subnets
+// only support IPv4.
+func parseIPv4CIDR(cidr string) (net.IP, *net.IPNet, error) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q must be a valid cidr address", cidr)
+	}
+	if ip.To4() == nil {
+		return nil, nil, fmt.Errorf("%q must be an IPv4 cidr address", cidr)
+	}
+	return ip, network, nil
+}
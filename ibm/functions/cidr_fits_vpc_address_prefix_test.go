@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCIDRFitsVPCAddressPrefixFunction(t *testing.T) {
+	testCases := []struct {
+		cidr, addressPrefix string
+		fits                bool
+	}{
+		{"10.240.0.0/24", "10.240.0.0/18", true},
+		{"10.240.63.0/24", "10.240.0.0/18", true},
+		{"10.240.0.0/18", "10.240.0.0/18", true},
+		{"10.240.64.0/24", "10.240.0.0/18", false},
+		{"10.240.0.0/16", "10.240.0.0/18", false},
+		{"192.168.0.0/24", "10.240.0.0/18", false},
+	}
+	for _, tc := range testCases {
+		value, funcErr := runFunction(NewCIDRFitsVPCAddressPrefixFunction(), types.BoolUnknown(),
+			types.StringValue(tc.cidr), types.StringValue(tc.addressPrefix))
+		if funcErr != nil {
+			t.Errorf("%s in %s: unexpected error: %s", tc.cidr, tc.addressPrefix, funcErr)
+			continue
+		}
+		if got := value.(types.Bool).ValueBool(); got != tc.fits {
+			t.Errorf("%s in %s: expected %t, got %t", tc.cidr, tc.addressPrefix, tc.fits, got)
+		}
+	}
+}
+
+func TestCIDRFitsVPCAddressPrefixFunctionInvalid(t *testing.T) {
+	testCases := []struct {
+		cidr, addressPrefix string
+	}{
+		{"10.240.0.0", "10.240.0.0/18"},
+		{"10.240.0.0/24", "not-a-cidr"},
+		{"2001:db8::/64", "2001:db8::/48"},
+	}
+	for _, tc := range testCases {
+		_, funcErr := runFunction(NewCIDRFitsVPCAddressPrefixFunction(), types.BoolUnknown(),
+			types.StringValue(tc.cidr), types.StringValue(tc.addressPrefix))
+		if funcErr == nil {
+			t.Errorf("%s in %s: expected an error", tc.cidr, tc.addressPrefix)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// crnAttributeTypes describes the object returned by parse_crn and accepted
+// by build_crn. There is one attribute per segment of a CRN.
+var crnAttributeTypes = map[string]attr.Type{
+	"scheme":           types.StringType,
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+type crnModel struct {
+	Scheme          string `tfsdk:"scheme"`
+	Version         string `tfsdk:"version"`
+	CName           string `tfsdk:"cname"`
+	CType           string `tfsdk:"ctype"`
+	ServiceName     string `tfsdk:"service_name"`
+	Region          string `tfsdk:"region"`
+	ScopeType       string `tfsdk:"scope_type"`
+	Scope           string `tfsdk:"scope"`
+	ServiceInstance string `tfsdk:"service_instance"`
+	ResourceType    string `tfsdk:"resource_type"`
+	Resource        string `tfsdk:"resource"`
+}
+
+func newCRNModel(crn flex.CRN) crnModel {
+	return crnModel{
+		Scheme:          crn.Scheme,
+		Version:         crn.Version,
+		CName:           crn.CName,
+		CType:           crn.CType,
+		ServiceName:     crn.ServiceName,
+		Region:          crn.Region,
+		ScopeType:       crn.ScopeType,
+		Scope:           crn.Scope,
+		ServiceInstance: crn.ServiceInstance,
+		ResourceType:    crn.ResourceType,
+		Resource:        crn.Resource,
+	}
+}
+
+func (m crnModel) CRN() flex.CRN {
+	return flex.CRN{
+		Scheme:          m.Scheme,
+		Version:         m.Version,
+		CName:           m.CName,
+		CType:           m.CType,
+		ServiceName:     m.ServiceName,
+		Region:          m.Region,
+		ScopeType:       m.ScopeType,
+		Scope:           m.Scope,
+		ServiceInstance: m.ServiceInstance,
+		ResourceType:    m.ResourceType,
+		Resource:        m.Resource,
+	}
+}
+
+type parseCRNFunction struct{}
+
+var _ function.Function = parseCRNFunction{}
+
+func NewParseCRNFunction() function.Function {
+	return parseCRNFunction{}
+}
+
+func (f parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Cloud Resource Name",
+		Description: "Splits a Cloud Resource Name (CRN) into its segments, such as the service name, the region, the account scope and the resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse, for example `crn:v1:bluemix:public:is:us-south:a/<account_id>::vpc:<vpc_id>`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var crn string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &crn))
+	if resp.Error != nil {
+		return
+	}
+	if crn == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The CRN must not be empty")
+		return
+	}
+
+	parsed, err := flex.Parse(crn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Error parsing CRN %q: %s", crn, err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, newCRNModel(parsed)))
+}
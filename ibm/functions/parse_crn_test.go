@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls f with the given arguments and returns its result, or the
+// function error.
+func runFunction(f function.Function, result attr.Value, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData(arguments)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseCRNFunction(t *testing.T) {
+	unknown := types.ObjectUnknown(crnAttributeTypes)
+	value, funcErr := runFunction(NewParseCRNFunction(), unknown,
+		types.StringValue("crn:v1:bluemix:public:is:us-south:a/0123456789abcdef::vpc:r006-1234"))
+	if funcErr != nil {
+		t.Fatalf("Unexpected error: %s", funcErr)
+	}
+
+	expected := map[string]string{
+		"scheme":           "crn",
+		"version":          "v1",
+		"cname":            "bluemix",
+		"ctype":            "public",
+		"service_name":     "is",
+		"region":           "us-south",
+		"scope_type":       "a",
+		"scope":            "0123456789abcdef",
+		"service_instance": "",
+		"resource_type":    "vpc",
+		"resource":         "r006-1234",
+	}
+	attributes := value.(types.Object).Attributes()
+	for name, want := range expected {
+		if got := attributes[name].(types.String).ValueString(); got != want {
+			t.Errorf("%s: expected %q, got %q", name, want, got)
+		}
+	}
+}
+
+func TestParseCRNFunctionInvalid(t *testing.T) {
+	for _, crn := range []string{"", "not-a-crn", "crn:v1:bluemix:public:is:us-south:a/b/c::vpc:r006-1234"} {
+		_, funcErr := runFunction(NewParseCRNFunction(), types.ObjectUnknown(crnAttributeTypes), types.StringValue(crn))
+		if funcErr == nil {
+			t.Errorf("Expected an error parsing %q", crn)
+		}
+	}
+}
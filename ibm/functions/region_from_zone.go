@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// zoneRegexp matches multizone region zone names such as us-south-1 or
+// eu-de-3, capturing the region.
+var zoneRegexp = regexp.MustCompile(`^([a-z]+-[a-z]+)-[0-9]+$`)
+
+type regionFromZoneFunction struct{}
+
+var _ function.Function = regionFromZoneFunction{}
+
+func NewRegionFromZoneFunction() function.Function {
+	return regionFromZoneFunction{}
+}
+
+func (f regionFromZoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_from_zone"
+}
+
+func (f regionFromZoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the region of a zone",
+		Description: "Returns the multizone region a zone belongs to, for example `us-south` for `us-south-1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "The name of the zone, for example `us-south-1`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f regionFromZoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	matches := zoneRegexp.FindStringSubmatch(zone)
+	if matches == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a zone of a multizone region, expected a name such as us-south-1", zone))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, matches[1]))
+}
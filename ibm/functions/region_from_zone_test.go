@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegionFromZoneFunction(t *testing.T) {
+	testCases := map[string]string{
+		"us-south-1": "us-south",
+		"eu-de-3":    "eu-de",
+		"jp-tok-2":   "jp-tok",
+		"ca-tor-1":   "ca-tor",
+	}
+	for zone, region := range testCases {
+		value, funcErr := runFunction(NewRegionFromZoneFunction(), types.StringUnknown(), types.StringValue(zone))
+		if funcErr != nil {
+			t.Errorf("%s: unexpected error: %s", zone, funcErr)
+			continue
+		}
+		if got := value.(types.String).ValueString(); got != region {
+			t.Errorf("%s: expected %q, got %q", zone, region, got)
+		}
+	}
+}
+
+func TestRegionFromZoneFunctionInvalid(t *testing.T) {
+	for _, zone := range []string{"", "us-south", "dal10", "US-SOUTH-1"} {
+		if _, funcErr := runFunction(NewRegionFromZoneFunction(), types.StringUnknown(), types.StringValue(zone)); funcErr == nil {
+			t.Errorf("Expected an error for zone %q", zone)
+		}
+	}
+}
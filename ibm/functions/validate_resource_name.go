@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+type validateResourceNameFunction struct{}
+
+var _ function.Function = validateResourceNameFunction{}
+
+func NewValidateResourceNameFunction() function.Function {
+	return validateResourceNameFunction{}
+}
+
+func (f validateResourceNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_resource_name"
+}
+
+func (f validateResourceNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check a name against the naming rules of a resource type",
+		Description: "Returns true if the name satisfies the rules the provider enforces on the `name` argument of the resource type, such as its length and the allowed characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: "The resource type, for example `ibm_is_vpc`.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f validateResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	validateFunc := validate.InvokeValidator(resourceType, "name")
+	if validateFunc == nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("No naming rules are known for the resource type %q", resourceType))
+		return
+	}
+	_, errs := validateFunc(name, "name")
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, len(errs) == 0))
+}
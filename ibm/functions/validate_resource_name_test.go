@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func TestValidateResourceNameFunction(t *testing.T) {
+	validate.SetValidatorDict(validate.ValidatorDict{
+		ResourceValidatorDictionary: map[string]*validate.ResourceValidator{
+			"ibm_is_vpc": {
+				ResourceName: "ibm_is_vpc",
+				Schema: []validate.ValidateSchema{
+					{
+						Identifier:                 "name",
+						ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+						Type:                       validate.TypeString,
+						Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+						MinValueLength:             1,
+						MaxValueLength:             63,
+					},
+				},
+			},
+		},
+	})
+	t.Cleanup(func() { validate.SetValidatorDict(validate.ValidatorDict{}) })
+
+	testCases := map[string]bool{
+		"my-vpc":  true,
+		"v":       true,
+		"My_VPC":  false,
+		"-my-vpc": false,
+		"my-vpc-": false,
+		"1-vpc":   false,
+		"":        false,
+	}
+	for name, valid := range testCases {
+		value, funcErr := runFunction(NewValidateResourceNameFunction(), types.BoolUnknown(),
+			types.StringValue("ibm_is_vpc"), types.StringValue(name))
+		if funcErr != nil {
+			t.Errorf("%q: unexpected error: %s", name, funcErr)
+			continue
+		}
+		if got := value.(types.Bool).ValueBool(); got != valid {
+			t.Errorf("%q: expected %t, got %t", name, valid, got)
+		}
+	}
+
+	if _, funcErr := runFunction(NewValidateResourceNameFunction(), types.BoolUnknown(),
+		types.StringValue("ibm_unknown"), types.StringValue("name")); funcErr == nil {
+		t.Error("Expected an error for an unknown resource type")
+	}
+}
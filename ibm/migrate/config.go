@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Finding is a change made by a migration, or a deprecated resource or
+// argument that is still in use.
+type Finding struct {
+	File string
+
+	// Address is the address of the block, e.g. ibm_is_vpc.main,
+	// data.ibm_is_image.ubuntu or provider.ibm.
+	Address string
+
+	// Argument is the path of the argument, empty for the block itself.
+	Argument string
+
+	Message string
+
+	// Fixed reports whether the migration changed the configuration.
+	Fixed bool
+}
+
+func (f Finding) String() string {
+	address := f.Address
+	if f.Argument != "" {
+		address += ": " + f.Argument
+	}
+	return fmt.Sprintf("%s: %s: %s", f.File, address, f.Message)
+}
+
+// Migration is the result of the migration of a module.
+type Migration struct {
+	// Files are the migrated files that changed, keyed by their name.
+	Files map[string][]byte
+
+	// Moved maps the addresses of the renamed resources to their new
+	// address, for the migration of their state.
+	Moved map[string]string
+
+	Findings []Finding
+}
+
+// Config migrates the configuration files of a module, keyed by their
+// name. It renames legacy and replaced types and the arguments of the rules,
+// rewrites the references to them in every expression of the module, and
+// reports the renamed resources, whose state Terraform cannot move to their
+// new type; see Rules.State. String literals are never changed.
+func (r Rules) Config(files map[string][]byte) (*Migration, error) {
+	m := &configMigration{rules: r, renamed: map[string]string{}, moved: map[string]string{}}
+	names := sortedKeys(files)
+	parsed := map[string]*hclwrite.File{}
+	for _, name := range names {
+		f, diags := hclwrite.ParseConfig(files[name], name, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		parsed[name] = f
+		m.file = name
+		for _, block := range f.Body().Blocks() {
+			m.block(block)
+		}
+	}
+
+	migrated := map[string][]byte{}
+	for _, name := range names {
+		m.references(parsed[name].Body())
+		if src := hclwrite.Format(parsed[name].Bytes()); string(src) != string(files[name]) {
+			migrated[name] = src
+		}
+	}
+	return &Migration{Files: migrated, Moved: m.moved, Findings: m.findings}, nil
+}
+
+type configMigration struct {
+	rules    Rules
+	file     string
+	findings []Finding
+	moved    map[string]string
+
+	// renamed maps the addresses of the resources and data sources moved
+	// to the type that replaces their deprecated type to that type.
+	renamed map[string]string
+}
+
+func (m *configMigration) report(address, argument, message string, fixed bool) {
+	m.findings = append(m.findings, Finding{File: m.file, Address: address, Argument: argument, Message: message, Fixed: fixed})
+}
+
+func (m *configMigration) block(block *hclwrite.Block) {
+	labels := block.Labels()
+	switch {
+	case block.Type() == "provider" && len(labels) == 1:
+		alias := ""
+		if attr := block.Body().GetAttribute("alias"); attr != nil {
+			alias = "." + strings.Trim(strings.TrimSpace(string(attr.Expr().BuildTokens(nil).Bytes())), `"`)
+		}
+		if labels[0] == LegacyProvider {
+			block.SetLabels([]string{"ibm"})
+			m.report("provider."+LegacyProvider+alias, "", "renamed to provider.ibm"+alias, true)
+		}
+		m.arguments("provider.ibm"+alias, "provider", "", block.Body(), m.rules.Provider)
+	case block.Type() == "terraform":
+		for _, nested := range block.Body().Blocks() {
+			if nested.Type() != "required_providers" {
+				continue
+			}
+			if attr := nested.Body().GetAttribute(LegacyProvider); attr != nil {
+				for _, token := range attr.Expr().BuildTokens(nil) {
+					if token.Type == hclsyntax.TokenQuotedLit && legacySource.Match(token.Bytes) {
+						token.Bytes = []byte(Source)
+					}
+				}
+				nested.Body().RenameAttribute(LegacyProvider, "ibm")
+				m.report("terraform", "required_providers."+LegacyProvider, "renamed to ibm with source "+Source, true)
+			}
+		}
+	case (block.Type() == "resource" || block.Type() == "data") && len(labels) == 2:
+		m.resource(block)
+	}
+}
+
+func (m *configMigration) resource(block *hclwrite.Block) {
+	labels := block.Labels()
+	mode, prefix, resources := "resource", "", m.rules.Resources
+	if block.Type() == "data" {
+		mode, prefix, resources = "data source", "data.", m.rules.DataSources
+	}
+	address := prefix + labels[0] + "." + labels[1]
+
+	resourceType, renamed := m.rules.Type(labels[0])
+	if r, ok := resources[resourceType]; ok && !renamed && r.DeprecationMessage != "" {
+		if replacement, ok := replacementType(r.DeprecationMessage, resourceType, resources); ok && fits(block.Body(), resources[replacement].Schema) {
+			resourceType, renamed = replacement, true
+			m.renamed[address] = replacement
+		}
+	}
+	if renamed {
+		block.SetLabels([]string{resourceType, labels[1]})
+		m.report(address, "", fmt.Sprintf("renamed to %s%s.%s", prefix, resourceType, labels[1]), true)
+		if block.Type() == "resource" {
+			m.moved[address] = resourceType + "." + labels[1]
+			m.report(address, "", fmt.Sprintf("Terraform cannot move a resource from %s to %s, migrate the state with -state, or run terraform state rm and terraform import", labels[0], resourceType), false)
+		}
+	}
+
+	r, ok := resources[resourceType]
+	if !ok {
+		if strings.HasPrefix(resourceType, "ibm_") {
+			m.report(address, "", fmt.Sprintf("%s %s does not exist", mode, resourceType), false)
+		}
+		return
+	}
+	address = prefix + resourceType + "." + labels[1]
+	if r.DeprecationMessage != "" {
+		m.report(address, "", fmt.Sprintf("%s %s is deprecated: %s", mode, resourceType, r.DeprecationMessage), false)
+	}
+	m.arguments(address, prefix+resourceType, "", block.Body(), r.Schema)
+}
+
+// arguments renames and reports the deprecated arguments of a block and of
+// its nested blocks.
+func (m *configMigration) arguments(address, key, prefix string, body *hclwrite.Body, args map[string]*schema.Schema) {
+	renames := m.rules.Arguments[key]
+	names := make([]string, 0, len(body.Attributes()))
+	for name := range body.Attributes() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s, ok := args[name]
+		if !ok || s.Deprecated == "" {
+			continue
+		}
+		if new, ok := renames[prefix+name]; ok {
+			new = strings.TrimPrefix(new, prefix)
+			if body.GetAttribute(new) == nil {
+				body.RenameAttribute(name, new)
+				m.report(address, prefix+name, "renamed to "+new, true)
+				continue
+			}
+		}
+		m.report(address, prefix+name, "deprecated: "+s.Deprecated, false)
+	}
+
+	for _, nested := range body.Blocks() {
+		name := nested.Type()
+		if name == "dynamic" && len(nested.Labels()) == 1 {
+			name = nested.Labels()[0]
+			if content := nested.Body().FirstMatchingBlock("content", nil); content != nil {
+				nested = content
+			}
+		}
+		s, ok := args[name]
+		if !ok {
+			continue
+		}
+		if s.Deprecated != "" {
+			m.report(address, prefix+name, "deprecated: "+s.Deprecated, false)
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			m.arguments(address, key, prefix+name+".", nested.Body(), elem.Schema)
+		}
+	}
+}
+
+// references rewrites the references to renamed types and arguments in
+// every expression of body.
+func (m *configMigration) references(body *hclwrite.Body) {
+	for _, attr := range body.Attributes() {
+		expr := attr.Expr()
+		for _, traversal := range expr.Variables() {
+			names := traversalNames(traversal)
+			if search, replacement, ok := m.reference(names); ok {
+				expr.RenameVariablePrefix(search, replacement)
+			}
+		}
+	}
+	for _, block := range body.Blocks() {
+		if block.Type() == "moved" {
+			continue
+		}
+		m.references(block.Body())
+	}
+}
+
+// reference returns the prefix of a reference to rename and its
+// replacement.
+func (m *configMigration) reference(names []string) ([]string, []string, bool) {
+	if len(names) == 0 {
+		return nil, nil, false
+	}
+	if names[0] == LegacyProvider {
+		// provider = ibmcloud or ibmcloud.<alias>
+		return names[:1], []string{"ibm"}, true
+	}
+	prefix := ""
+	rest := names
+	if names[0] == "data" {
+		prefix, rest = "data.", names[1:]
+	}
+	if len(rest) < 2 {
+		return nil, nil, false
+	}
+	resourceType, renamed := m.rules.Type(rest[0])
+	if new, ok := m.renamed[prefix+rest[0]+"."+rest[1]]; ok {
+		resourceType, renamed = new, true
+	}
+	search := append([]string{}, names[:len(names)-len(rest)+2]...)
+	replacement := append(append([]string{}, names[:len(names)-len(rest)]...), resourceType, rest[1])
+	if len(rest) > 2 {
+		if new, ok := m.rules.Arguments[prefix+resourceType][rest[2]]; ok {
+			search = append(search, rest[2])
+			replacement = append(replacement, new)
+			renamed = true
+		}
+	}
+	return search, replacement, renamed
+}
+
+// traversalNames returns the leading names of a traversal, up to its first
+// index or splat.
+func traversalNames(traversal *hclwrite.Traversal) []string {
+	var names []string
+	for _, token := range traversal.BuildTokens(nil) {
+		switch token.Type {
+		case hclsyntax.TokenIdent:
+			names = append(names, string(token.Bytes))
+		case hclsyntax.TokenDot:
+		default:
+			return names
+		}
+	}
+	return names
+}
+
+// Source is the source address of the provider.
+const Source = "IBM-Cloud/ibm"
+
+// legacySource matches the source addresses of the ibmcloud provider.
+var legacySource = regexp.MustCompile(`(?i)^(?:[a-z0-9.-]+/)?[a-z0-9-]+/ibmcloud$`)
+
+var typeName = regexp.MustCompile(`\bibm_[a-z0-9_]+\b`)
+
+// replacementType returns the type that a deprecation message names as the
+// replacement of resourceType, when there is exactly one.
+func replacementType(message, resourceType string, resources map[string]*schema.Resource) (string, bool) {
+	candidates := map[string]bool{}
+	for _, name := range typeName.FindAllString(message, -1) {
+		if _, ok := resources[name]; ok && name != resourceType {
+			candidates[name] = true
+		}
+	}
+	if len(candidates) != 1 {
+		return "", false
+	}
+	for name := range candidates {
+		return name, true
+	}
+	return "", false
+}
+
+// fits reports whether every argument and block of body is an argument of
+// args, so that the block can be moved to another type.
+func fits(body *hclwrite.Body, args map[string]*schema.Schema) bool {
+	for name := range body.Attributes() {
+		if s, ok := args[name]; !ok || !(s.Optional || s.Required) {
+			if !metaArguments[name] {
+				return false
+			}
+		}
+	}
+	for name, s := range args {
+		if s.Required && body.GetAttribute(name) == nil && body.FirstMatchingBlock(name, nil) == nil {
+			return false
+		}
+	}
+	for _, nested := range body.Blocks() {
+		if _, ok := args[nested.Type()]; !ok && !metaBlocks[nested.Type()] {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	metaArguments = map[string]bool{"count": true, "for_each": true, "depends_on": true, "provider": true}
+	metaBlocks    = map[string]bool{"lifecycle": true, "provisioner": true, "connection": true, "timeouts": true, "dynamic": true}
+)
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReadModules reads the .tf files of paths, which are directories, walked
+// with their subdirectories, or files. The files are keyed by their
+// directory, which is a module, and their name.
+func ReadModules(paths []string) (map[string]map[string][]byte, error) {
+	modules := map[string]map[string][]byte{}
+	add := func(path string) error {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Dir(path)
+		if modules[dir] == nil {
+			modules[dir] = map[string][]byte{}
+		}
+		modules[dir][filepath.Base(path)] = src
+		return nil
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if err := add(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && path != "." && strings.HasPrefix(d.Name(), ".") {
+				// .terraform holds the downloaded modules and providers
+				return filepath.SkipDir
+			}
+			if d.IsDir() || filepath.Ext(path) != ".tf" {
+				return nil
+			}
+			return add(path)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return modules, nil
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+var update = flag.Bool("update", false, "write the golden files of the migrations")
+
+// TestMigrations migrates each module of testdata, with its state when it
+// has one, and compares the migrated files and the findings with
+// testdata/<module>.golden.
+func TestMigrations(t *testing.T) {
+	rules := ProviderRules(provider.Provider())
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			got := migrateModule(t, rules, dir)
+			golden := dir + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%s, run go test ./ibm/migrate -update to write it", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Unexpected migration of %s:\n%s\nwant:\n%s", dir, got, want)
+			}
+		})
+	}
+}
+
+func migrateModule(t *testing.T, rules Rules, dir string) []byte {
+	names, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	for _, name := range names {
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Base(name)] = src
+	}
+	m, err := rules.Config(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	for _, name := range sortedKeys(m.Files) {
+		fmt.Fprintf(&out, "=== %s\n%s", name, m.Files[name])
+	}
+	findings := m.Findings
+	if src, err := os.ReadFile(filepath.Join(dir, "terraform.tfstate")); err == nil {
+		state, stateFindings, err := rules.State(src, m.Moved)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&out, "=== terraform.tfstate\n%s", state)
+		findings = append(findings, stateFindings...)
+	}
+	fmt.Fprintln(&out, "=== findings")
+	for _, f := range findings {
+		fmt.Fprintln(&out, f)
+	}
+	return out.Bytes()
+}
+
+func TestProviderRules(t *testing.T) {
+	rules := ProviderRules(provider.Provider())
+	for _, tc := range []struct {
+		key, old, new string
+	}{
+		{"provider", "bluemix_api_key", "ibmcloud_api_key"},
+		{"provider", "softlayer_endpoint_url", "iaas_classic_endpoint_url"},
+		{"ibm_pi_shared_processor_pool", "spp_placement_groups", "pi_shared_processor_pool_placement_groups"},
+		// Names are not replaced by IDs
+		{"data.ibm_pi_instance", "pi_instance_name", ""},
+		{"ibm_iam_service_policy", "iam_service_id", ""},
+	} {
+		if got := rules.Arguments[tc.key][tc.old]; got != tc.new {
+			t.Errorf("%s.%s is renamed to %q, want %q", tc.key, tc.old, got, tc.new)
+		}
+	}
+
+	for old, new := range LegacyTypes {
+		if got, ok := rules.Type(old); !ok || got != new {
+			t.Errorf("Type(%s) = %s, want %s", old, got, new)
+		}
+	}
+	if got, ok := rules.Type("ibmcloud_is_vpc"); !ok || got != "ibm_is_vpc" {
+		t.Errorf("Type(ibmcloud_is_vpc) = %s, want ibm_is_vpc", got)
+	}
+	if _, ok := rules.Type("ibmcloud_unknown"); ok {
+		t.Error("Type(ibmcloud_unknown) is renamed")
+	}
+}
+
+func TestReplacementArgument(t *testing.T) {
+	args := map[string]*schema.Schema{
+		"old_name":   {Type: schema.TypeString, Optional: true, Deprecated: "Use 'new_name' instead"},
+		"new_name":   {Type: schema.TypeString, Optional: true},
+		"old_count":  {Type: schema.TypeString, Optional: true, Deprecated: "use new_count"},
+		"new_count":  {Type: schema.TypeInt, Optional: true},
+		"both":       {Type: schema.TypeString, Optional: true, Deprecated: "use new_name or other_name"},
+		"other_name": {Type: schema.TypeString, Optional: true},
+		"computed":   {Type: schema.TypeString, Optional: true, Deprecated: "use new_id"},
+		"new_id":     {Type: schema.TypeString, Computed: true},
+	}
+	for name, want := range map[string]string{"old_name": "new_name", "old_count": "", "both": "", "computed": ""} {
+		got, _ := replacementArgument(name, args[name], args)
+		if got != want {
+			t.Errorf("replacementArgument(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestStateVersion(t *testing.T) {
+	_, _, err := Rules{}.State([]byte(`{"version": 3, "modules": []}`), nil)
+	if err == nil || !strings.Contains(err.Error(), "version 3 is not supported") {
+		t.Errorf("Expected an unsupported version error, got %v", err)
+	}
+}
+
+func TestReport(t *testing.T) {
+	report := ProviderRules(provider.Provider()).Report("1.0.0")
+	deprecations := map[string]Deprecation{}
+	for _, d := range report.Deprecations {
+		deprecations[strings.Trim(d.Type+"."+d.Path, ".")] = d
+	}
+	for name, want := range map[string]Deprecation{
+		"bluemix_api_key":                       {Kind: KindProviderArgument, Replacements: []string{"ibmcloud_api_key"}, Migrated: true},
+		"ibm_iam_service_policy.iam_service_id": {Kind: KindArgument, RemovedIn: "1.82.0", Replacements: []string{"iam_id"}},
+		"ibm_pi_snapshot":                       {Kind: KindResource, Replacements: []string{"ibm_pi_instance_snapshot"}},
+		"data.ibm_db2_whitelist_ip":             {Kind: KindDataSource, Replacements: []string{"data.ibm_db2_allowlist_ip"}},
+	} {
+		got, ok := deprecations[name]
+		if !ok {
+			t.Errorf("%s is not reported", name)
+			continue
+		}
+		if got.Kind != want.Kind || got.RemovedIn != want.RemovedIn || got.Migrated != want.Migrated || strings.Join(got.Replacements, ",") != strings.Join(want.Replacements, ",") {
+			t.Errorf("Unexpected deprecation of %s: %+v", name, got)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := report.Markdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	if want := "| `ibm_iam_service_policy.iam_service_id` | 1.82.0 | `iam_id` |  |"; !strings.Contains(b.String(), want) {
+		t.Errorf("Markdown report has no %q row", want)
+	}
+}
+
+func TestScan(t *testing.T) {
+	report := Report{Deprecations: []Deprecation{
+		{Kind: KindProviderArgument, Path: "generation", Message: "use VPC"},
+		{Kind: KindResource, Type: "ibm_old", Message: "use ibm_new"},
+		{Kind: KindArgument, Type: "ibm_thing", Path: "rule.port", Message: "use rule.ports"},
+		{Kind: KindAttribute, Type: "data.ibm_thing", Path: "guid", Message: "use id"},
+	}}
+	src := `provider "ibm" {
+  generation = 2
+}
+
+resource "ibm_old" "a" {}
+
+resource "ibm_thing" "b" {
+  dynamic "rule" {
+    for_each = [22]
+    content {
+      port = rule.value
+    }
+  }
+  name = "${data.ibm_thing.c.guid}-b"
+}
+`
+	findings, err := report.Scan(map[string][]byte{"main.tf": []byte(src)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range findings {
+		got = append(got, f.String())
+	}
+	want := []string{
+		"main.tf: provider.ibm: generation: deprecated: use VPC",
+		"main.tf: ibm_old.a: resource ibm_old is deprecated: use ibm_new",
+		"main.tf: ibm_thing.b: rule.port: deprecated: use rule.ports",
+		"main.tf: data.ibm_thing.c: guid: deprecated: use id",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected findings:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
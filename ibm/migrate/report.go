@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Kinds of deprecations.
+const (
+	KindResource         = "resource"
+	KindDataSource       = "data source"
+	KindProviderArgument = "provider argument"
+	KindArgument         = "argument"
+	KindAttribute        = "attribute"
+)
+
+// Deprecation is a deprecated resource, data source, argument or attribute
+// of the provider.
+type Deprecation struct {
+	Kind string `json:"kind"`
+
+	// Type is the type of the resource, prefixed with "data." for data
+	// sources, empty for provider arguments.
+	Type string `json:"type,omitempty"`
+
+	// Path is the path of the argument or attribute, joined with dots.
+	Path string `json:"path,omitempty"`
+
+	Message string `json:"message"`
+
+	// RemovedIn is the provider version that the message announces the
+	// removal in.
+	RemovedIn string `json:"removed_in,omitempty"`
+
+	// Replacements are the types or arguments that the message names.
+	Replacements []string `json:"replacements,omitempty"`
+
+	// Migrated reports whether the migration renames the argument to its
+	// replacement.
+	Migrated bool `json:"migrated,omitempty"`
+}
+
+// Report lists the deprecations of a version of the provider.
+type Report struct {
+	ProviderVersion string        `json:"provider_version"`
+	Deprecations    []Deprecation `json:"deprecations"`
+}
+
+var removalVersion = regexp.MustCompile(`(?i)remov.*?\bv?(\d+\.\d+\.\d+)\b`)
+
+// Report returns the deprecations of the provider of the rules, sorted by
+// kind, type and path.
+func (r Rules) Report(providerVersion string) Report {
+	report := Report{ProviderVersion: providerVersion}
+	add := func(d Deprecation) {
+		if match := removalVersion.FindStringSubmatch(d.Message); match != nil {
+			d.RemovedIn = match[1]
+		}
+		report.Deprecations = append(report.Deprecations, d)
+	}
+
+	var arguments func(key, prefix string, args map[string]*schema.Schema)
+	arguments = func(key, prefix string, args map[string]*schema.Schema) {
+		for name, s := range args {
+			if elem, ok := s.Elem.(*schema.Resource); ok {
+				arguments(key, prefix+name+".", elem.Schema)
+			}
+			if s.Deprecated == "" {
+				continue
+			}
+			d := Deprecation{Kind: KindArgument, Type: key, Path: prefix + name, Message: s.Deprecated}
+			switch {
+			case key == "provider":
+				d.Kind, d.Type = KindProviderArgument, ""
+			case !s.Optional && !s.Required:
+				d.Kind = KindAttribute
+			}
+			if new, ok := r.Arguments[key][prefix+name]; ok {
+				d.Replacements, d.Migrated = []string{new}, true
+			} else {
+				for _, mentioned := range mentionedArguments(name, s.Deprecated, args) {
+					d.Replacements = append(d.Replacements, prefix+mentioned)
+				}
+			}
+			add(d)
+		}
+	}
+	types := func(kind, prefix string, resources map[string]*schema.Resource) {
+		for name, resource := range resources {
+			if resource.DeprecationMessage != "" {
+				d := Deprecation{Kind: kind, Type: prefix + name, Message: resource.DeprecationMessage}
+				for _, mentioned := range typeName.FindAllString(resource.DeprecationMessage, -1) {
+					if _, ok := resources[mentioned]; ok && mentioned != name && !slices.Contains(d.Replacements, prefix+mentioned) {
+						d.Replacements = append(d.Replacements, prefix+mentioned)
+					}
+				}
+				add(d)
+			}
+			arguments(prefix+name, "", resource.Schema)
+		}
+	}
+
+	arguments("provider", "", r.Provider)
+	types(KindResource, "", r.Resources)
+	types(KindDataSource, "data.", r.DataSources)
+
+	order := map[string]int{KindProviderArgument: 0, KindResource: 1, KindDataSource: 2, KindArgument: 3, KindAttribute: 4}
+	sort.Slice(report.Deprecations, func(i, j int) bool {
+		a, b := report.Deprecations[i], report.Deprecations[j]
+		if a.Kind != b.Kind {
+			return order[a.Kind] < order[b.Kind]
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Path < b.Path
+	})
+	return report
+}
+
+// Markdown writes the report as Markdown, with a table per kind of
+// deprecation.
+func (report Report) Markdown(w io.Writer) error {
+	sections := []struct{ kind, title string }{
+		{KindProviderArgument, "Provider arguments"},
+		{KindResource, "Resources"},
+		{KindDataSource, "Data sources"},
+		{KindArgument, "Arguments"},
+		{KindAttribute, "Attributes"},
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Deprecations of the IBM Cloud provider %s\n", report.ProviderVersion)
+	fmt.Fprintf(&b, "\nArguments marked as migrated are renamed to their replacement by `ibm-migrate`.\n")
+	for _, section := range sections {
+		var rows []Deprecation
+		for _, d := range report.Deprecations {
+			if d.Kind == section.kind {
+				rows = append(rows, d)
+			}
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", section.title)
+		fmt.Fprintln(&b, "| Name | Removed in | Replacement | Migrated | Message |")
+		fmt.Fprintln(&b, "|------|------------|-------------|----------|---------|")
+		for _, d := range rows {
+			name := d.Type
+			if d.Path != "" {
+				name = strings.TrimPrefix(name+"."+d.Path, ".")
+			}
+			migrated := ""
+			if d.Migrated {
+				migrated = "yes"
+			}
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", name, d.RemovedIn, code(d.Replacements), migrated, markdownCell(d.Message))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func code(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func markdownCell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// Scan reports the uses of the deprecations of the report in the
+// configuration files of a module, keyed by their name: the deprecated
+// resources, data sources and arguments, and the references to deprecated
+// attributes.
+func (report Report) Scan(files map[string][]byte) ([]Finding, error) {
+	index := map[string]map[string]Deprecation{}
+	for _, d := range report.Deprecations {
+		key := d.Type
+		if d.Kind == KindProviderArgument {
+			key = "provider"
+		}
+		if index[key] == nil {
+			index[key] = map[string]Deprecation{}
+		}
+		index[key][d.Path] = d
+	}
+
+	var findings []Finding
+	for _, name := range sortedKeys(files) {
+		f, diags := hclsyntax.ParseConfig(files[name], name, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		s := &scan{file: name, index: index}
+		for _, block := range f.Body.(*hclsyntax.Body).Blocks {
+			s.block(block)
+		}
+		hclsyntax.VisitAll(f.Body.(*hclsyntax.Body), func(node hclsyntax.Node) hcl.Diagnostics {
+			if expr, ok := node.(*hclsyntax.ScopeTraversalExpr); ok {
+				s.reference(expr.Traversal)
+			}
+			return nil
+		})
+		findings = append(findings, s.findings...)
+	}
+	return findings, nil
+}
+
+type scan struct {
+	file     string
+	index    map[string]map[string]Deprecation
+	findings []Finding
+}
+
+func (s *scan) report(address string, d Deprecation) {
+	message := "deprecated: " + d.Message
+	if d.Path == "" {
+		message = fmt.Sprintf("%s %s is deprecated: %s", d.Kind, strings.TrimPrefix(d.Type, "data."), d.Message)
+	}
+	s.findings = append(s.findings, Finding{File: s.file, Address: address, Argument: d.Path, Message: message})
+}
+
+func (s *scan) block(block *hclsyntax.Block) {
+	switch {
+	case block.Type == "provider" && len(block.Labels) == 1 && block.Labels[0] == "ibm":
+		s.arguments("provider.ibm", "provider", "", block.Body)
+	case (block.Type == "resource" || block.Type == "data") && len(block.Labels) == 2:
+		key := block.Labels[0]
+		if block.Type == "data" {
+			key = "data." + key
+		}
+		address := key + "." + block.Labels[1]
+		if d, ok := s.index[key][""]; ok {
+			s.report(address, d)
+		}
+		s.arguments(address, key, "", block.Body)
+	}
+}
+
+func (s *scan) arguments(address, key, prefix string, body *hclsyntax.Body) {
+	names := make([]string, 0, len(body.Attributes))
+	for name := range body.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if d, ok := s.index[key][prefix+name]; ok {
+			s.report(address, d)
+		}
+	}
+	for _, nested := range body.Blocks {
+		name, nestedBody := nested.Type, nested.Body
+		if name == "dynamic" && len(nested.Labels) == 1 {
+			name = nested.Labels[0]
+			for _, content := range nested.Body.Blocks {
+				if content.Type == "content" {
+					nestedBody = content.Body
+				}
+			}
+		}
+		if d, ok := s.index[key][prefix+name]; ok {
+			s.report(address, d)
+		}
+		s.arguments(address, key, prefix+name+".", nestedBody)
+	}
+}
+
+// reference reports the references to deprecated attributes, e.g.
+// ibm_is_vpc.main.default_network_acl.
+func (s *scan) reference(traversal hcl.Traversal) {
+	// Indexes of lists of nested blocks are not part of the path
+	var names []string
+	for _, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, step.Name)
+		case hcl.TraverseAttr:
+			names = append(names, step.Name)
+		}
+	}
+	prefix := ""
+	if len(names) > 0 && names[0] == "data" {
+		prefix, names = "data.", names[1:]
+	}
+	if len(names) < 3 {
+		return
+	}
+	key := prefix + names[0]
+	for i := 3; i <= len(names); i++ {
+		if d, ok := s.index[key][strings.Join(names[2:i], ".")]; ok {
+			s.report(key+"."+names[1], d)
+			return
+		}
+	}
+}
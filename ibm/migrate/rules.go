@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package migrate upgrades Terraform configurations and states written for
+// older versions of the provider. It renames the resource types of the
+// ibmcloud provider and the arguments whose deprecation message names their
+// replacement, and reports the deprecated resources and arguments that
+// cannot be renamed automatically. Report lists the deprecations of the
+// provider, and scans configurations against them.
+package migrate
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// LegacyTypes are the resource and data source types of the ibmcloud
+// provider and the types that replace them. Other ibmcloud_<name> types are
+// renamed to ibm_<name> when the provider has them.
+var LegacyTypes = map[string]string{
+	"ibmcloud_cf_account":                        "ibm_account",
+	"ibmcloud_cf_app":                            "ibm_app",
+	"ibmcloud_cf_org":                            "ibm_org",
+	"ibmcloud_cf_private_domain":                 "ibm_app_domain_private",
+	"ibmcloud_cf_route":                          "ibm_app_route",
+	"ibmcloud_cf_service_instance":               "ibm_service_instance",
+	"ibmcloud_cf_service_key":                    "ibm_service_key",
+	"ibmcloud_cf_service_plan":                   "ibm_service_plan",
+	"ibmcloud_cf_shared_domain":                  "ibm_app_domain_shared",
+	"ibmcloud_cf_space":                          "ibm_space",
+	"ibmcloud_cs_cluster_config":                 "ibm_container_cluster_config",
+	"ibmcloud_cs_cluster":                        "ibm_container_cluster",
+	"ibmcloud_cs_worker":                         "ibm_container_cluster_worker",
+	"ibmcloud_infra_dns_domain":                  "ibm_dns_domain",
+	"ibmcloud_infra_image_template":              "ibm_compute_image_template",
+	"ibmcloud_infra_ssh_key":                     "ibm_compute_ssh_key",
+	"ibmcloud_infra_virtual_guest":               "ibm_compute_vm_instance",
+	"ibmcloud_infra_vlan":                        "ibm_network_vlan",
+	"ibmcloud_cs_cluster_bind_service":           "ibm_container_bind_service",
+	"ibmcloud_infra_bare_metal":                  "ibm_compute_bare_metal",
+	"ibmcloud_infra_basic_monitor":               "ibm_compute_monitor",
+	"ibmcloud_infra_block_storage":               "ibm_storage_block",
+	"ibmcloud_infra_dns_domain_record":           "ibm_dns_record",
+	"ibmcloud_infra_file_storage":                "ibm_storage_file",
+	"ibmcloud_infra_fw_hardware_dedicated_rules": "ibm_firewall_policy",
+	"ibmcloud_infra_fw_hardware_dedicated":       "ibm_firewall",
+	"ibmcloud_infra_global_ip":                   "ibm_network_public_ip",
+	"ibmcloud_infra_lb_local_service_group":      "ibm_lb_service_group",
+	"ibmcloud_infra_lb_local_service":            "ibm_lb_service",
+	"ibmcloud_infra_lb_local":                    "ibm_lb",
+	"ibmcloud_infra_lb_vpx_ha":                   "ibm_lb_vpx_ha",
+	"ibmcloud_infra_lb_vpx_service":              "ibm_lb_vpx_service",
+	"ibmcloud_infra_lb_vpx_vip":                  "ibm_lb_vpx_vip",
+	"ibmcloud_infra_lb_vpx":                      "ibm_lb_vpx",
+	"ibmcloud_infra_objectstorage_account":       "ibm_object_storage_account",
+	"ibmcloud_infra_provisioning_hook":           "ibm_compute_provisioning_hook",
+	"ibmcloud_infra_scale_group":                 "ibm_compute_autoscale_group",
+	"ibmcloud_infra_scale_policy":                "ibm_compute_autoscale_policy",
+	"ibmcloud_infra_security_certificate":        "ibm_compute_ssl_certificate",
+	"ibmcloud_infra_user":                        "ibm_compute_user",
+}
+
+// DifferentValues are the deprecated arguments of resources and data sources
+// whose replacement takes another value, e.g. the IAM ID of a service ID
+// instead of its UUID, and that are reported instead of renamed.
+var DifferentValues = map[string]bool{
+	"ibm_iam_service_policy.iam_service_id":          true,
+	"ibm_iam_trusted_profile_policy.profile_id":      true,
+	"data.ibm_iam_service_policy.iam_service_id":     true,
+	"data.ibm_iam_trusted_profile_policy.profile_id": true,
+}
+
+// LegacyProvider is the name of the provider that preceded ibm.
+const LegacyProvider = "ibmcloud"
+
+// Rules are the renames applied by a migration, with the schemas used to
+// report deprecated resources and arguments.
+type Rules struct {
+	// Types maps old resource and data source types to new ones.
+	Types map[string]string
+
+	// Arguments maps resource types, data source types prefixed with
+	// "data." and "provider" to the arguments to rename, from their old to
+	// their new path. Paths of arguments of nested blocks are joined with
+	// dots, e.g. boot_volume.name.
+	Arguments map[string]map[string]string
+
+	Provider    map[string]*schema.Schema
+	Resources   map[string]*schema.Resource
+	DataSources map[string]*schema.Resource
+}
+
+// ProviderRules returns the rules of the provider. Arguments are renamed
+// when their deprecation message names a single other argument of the same
+// block and type that replaces them.
+func ProviderRules(p *schema.Provider) Rules {
+	rules := Rules{
+		Types:       map[string]string{},
+		Arguments:   map[string]map[string]string{},
+		Provider:    p.Schema,
+		Resources:   p.ResourcesMap,
+		DataSources: p.DataSourcesMap,
+	}
+	for old, new := range LegacyTypes {
+		rules.Types[old] = new
+	}
+	addArguments(rules.Arguments, "provider", "", p.Schema)
+	for name, r := range p.ResourcesMap {
+		addArguments(rules.Arguments, name, "", r.Schema)
+	}
+	for name, r := range p.DataSourcesMap {
+		addArguments(rules.Arguments, "data."+name, "", r.Schema)
+	}
+	return rules
+}
+
+// Type returns the type that replaces a resource or data source type, and
+// whether it is renamed.
+func (r Rules) Type(name string) (string, bool) {
+	if new, ok := r.Types[name]; ok {
+		return new, true
+	}
+	if rest, ok := strings.CutPrefix(name, LegacyProvider+"_"); ok {
+		new := "ibm_" + rest
+		if _, ok := r.Resources[new]; ok {
+			return new, true
+		}
+		if _, ok := r.DataSources[new]; ok {
+			return new, true
+		}
+	}
+	return name, false
+}
+
+func addArguments(arguments map[string]map[string]string, key, prefix string, args map[string]*schema.Schema) {
+	for name, s := range args {
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			addArguments(arguments, key, prefix+name+".", elem.Schema)
+		}
+		if s.Deprecated == "" || !(s.Optional || s.Required) || DifferentValues[key+"."+prefix+name] {
+			continue
+		}
+		if replacement, ok := replacementArgument(name, s, args); ok {
+			if arguments[key] == nil {
+				arguments[key] = map[string]string{}
+			}
+			arguments[key][prefix+name] = prefix + replacement
+		}
+	}
+}
+
+var (
+	quotedName      = regexp.MustCompile("[`'\"]([a-z][a-z0-9_]*)[`'\"]")
+	replacementName = regexp.MustCompile(`(?i)\b(?:use|favor of)\s+(?:the\s+argument\s+)?([a-z][a-z0-9_]*)`)
+)
+
+// replacementArgument returns the argument of args that the deprecation
+// message of name names as its replacement, when there is exactly one and it
+// has the same type and takes the same kind of value: names are not
+// replaced by IDs.
+func replacementArgument(name string, s *schema.Schema, args map[string]*schema.Schema) (string, bool) {
+	var candidates []string
+	for _, candidate := range mentionedArguments(name, s.Deprecated, args) {
+		if other := args[candidate]; other.Optional || other.Required {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) != 1 {
+		return "", false
+	}
+	replacement, other := candidates[0], args[candidates[0]]
+	if other.Type != s.Type || !sameElem(s.Elem, other.Elem) || lastWord(name) != lastWord(replacement) {
+		return "", false
+	}
+	return replacement, true
+}
+
+// mentionedArguments returns the arguments of args other than name that a
+// deprecation message names, quoted or after "use", and that are not
+// deprecated themselves.
+func mentionedArguments(name, message string, args map[string]*schema.Schema) []string {
+	mentioned := map[string]bool{}
+	for _, re := range []*regexp.Regexp{quotedName, replacementName} {
+		for _, match := range re.FindAllStringSubmatch(message, -1) {
+			if other, ok := args[match[1]]; ok && match[1] != name && other.Deprecated == "" {
+				mentioned[match[1]] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(mentioned))
+	for name := range mentioned {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lastWord returns the last word of an argument name, e.g. id or name.
+func lastWord(name string) string {
+	return name[strings.LastIndex(name, "_")+1:]
+}
+
+func sameElem(a, b interface{}) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case *schema.Schema:
+		b, ok := b.(*schema.Schema)
+		return ok && a.Type == b.Type
+	}
+	return false
+}
+
+// sortedKeys returns the keys of m, sorted.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ProviderAddress is the address of the provider in states.
+const ProviderAddress = `provider["registry.terraform.io/ibm-cloud/ibm"]`
+
+// legacyProviderAddress matches the addresses of the ibmcloud provider in
+// states, with their alias.
+var legacyProviderAddress = regexp.MustCompile(`^provider(?:\.ibmcloud|\["[^"]*/ibmcloud"\])(\.[A-Za-z0-9_-]+)?$`)
+
+type state struct {
+	Version          int             `json:"version"`
+	TerraformVersion string          `json:"terraform_version"`
+	Serial           int64           `json:"serial"`
+	Lineage          string          `json:"lineage"`
+	Outputs          json.RawMessage `json:"outputs,omitempty"`
+	Resources        []stateResource `json:"resources"`
+	CheckResults     json.RawMessage `json:"check_results,omitempty"`
+}
+
+type stateResource struct {
+	Module    string                       `json:"module,omitempty"`
+	Mode      string                       `json:"mode"`
+	Type      string                       `json:"type"`
+	Name      string                       `json:"name"`
+	Each      string                       `json:"each,omitempty"`
+	Provider  string                       `json:"provider"`
+	Instances []map[string]json.RawMessage `json:"instances"`
+}
+
+// State migrates a state of version 4, written by Terraform 0.12 or later.
+// It renames the legacy types of its resources and their provider, moves
+// the resources of moved, usually the Moved addresses of the migration of the
+// configuration, and renames the dependencies on them. The serial of the
+// state is incremented when it changes.
+func (r Rules) State(src []byte, moved map[string]string) ([]byte, []Finding, error) {
+	var s state
+	if err := json.Unmarshal(src, &s); err != nil {
+		return nil, nil, err
+	}
+	if s.Version != 4 {
+		return nil, nil, fmt.Errorf("[ERROR] State version %d is not supported, run terraform refresh with Terraform 0.12 or later to upgrade it", s.Version)
+	}
+
+	var findings []Finding
+	report := func(address, message string) {
+		findings = append(findings, Finding{File: "state", Address: address, Message: message, Fixed: true})
+	}
+	rename := func(module, address string) string {
+		if module == "" {
+			if new, ok := moved[address]; ok {
+				return new
+			}
+		}
+		prefix := ""
+		if rest, ok := strings.CutPrefix(address, "data."); ok {
+			prefix, address = "data.", rest
+		}
+		resourceType, name, ok := strings.Cut(address, ".")
+		if !ok {
+			return prefix + address
+		}
+		resourceType, _ = r.Type(resourceType)
+		return prefix + resourceType + "." + name
+	}
+
+	changed := false
+	for i := range s.Resources {
+		res := &s.Resources[i]
+		prefix := ""
+		if res.Mode == "data" {
+			prefix = "data."
+		}
+		address := prefix + res.Type + "." + res.Name
+		if new := rename(res.Module, address); new != address {
+			res.Type = strings.TrimSuffix(strings.TrimPrefix(new, prefix), "."+res.Name)
+			report(moduleAddress(res.Module, address), "renamed to "+moduleAddress(res.Module, new))
+			changed = true
+		}
+		if match := legacyProviderAddress.FindStringSubmatch(res.Provider); match != nil {
+			res.Provider = ProviderAddress + match[1]
+			changed = true
+		}
+		for _, instance := range res.Instances {
+			raw, ok := instance["dependencies"]
+			if !ok {
+				continue
+			}
+			var dependencies []string
+			if err := json.Unmarshal(raw, &dependencies); err != nil {
+				return nil, nil, fmt.Errorf("[ERROR] Dependencies of %s: %s", address, err)
+			}
+			depChanged := false
+			for j, dependency := range dependencies {
+				module, local := splitModule(dependency)
+				if new := rename(module, local); new != local {
+					dependencies[j] = moduleAddress(module, new)
+					depChanged = true
+				}
+			}
+			if depChanged {
+				instance["dependencies"], _ = json.Marshal(dependencies)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return src, findings, nil
+	}
+
+	s.Serial++
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), findings, nil
+}
+
+// splitModule splits an absolute address into its module path and its
+// address in the module.
+func splitModule(address string) (string, string) {
+	parts := strings.Split(address, ".")
+	i := 0
+	for i+1 < len(parts) && parts[i] == "module" {
+		i += 2
+	}
+	return strings.Join(parts[:i], "."), strings.Join(parts[i:], ".")
+}
+
+func moduleAddress(module, address string) string {
+	if module == "" {
+		return address
+	}
+	return module + "." + address
+}
@@ -0,0 +1,30 @@
+resource "ibm_pi_shared_processor_pool" "pool" {
+  pi_cloud_instance_id                    = var.cloud_instance_id
+  pi_shared_processor_pool_host_group     = "s922"
+  pi_shared_processor_pool_name           = "pool"
+  pi_shared_processor_pool_reserved_cores = 1
+  spp_placement_groups                    = [var.placement_group]
+}
+
+resource "ibm_iam_service_policy" "policy" {
+  iam_service_id = ibm_iam_service_id.service.id
+  roles          = ["Viewer"]
+}
+
+resource "ibm_pi_snapshot" "snapshot" {
+  pi_cloud_instance_id = var.cloud_instance_id
+  pi_instance_name     = "instance"
+  pi_snap_shot_name    = "snapshot"
+}
+
+data "ibm_db2_whitelist_ip" "db2" {
+  x_deployment_id = var.deployment_id
+}
+
+output "placement_groups" {
+  value = ibm_pi_shared_processor_pool.pool.spp_placement_groups
+}
+
+output "db2_ips" {
+  value = data.ibm_db2_whitelist_ip.db2.ip_addresses
+}
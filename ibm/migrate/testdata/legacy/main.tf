@@ -0,0 +1,30 @@
+data "ibmcloud_infra_image_template" "base" {
+  name = "base-image"
+}
+
+resource "ibmcloud_infra_vlan" "private" {
+  name       = "private"
+  datacenter = "dal10"
+  type       = "PRIVATE"
+}
+
+resource "ibmcloud_infra_virtual_guest" "web" {
+  provider          = ibmcloud.dallas
+  hostname          = "web"
+  domain            = "example.com"
+  datacenter        = ibmcloud_infra_vlan.private.datacenter
+  image_id          = data.ibmcloud_infra_image_template.base.id
+  private_vlan_id   = ibmcloud_infra_vlan.private.id
+  network_speed     = 100
+  cores             = 1
+  memory            = 1024
+  # The old names in strings and comments, like ibmcloud_infra_vlan, are kept
+  user_metadata = "{\"vlan\": \"ibmcloud_infra_vlan.private\", \"id\": \"${ibmcloud_infra_vlan.private.id}\"}"
+  depends_on    = [ibmcloud_infra_vlan.private]
+}
+
+resource "ibmcloud_cs_cluster_bind_service" "bind" {
+  cluster_name_id     = "my-cluster"
+  service_instance_id = "instance"
+  namespace_id        = "default"
+}
@@ -0,0 +1,7 @@
+output "web_ips" {
+  value = [for guest in [ibmcloud_infra_virtual_guest.web] : guest.ipv4_address]
+}
+
+output "vlan" {
+  value = "VLAN ${ibmcloud_infra_vlan.private.vlan_number} in ${ibmcloud_infra_vlan.private.datacenter}"
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    ibmcloud = {
+      source = "ibm-cloud/ibmcloud"
+    }
+  }
+}
+
+provider "ibmcloud" {
+  bluemix_api_key    = var.api_key
+  softlayer_username = var.username
+  softlayer_api_key  = var.classic_api_key
+}
+
+provider "ibmcloud" {
+  alias            = "dallas"
+  ibmcloud_api_key = var.api_key
+  region           = "us-south"
+}
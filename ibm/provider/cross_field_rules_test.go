@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func TestCrossFieldRulePaths(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name, validator := range Validator().ResourceValidatorDictionary {
+		if len(validator.Rules) == 0 {
+			continue
+		}
+		resource, ok := resources[name]
+		if !ok {
+			t.Errorf("%s: rules of a resource that does not exist", name)
+			continue
+		}
+		if resource.CustomizeDiff == nil {
+			t.Errorf("%s: rules are not checked at plan time", name)
+		}
+		ty := resource.CoreConfigSchema().ImpliedType()
+		for i, rule := range validator.Rules {
+			paths := append([]string{rule.Identifier, rule.Scale, rule.MinValue, rule.MaxValue}, rule.Targets...)
+			for _, condition := range rule.When {
+				paths = append(paths, condition.Identifier)
+			}
+			for _, path := range paths {
+				if _, err := strconv.ParseFloat(path, 64); path == "" || err == nil {
+					continue
+				}
+				if !hasPath(ty, path) {
+					t.Errorf("%s: rule %d: %s is not an attribute", name, i, path)
+				}
+			}
+			if rule.Type == validate.Custom && rule.Check == nil {
+				t.Errorf("%s: rule %d: custom rule without a check", name, i)
+			}
+		}
+	}
+}
+
+func hasPath(ty cty.Type, path string) bool {
+	for _, step := range strings.Split(path, ".") {
+		switch {
+		case step == "#" && (ty.IsListType() || ty.IsSetType() || ty.IsMapType()):
+			ty = cty.Number
+		case ty.IsListType() || ty.IsSetType():
+			if _, err := strconv.Atoi(step); err != nil && step != "*" {
+				return false
+			}
+			ty = ty.ElementType()
+		case ty.IsMapType():
+			ty = ty.ElementType()
+		case ty.IsObjectType() && ty.HasAttribute(step):
+			ty = ty.AttributeType(step)
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func TestCrossFieldRules(t *testing.T) {
+	resources := Provider().ResourcesMap
+	validators := Validator().ResourceValidatorDictionary
+	for _, tc := range []struct {
+		resource string
+		config   string
+		err      string
+	}{
+		// VPC
+		{"ibm_is_security_group_rule", `{"protocol": "icmp", "type": 8, "code": 0}`, ""},
+		{"ibm_is_security_group_rule", `{"protocol": "tcp", "type": 8}`, "type cannot be set when protocol is set and protocol is not \"icmp\""},
+		{"ibm_is_security_group_rule", `{"protocol": "icmp", "port_min": 22}`, "port_min cannot be set"},
+		{"ibm_is_security_group_rule", `{"protocol": "icmp", "code": 0}`, "type is required when code is set"},
+		{"ibm_is_security_group_rule", `{"icmp": [{"code": 0}]}`, "icmp.0.type is required"},
+		{"ibm_is_security_group_rule", `{"protocol": "tcp", "port_min": 80, "port_max": 22}`, "port_min must be at most port_max"},
+		{"ibm_is_security_group_rule", `{"udp": [{"port_min": 53, "port_max": 53}]}`, ""},
+		{"ibm_is_security_group_rule", `{"tcp": [{"port_min": 443, "port_max": 80}]}`, "tcp.0.port_min must be at most tcp.0.port_max"},
+		{"ibm_is_network_acl_rule", `{"protocol": "udp", "source_port_min": 1, "source_port_max": 65535}`, ""},
+		{"ibm_is_network_acl_rule", `{"protocol": "all", "source_port_min": 1}`, "source_port_min"},
+		{"ibm_is_network_acl_rule", `{"protocol": "tcp", "code": 1}`, "code cannot be set"},
+		{"ibm_is_network_acl_rule", `{"udp": [{"source_port_min": 100, "source_port_max": 10}]}`, "udp.0.source_port_min must be at most"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "icmp": [{"type": 8}]}, {"name": "b", "tcp": [{"port_min": 22, "port_max": 22}]}]}`, ""},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "icmp": [{"type": 8}], "tcp": [{}]}]}`, "rules.0.tcp cannot be set when rules.0.icmp is set"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a"}, {"name": "b", "tcp": [{}], "udp": [{}]}]}`, "rules.1.udp cannot be set when rules.1.tcp is set"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "protocol": "icmp", "icmp": [{}]}]}`, "rules.0.icmp cannot be set when rules.0.protocol is set"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "protocol": "udp", "type": 8}]}`, "rules.0.type cannot be set"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "protocol": "icmp", "port_max": 8}]}`, "rules.0.port_max cannot be set"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "icmp": [{"code": 1}]}]}`, "rules.0.icmp.0.type is required"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "protocol": "tcp", "port_min": 9, "port_max": 8}]}`, "rules.0.port_min must be at most rules.0.port_max"},
+		{"ibm_is_network_acl", `{"rules": [{"name": "a", "tcp": [{"source_port_min": 9, "source_port_max": 8}]}]}`, "rules.0.tcp.0.source_port_min must be at most"},
+		{"ibm_is_lb_listener", `{"protocol": "https", "certificate_instance": "crn"}`, ""},
+		{"ibm_is_lb_listener", `{"protocol": "https"}`, "certificate_instance is required when protocol is \"https\""},
+		{"ibm_is_lb_listener", `{"protocol": "tcp", "certificate_instance": "crn"}`, "certificate_instance cannot be set"},
+		{"ibm_is_lb_listener", `{"protocol": "https", "certificate_instance": "crn", "https_redirect_uri": "/"}`, "https_redirect_uri cannot be set when protocol is not \"http\""},
+		{"ibm_is_lb_listener", `{"protocol": "tcp", "port_min": 2000, "port_max": 1000}`, "port_min must be at most port_max"},
+		{"ibm_is_lb_pool", `{"session_persistence_type": "app_cookie", "session_persistence_app_cookie_name": "a", "health_type": "https", "health_monitor_url": "/"}`, ""},
+		{"ibm_is_lb_pool", `{"session_persistence_type": "app_cookie"}`, "session_persistence_app_cookie_name is required"},
+		{"ibm_is_lb_pool", `{"session_persistence_type": "http_cookie", "session_persistence_app_cookie_name": "a"}`, "session_persistence_app_cookie_name cannot be set"},
+		{"ibm_is_lb_pool", `{"health_type": "tcp", "health_monitor_url": "/"}`, "health_monitor_url cannot be set when health_type is not one of \"http\", \"https\""},
+		{"ibm_is_lb", `{"type": "private", "profile": "network-fixed", "route_mode": true}`, ""},
+		{"ibm_is_lb", `{"type": "private", "route_mode": true}`, "route mode is only supported by network-fixed load balancers"},
+		{"ibm_is_lb", `{"type": "public", "profile": "network-fixed", "route_mode": true}`, "route mode is only supported by private load balancers"},
+		{"ibm_is_instance_group_manager", `{"manager_type": "autoscale", "max_membership_count": 4, "min_membership_count": 2}`, ""},
+		{"ibm_is_instance_group_manager", `{"manager_type": "scheduled", "cooldown": 300}`, "cooldown cannot be set when manager_type is \"scheduled\""},
+		{"ibm_is_instance_group_manager", `{"manager_type": "autoscale"}`, "max_membership_count is required"},
+		{"ibm_is_instance_group_manager", `{"max_membership_count": 2, "min_membership_count": 3}`, "min_membership_count must be at most max_membership_count"},
+		{"ibm_is_subnet", `{"ipv4_cidr_block": "10.240.0.0/24"}`, ""},
+		{"ibm_is_subnet", `{"name": "a"}`, "total_ipv4_address_count is required when ipv4_cidr_block is not set"},
+		{"ibm_is_instance", `{"boot_volume": [{"profile": "sdp", "size": 500, "bandwidth": 1000}], "volume_prototypes": [{"volume_profile": "custom", "volume_iops": 3000}]}`, ""},
+		{"ibm_is_instance", `{"boot_volume": [{"size": 300}]}`, "boot_volume.0.size must be at most 250"},
+		{"ibm_is_instance", `{"boot_volume": [{"profile": "general-purpose", "bandwidth": 1000}]}`, "boot_volume.0.bandwidth cannot be set"},
+		{"ibm_is_instance", `{"boot_volume": [{"profile": "10iops-tier", "iops": 1000}]}`, "boot_volume.0.iops cannot be set"},
+		{"ibm_is_instance", `{"volume_prototypes": [{"volume_profile": "5iops-tier", "volume_iops": 1000}]}`, "volume_prototypes.0.volume_iops cannot be set"},
+		{"ibm_is_instance", `{"volume_prototypes": [{"volume_profile": "general-purpose"}, {"volume_profile": "custom"}]}`, "volume_prototypes.1.volume_iops is required"},
+		{"ibm_is_instance_template", `{"boot_volume": [{"profile": "sdp", "size": 500}]}`, ""},
+		{"ibm_is_instance_template", `{"boot_volume": [{"size": 251}]}`, "boot_volume.0.size must be at most 250"},
+		{"ibm_is_instance_template", `{"boot_volume": [{"bandwidth": 1000}]}`, "boot_volume.0.bandwidth cannot be set"},
+		{"ibm_is_virtual_endpoint_gateway", `{"allow_dns_resolution_binding": true, "dns_resolution_binding_mode": "primary"}`, ""},
+		{"ibm_is_virtual_endpoint_gateway", `{"allow_dns_resolution_binding": true, "dns_resolution_binding_mode": "disabled"}`, "use only dns_resolution_binding_mode"},
+		{"ibm_is_virtual_endpoint_gateway", `{"allow_dns_resolution_binding": false, "dns_resolution_binding_mode": "per_resource_binding"}`, "use only dns_resolution_binding_mode"},
+		{"ibm_is_vpc_routing_table_route", `{"action": "drop", "next_hop": "0.0.0.0"}`, ""},
+		{"ibm_is_vpc_routing_table_route", `{"next_hop": "10.0.0.4"}`, ""},
+		{"ibm_is_vpc_routing_table_route", `{"action": "delegate", "next_hop": "10.0.0.4"}`, "next_hop cannot be set"},
+		{"ibm_is_vpn_server", `{"client_authentication": [{"method": "certificate", "client_ca_crn": "crn"}, {"method": "username", "identity_provider": "iam"}]}`, ""},
+		{"ibm_is_vpn_server", `{"client_authentication": [{"method": "certificate"}]}`, "client_authentication.0.client_ca_crn is required"},
+		{"ibm_is_vpn_server", `{"client_authentication": [{"method": "certificate", "client_ca_crn": "crn"}, {"method": "username"}]}`, "client_authentication.1.identity_provider is required"},
+		{"ibm_is_lb_listener_policy", `{"action": "forward", "target_id": "a"}`, ""},
+		{"ibm_is_lb_listener_policy", `{"action": "forward_to_pool", "target": [{"id": "a"}]}`, ""},
+		{"ibm_is_lb_listener_policy", `{"action": "reject"}`, ""},
+		{"ibm_is_lb_listener_policy", `{"action": "forward"}`, "target_id is required when action is one of"},
+		{"ibm_is_lb_listener_policy", `{"action": "redirect", "target_url": "https://a"}`, "target_http_status_code is required"},
+		{"ibm_is_lb_listener_policy", `{"action": "https_redirect"}`, "target_https_redirect_listener, target_https_redirect_status_code are required"},
+		{"ibm_is_ipsec_policy", `{"encryption_algorithm": "aes256gcm16", "authentication_algorithm": "disabled"}`, ""},
+		{"ibm_is_ipsec_policy", `{"encryption_algorithm": "aes256", "authentication_algorithm": "sha256"}`, ""},
+		{"ibm_is_ipsec_policy", `{"encryption_algorithm": "aes128gcm16", "authentication_algorithm": "sha256"}`, "authentication is disabled with GCM encryption algorithms"},
+		{"ibm_is_ipsec_policy", `{"encryption_algorithm": "aes256", "authentication_algorithm": "disabled"}`, "authentication is only disabled with GCM encryption algorithms"},
+		{"ibm_is_vpn_gateway_connection", `{"routing_protocol": "bgp", "peer": [{"asn": 65000}]}`, ""},
+		{"ibm_is_vpn_gateway_connection", `{"routing_protocol": "bgp", "peer": [{"address": "1.2.3.4"}]}`, "peer.0.asn is required when routing_protocol is \"bgp\""},
+		{"ibm_is_vpn_gateway_connection", `{"peer": [{"address": "1.2.3.4"}]}`, ""},
+		{"ibm_is_vpc", `{"dns": [{"resolver": [{"type": "delegated", "vpc_crn": "crn"}]}]}`, ""},
+		{"ibm_is_vpc", `{"dns": [{"resolver": [{"type": "delegated"}]}]}`, "dns.0.resolver.0.vpc_id is required"},
+		{"ibm_is_vpc", `{"dns": [{"resolver": [{"type": "manual"}]}]}`, "dns.0.resolver.0.manual_servers is required"},
+		{"ibm_is_vpc", `{"dns": [{"resolver": [{"type": "system"}]}]}`, ""},
+
+		// Power
+		{"ibm_pi_instance", `{"pi_proc_type": "shared", "pi_processors": 0.5, "pi_sys_type": "s922"}`, ""},
+		{"ibm_pi_instance", `{"pi_proc_type": "dedicated", "pi_processors": 1.5}`, "pi_processors must be a multiple of 1"},
+		{"ibm_pi_instance", `{"pi_proc_type": "capped", "pi_processors": 0.1}`, "pi_processors must be at least 0.25"},
+		{"ibm_pi_instance", `{"pi_proc_type": "shared", "pi_processors": 0.3}`, "pi_processors must be a multiple of 0.25"},
+		{"ibm_pi_instance", `{"pi_sys_type": "s922", "pi_processors": 16}`, "pi_processors must be at most 15"},
+		{"ibm_pi_instance", `{"pi_sys_type": "e980", "pi_processors": 144}`, "pi_processors must be at most 143"},
+		{"ibm_pi_instance", `{"pi_replication_sites": ["a"]}`, "pi_replication_sites cannot be set"},
+		{"ibm_pi_instance", `{"pi_boot_volume_replication_enabled": true, "pi_replication_sites": ["a"]}`, ""},
+		{"ibm_pi_volume", `{"pi_replication_enabled": true, "pi_replication_sites": ["a"]}`, ""},
+		{"ibm_pi_volume", `{"pi_replication_sites": ["a"]}`, "pi_replication_sites cannot be set"},
+		{"ibm_pi_network", `{"pi_network_type": "vlan", "pi_cidr": "192.168.0.0/24"}`, ""},
+		{"ibm_pi_network", `{"pi_network_type": "vlan"}`, "pi_cidr is required"},
+		{"ibm_pi_network", `{"pi_network_type": "pub-vlan", "pi_cidr": "192.168.0.0/24"}`, "pi_cidr cannot be set"},
+		{"ibm_pi_capture", `{"pi_capture_destination": "image-catalog"}`, ""},
+		{"ibm_pi_capture", `{"pi_capture_destination": "cloud-storage", "pi_capture_cloud_storage_region": "us-east"}`, "pi_capture_cloud_storage_access_key, pi_capture_cloud_storage_secret_key, pi_capture_storage_image_path are required"},
+		{"ibm_pi_virtual_serial_number", `{"pi_serial": "auto-assign", "pi_instance_id": "a"}`, ""},
+		{"ibm_pi_virtual_serial_number", `{"pi_serial": "auto-assign"}`, "pi_instance_id is required when pi_serial is \"auto-assign\""},
+		{"ibm_pi_virtual_serial_number", `{"pi_serial": "a", "pi_software_tier": "bronze"}`, "pi_instance_id is required when pi_software_tier is set"},
+		{"ibm_pi_network_security_group_rule", `{"pi_protocol": [{"type": "tcp"}], "pi_destination_port": [{}]}`, ""},
+		{"ibm_pi_network_security_group_rule", `{"pi_protocol": [{"type": "icmp"}], "pi_source_port": [{}]}`, "pi_source_port cannot be set"},
+		{"ibm_pi_image", `{"pi_image_bucket_name": "a", "pi_image_bucket_access": "private", "pi_image_access_key": "k", "pi_image_secret_key": "s"}`, ""},
+		{"ibm_pi_image", `{"pi_image_bucket_name": "a", "pi_image_bucket_access": "private"}`, "pi_image_access_key is required"},
+		{"ibm_pi_image", `{"pi_affinity_policy": "affinity", "pi_affinity_instance": "a"}`, ""},
+		{"ibm_pi_image", `{"pi_affinity_policy": "affinity", "pi_image_storage_pool": "a"}`, ""},
+		{"ibm_pi_image", `{"pi_affinity_policy": "affinity"}`, "the affinity policy requires one of pi_affinity_instance or pi_affinity_volume"},
+		{"ibm_pi_image", `{"pi_affinity_policy": "anti-affinity", "pi_affinity_volume": "a"}`, "the anti-affinity policy requires one of"},
+
+		// Kubernetes
+		{"ibm_container_vpc_cluster", `{"kube_version": "4.16_openshift", "worker_count": 1, "zones": [{"name": "a"}, {"name": "b"}], "cos_instance_crn": "crn"}`, ""},
+		{"ibm_container_vpc_cluster", `{"kube_version": "4.16_openshift", "worker_count": 1, "zones": [{"name": "a"}], "cos_instance_crn": "crn"}`, "worker_count multiplied by zones.# must be at least 2"},
+		{"ibm_container_vpc_cluster", `{"kube_version": "4.16_OpenShift", "worker_count": 2, "zones": [{"name": "a"}]}`, "cos_instance_crn is required"},
+		{"ibm_container_vpc_cluster", `{"kube_version": "1.30", "entitlement": "cloud_pak"}`, "entitlement cannot be set"},
+		{"ibm_container_cluster", `{"gateway_enabled": true, "private_service_endpoint": true, "public_vlan_id": "1", "private_vlan_id": "2"}`, ""},
+		{"ibm_container_cluster", `{"gateway_enabled": true}`, "gateway_enabled cannot be set"},
+		{"ibm_container_cluster", `{"public_vlan_id": "1"}`, "private_vlan_id is required when public_vlan_id is set"},
+		{"ibm_container_cluster", `{"kube_version": "4.16_openshift", "default_pool_size": 1}`, "default_pool_size must be at least 2"},
+		{"ibm_container_worker_pool_zone_attachment", `{"private_vlan_id": "2"}`, ""},
+		{"ibm_container_worker_pool_zone_attachment", `{"public_vlan_id": "1"}`, "private_vlan_id is required"},
+		{"ibm_container_cluster_feature", `{"public_service_endpoint": false}`, ""},
+		{"ibm_container_cluster_feature", `{"cluster": "a"}`, "private_service_endpoint is required"},
+		{"ibm_container_cluster_feature", `{"private_service_endpoint": false}`, "private_service_endpoint cannot be set"},
+		{"ibm_container_bind_service", `{"service_instance_id": "a"}`, ""},
+		{"ibm_container_bind_service", `{"namespace_id": "default"}`, "service_instance_id is required when service_instance_name is not set"},
+
+		// Networking
+		{"ibm_tg_connection", `{"network_type": "vpc", "network_id": "crn"}`, ""},
+		{"ibm_tg_connection", `{"network_type": "directlink"}`, "network_id is required"},
+		{"ibm_tg_connection", `{"network_type": "classic", "network_id": "crn"}`, "network_id cannot be set"},
+		{"ibm_tg_connection", `{"network_type": "gre_tunnel", "base_connection_id": "a", "local_gateway_ip": "192.168.1.1"}`, "local_tunnel_ip is required"},
+		{"ibm_tg_connection", `{"network_type": "unbound_gre_tunnel", "local_gateway_ip": "192.168.1.1", "local_tunnel_ip": "192.168.2.1"}`, "base_network_type is required"},
+		{"ibm_tg_connection", `{"network_type": "classic", "remote_bgp_asn": 65000}`, "remote_bgp_asn cannot be set"},
+		{"ibm_tg_connection", `{"network_type": "vpn_gateway", "network_id": "crn"}`, "cidr is required"},
+		{"ibm_tg_connection", `{"network_type": "redundant_gre", "default_prefix_filter": "permit"}`, "default_prefix_filter cannot be set"},
+		{"ibm_dl_gateway", `{"type": "connect", "port": "a"}`, ""},
+		{"ibm_dl_gateway", `{"type": "connect"}`, "port is required when type is \"connect\""},
+		{"ibm_dl_gateway", `{"type": "dedicated", "carrier_name": "a", "location_name": "b"}`, "cross_connect_router, customer_name are required"},
+		{"ibm_cis_rate_limit", `{"action": [{"mode": "ban", "timeout": 60}]}`, ""},
+		{"ibm_cis_rate_limit", `{"action": [{"mode": "simulate"}]}`, "action.0.timeout is required"},
+		{"ibm_cis_rate_limit", `{"action": [{"mode": "js_challenge", "timeout": 60}]}`, "action.0.timeout cannot be set"},
+		{"ibm_dns_custom_resolver", `{"locations": [{"subnet_crn": "a"}, {"subnet_crn": "b"}]}`, ""},
+		{"ibm_dns_custom_resolver", `{"high_availability": false, "locations": [{"subnet_crn": "a"}]}`, ""},
+		{"ibm_dns_custom_resolver", `{"locations": [{"subnet_crn": "a"}]}`, "locations.# must be at least 2"},
+		{"ibm_dns_custom_resolver", `{"high_availability": false}`, "locations is required"},
+		{"ibm_dns_custom_resolver", `{"high_availability": false, "enabled": false}`, ""},
+		{"ibm_dns_custom_resolver_forwarding_rule", `{"forward_to": ["10.0.0.1"]}`, ""},
+		{"ibm_dns_custom_resolver_forwarding_rule", `{"views": [{"name": "a", "expression": "b", "forward_to": ["10.0.0.1"]}]}`, ""},
+		{"ibm_dns_custom_resolver_forwarding_rule", `{"type": "zone", "match": "example.com"}`, "one of forward_to or views must be set"},
+		{"ibm_dns_resource_record", `{"type": "SRV", "port": 443, "service": "_sip", "protocol": "tcp"}`, ""},
+		{"ibm_dns_resource_record", `{"type": "SRV", "port": 443}`, "service, protocol are required when type is \"SRV\""},
+		{"ibm_dns_resource_record", `{"type": "A"}`, ""},
+		{"ibm_cis_dns_record", `{"type": "A", "content": "1.2.3.4", "proxied": true}`, ""},
+		{"ibm_cis_dns_record", `{"type": "A", "content": "1.2.3.4", "proxied": true, "ttl": 120}`, "ttl must be at most 1"},
+		{"ibm_cis_dns_record", `{"type": "CNAME"}`, "content is required when type is one of"},
+		{"ibm_cis_dns_record", `{"type": "SRV", "data": {"port": "443"}}`, ""},
+		{"ibm_cis_dns_record", `{"type": "LOC"}`, "data is required"},
+
+		// Classic infrastructure
+		{"ibm_compute_vm_instance", `{"datacenter": "dal10", "hostname": "a", "domain": "b"}`, ""},
+		{"ibm_compute_vm_instance", `{"hostname": "a", "domain": "b"}`, "datacenter_choice is required when datacenter is not set"},
+		{"ibm_compute_vm_instance", `{"datacenter": "dal10", "bulk_vms": [{"hostname": "a", "domain": "b"}]}`, ""},
+		{"ibm_compute_vm_instance", `{"datacenter": "dal10"}`, "hostname, domain are required when bulk_vms is not set"},
+		{"ibm_compute_bare_metal", `{"private_network_only": true, "secondary_ip_count": 0, "ipv6_enabled": false}`, ""},
+		{"ibm_compute_bare_metal", `{"private_network_only": true, "secondary_ip_count": 4}`, "secondary_ip_count cannot be set"},
+		{"ibm_compute_bare_metal", `{"private_network_only": true, "ipv6_enabled": true}`, "ipv6_enabled cannot be set"},
+		{"ibm_compute_bare_metal", `{"private_network_only": true, "ipv6_static_enabled": true}`, "ipv6_static_enabled cannot be set"},
+		{"ibm_subnet", `{"type": "Static", "endpoint_ip": "10.0.0.1"}`, ""},
+		{"ibm_subnet", `{"type": "Portable", "endpoint_ip": "10.0.0.1"}`, "endpoint_ip cannot be set when type is not \"Static\""},
+		{"ibm_lb", `{"dedicated": true, "ha_enabled": true}`, ""},
+		{"ibm_lb", `{"ha_enabled": true}`, "high availability is not supported by shared load balancers"},
+		{"ibm_lb_vpx_vip", `{"type": "SSL", "security_certificate_id": 1}`, ""},
+		{"ibm_lb_vpx_vip", `{"type": "HTTP", "security_certificate_id": 1}`, "security_certificate_id cannot be set when type is not \"SSL\""},
+		{"ibm_lbaas", `{"protocols": [{"frontend_protocol": "HTTPS", "frontend_port": 443, "backend_protocol": "HTTP", "backend_port": 80, "tls_certificate_id": 1}]}`, ""},
+		{"ibm_lbaas", `{"protocols": [{"frontend_protocol": "HTTP", "frontend_port": 80, "backend_protocol": "HTTP", "backend_port": 80, "tls_certificate_id": 1}]}`, "tls_certificate_id cannot be set"},
+
+		// Databases and storage
+		{"ibm_database", `{"service": "databases-for-postgresql", "plan": "standard", "logical_replication_slot": [{"name": "a", "database_name": "b", "plugin_type": "wal2json"}], "remote_leader_id": "crn"}`, ""},
+		{"ibm_database", `{"service": "databases-for-redis", "plan": "standard", "logical_replication_slot": [{"name": "a", "database_name": "b", "plugin_type": "wal2json"}]}`, "logical_replication_slot cannot be set"},
+		{"ibm_database", `{"service": "databases-for-mongodb", "plan": "enterprise", "offline_restore": true}`, ""},
+		{"ibm_database", `{"service": "databases-for-mongodb", "plan": "standard", "offline_restore": true}`, ""},
+		{"ibm_database", `{"service": "databases-for-postgresql", "plan": "enterprise", "offline_restore": true}`, ""},
+		{"ibm_database", `{"service": "databases-for-redis", "plan": "standard", "offline_restore": true}`, "offline restore is only supported by databases-for-mongodb enterprise"},
+		{"ibm_database", `{"service": "databases-for-redis", "plan": "standard", "remote_leader_id": "crn"}`, "remote_leader_id cannot be set"},
+		{"ibm_database", `{"service": "databases-for-redis", "plan": "standard", "offline_restore": false, "remote_leader_id": ""}`, ""},
+		{"ibm_database", `{"service": "databases-for-mysql", "plan": "standard", "offline_restore": false, "logical_replication_slot": []}`, ""},
+		{"ibm_cloudant", `{"plan": "standard", "capacity": 4}`, ""},
+		{"ibm_cloudant", `{"plan": "lite", "capacity": 2}`, "capacity must be at most 1"},
+		{"ibm_cos_bucket", `{"region_location": "us-south"}`, ""},
+		{"ibm_cos_bucket", `{"satellite_location_id": "a"}`, ""},
+		{"ibm_cos_bucket", `{"bucket_name": "a"}`, "one of cross_region_location, region_location, single_site_location or satellite_location_id must be set"},
+		{"ibm_kms_key", `{"payload": "a", "encrypted_nonce": "b", "iv_value": "c"}`, ""},
+		{"ibm_kms_key", `{"encrypted_nonce": "b"}`, "iv_value, payload are required"},
+		{"ibm_kms_key", `{"iv_value": "c"}`, "encrypted_nonce is required"},
+		{"ibm_kms_key", `{"standard_key": true, "payload": "a", "encrypted_nonce": "b", "iv_value": "c"}`, "import tokens only apply to root keys"},
+
+		// Identity and access
+		{"ibm_iam_authorization_policy", `{"subject_attributes": [{"name": "accountId", "value": "a"}, {"name": "serviceInstance", "operator": "stringExists", "value": "true"}]}`, ""},
+		{"ibm_iam_authorization_policy", `{"resource_attributes": [{"name": "serviceInstance", "operator": "stringExists", "value": "yes"}]}`, "the value of a stringExists operator is"},
+		{"ibm_iam_trusted_profile_claim_rule", `{"type": "Profile-CR", "cr_type": "IKS_SA"}`, ""},
+		{"ibm_iam_trusted_profile_claim_rule", `{"type": "Profile-CR"}`, "cr_type is required"},
+		{"ibm_iam_trusted_profile_claim_rule", `{"type": "Profile-SAML"}`, "realm_name is required"},
+		{"ibm_resource_key", `{"resource_alias_id": "a"}`, ""},
+		{"ibm_resource_key", `{"name": "a"}`, "one of resource_instance_id or resource_alias_id must be set"},
+	} {
+		resource, ok := resources[tc.resource]
+		if !ok {
+			t.Errorf("%s does not exist", tc.resource)
+			continue
+		}
+		config, err := ctyjson.Unmarshal([]byte(tc.config), resource.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			t.Errorf("%s %s: %s", tc.resource, tc.config, err)
+			continue
+		}
+		err = validate.CheckCrossFieldRules(context.Background(), validators[tc.resource].Rules, config, nil, nil)
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s %s: unexpected error %q", tc.resource, tc.config, err)
+		case tc.err != "" && err == nil:
+			t.Errorf("%s %s: expected an error with %q", tc.resource, tc.config, tc.err)
+		case tc.err != "" && !strings.Contains(err.Error(), tc.err):
+			t.Errorf("%s %s: expected an error with %q, got %q", tc.resource, tc.config, tc.err, err)
+		}
+	}
+}
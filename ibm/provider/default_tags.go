@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// defaultTagsAttribute is an attribute of a resource that the provider
+// default_tags are merged into. The merged tags, including those attached
+// outside of Terraform, are reported in the attribute named all.
+type defaultTagsAttribute struct {
+	name    string
+	all     string
+	tagType string
+}
+
+var defaultTagsAttributes = []defaultTagsAttribute{
+	{name: "tags", all: "tags_all", tagType: "user"},
+	{name: "access_tags", all: "access_tags_all", tagType: "access"},
+}
+
+// defaultTagsExempt lists the resources with a set of tags and a CRN whose
+// tags are not global tags, so that the default_tags do not apply to them.
+var defaultTagsExempt = map[string]bool{
+	"ibm_cr_namespace":     true,
+	"ibm_iam_access_group": true,
+	"ibm_iam_service_id":   true,
+	"ibm_resource_group":   true,
+	"ibm_resource_key":     true,
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags attached to every resource that supports global tagging, in addition to its own tags",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         flex.ResourceIBMVPCHash,
+					Description: "User tags attached to every resource",
+				},
+				"access_tags": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         flex.ResourceIBMVPCHash,
+					Description: "Access tags attached to every resource",
+				},
+			},
+		},
+	}
+}
+
+// expandDefaultTags reads the default_tags block of the provider.
+func expandDefaultTags(d *schema.ResourceData) conns.DefaultTags {
+	var defaultTags conns.DefaultTags
+	if v, ok := d.GetOk("default_tags.0.tags"); ok {
+		defaultTags.Tags = flex.FlattenSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("default_tags.0.access_tags"); ok {
+		defaultTags.AccessTags = flex.FlattenSet(v.(*schema.Set))
+	}
+	return defaultTags
+}
+
+// defaultTagsOf returns the tags of tagType, "user" or "access", that the
+// provider default_tags attach to every resource.
+func defaultTagsOf(meta interface{}, tagType string) []string {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	switch tagType {
+	case "user":
+		return session.DefaultTags().Tags
+	case "access":
+		return session.DefaultTags().AccessTags
+	}
+	return nil
+}
+
+// defaultTagsCRN returns the attribute that holds the CRN the tags of a
+// resource are attached to.
+func defaultTagsCRN(resource *schema.Resource) string {
+	for _, attr := range []string{"crn", flex.ResourceCRN} {
+		if _, ok := resource.Schema[attr]; ok {
+			return attr
+		}
+	}
+	return ""
+}
+
+// supportsDefaultTags reports whether the default_tags apply to resource: it
+// must have a set of global tags and a CRN to attach them to.
+func supportsDefaultTags(name string, resource *schema.Resource) bool {
+	if defaultTagsExempt[name] || defaultTagsCRN(resource) == "" {
+		return false
+	}
+	if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+		return false
+	}
+	tags, ok := resource.Schema["tags"]
+	if !ok || tags.Type != schema.TypeSet || tags.Computed && !tags.Optional {
+		return false
+	}
+	elem, ok := tags.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// withDefaultTags adds the attributes that report the merged tags to a
+// resource that supports default_tags, and merges the default_tags into its
+// tags when it is created, read and updated. The resource must have been
+// wrapped by wrapResource, so that its operations take a context.
+func withDefaultTags(resource *schema.Resource) *schema.Resource {
+	attrs := []defaultTagsAttribute{}
+	resourceSchema := make(map[string]*schema.Schema, len(resource.Schema)+len(defaultTagsAttributes))
+	for k, v := range resource.Schema {
+		resourceSchema[k] = v
+	}
+	for _, attr := range defaultTagsAttributes {
+		if _, ok := resource.Schema[attr.name]; !ok {
+			continue
+		}
+		attrs = append(attrs, attr)
+		resourceSchema[attr.all] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         flex.ResourceIBMVPCHash,
+			Description: "The " + attr.name + " of the resource, including the default_tags of the provider",
+		}
+	}
+	crn := defaultTagsCRN(resource)
+
+	wrapped := *resource
+	wrapped.Schema = resourceSchema
+	wrapped.CreateContext = wrapDefaultTags(resource.CreateContext, attrs, crn, true)
+	wrapped.CreateWithoutTimeout = wrapDefaultTags(resource.CreateWithoutTimeout, attrs, crn, true)
+	wrapped.ReadContext = wrapDefaultTags(resource.ReadContext, attrs, crn, false)
+	wrapped.ReadWithoutTimeout = wrapDefaultTags(resource.ReadWithoutTimeout, attrs, crn, false)
+	wrapped.UpdateContext = wrapDefaultTags(resource.UpdateContext, attrs, crn, true)
+	wrapped.UpdateWithoutTimeout = wrapDefaultTags(resource.UpdateWithoutTimeout, attrs, crn, true)
+	wrapped.CustomizeDiff = customizeDefaultTagsDiff(resource.CustomizeDiff, attrs)
+	return &wrapped
+}
+
+func wrapDefaultTags(
+	function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	attrs []defaultTagsAttribute,
+	crn string,
+	apply bool,
+) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if function == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// On create and update these are the configured tags, and on read
+		// the tags in state.
+		configured := map[string]*schema.Set{}
+		for _, attr := range attrs {
+			configured[attr.name] = tagSet(d.Get(attr.name))
+		}
+
+		diags := function(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		for _, attr := range attrs {
+			defaults := flex.NewStringSet(flex.ResourceIBMVPCHash, defaultTagsOf(meta, attr.tagType))
+			all := tagSet(d.Get(attr.name))
+			if apply {
+				if err := attachDefaultTags(d, meta, attr, crn, defaults); err != nil {
+					return append(diags, diag.FromErr(err)...)
+				}
+				// Global Search may not report the tags that were just
+				// attached yet.
+				all = all.Union(defaults)
+			}
+			d.Set(attr.all, all)
+			d.Set(attr.name, all.Difference(defaults.Difference(configured[attr.name])))
+		}
+		return diags
+	}
+}
+
+// attachDefaultTags attaches the default_tags that the resource is missing,
+// and detaches those that were removed from the provider configuration since
+// the last apply. It makes no call when the tags are up to date.
+func attachDefaultTags(d *schema.ResourceData, meta interface{}, attr defaultTagsAttribute, crn string, defaults *schema.Set) error {
+	resourceCRN, _ := d.Get(crn).(string)
+	if resourceCRN == "" {
+		return nil
+	}
+	oldAll, _ := d.GetChange(attr.all)
+	oldTags, newTags := d.GetChange(attr.name)
+	attach, detach := defaultTagsChanges(tagSet(oldAll), tagSet(oldTags), tagSet(newTags), defaults)
+	if attach.Len() == 0 && detach.Len() == 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] Attaching the default %s %v and detaching %v of %s", attr.name, attach.List(), detach.List(), resourceCRN)
+	return flex.UpdateGlobalTagsUsingCRN(detach, attach, meta, resourceCRN, "", attr.tagType)
+}
+
+// defaultTagsChanges returns the default_tags to attach to a resource, and
+// the former default_tags to detach from it, after the resource has updated
+// its own tags from oldTags to newTags. oldAll are the tags that were
+// attached to it before, as reported in state.
+func defaultTagsChanges(oldAll, oldTags, newTags, defaults *schema.Set) (attach, detach *schema.Set) {
+	attached := oldAll.Difference(oldTags.Difference(newTags)).Union(newTags)
+	attach = defaults.Difference(attached)
+	// Tags reported as merged in state that are neither default_tags nor
+	// tags of the resource were default_tags before.
+	detach = oldAll.Difference(defaults).Difference(oldTags.Union(newTags))
+	return attach, detach
+}
+
+// customizeDefaultTagsDiff plans the merged tags of a resource, so that
+// changing the default_tags of the provider updates it.
+func customizeDefaultTagsDiff(function schema.CustomizeDiffFunc, attrs []defaultTagsAttribute) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if function != nil {
+			if err := function(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		for _, attr := range attrs {
+			if !diff.NewValueKnown(attr.name) {
+				if err := diff.SetNewComputed(attr.all); err != nil {
+					return err
+				}
+				continue
+			}
+			defaults := flex.NewStringSet(flex.ResourceIBMVPCHash, defaultTagsOf(meta, attr.tagType))
+			planned := tagSet(diff.Get(attr.name)).Union(defaults)
+			if diff.Id() != "" && planned.Equal(tagSet(diff.Get(attr.all))) {
+				continue
+			}
+			if err := diff.SetNew(attr.all, planned); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func tagSet(v interface{}) *schema.Set {
+	if set, ok := v.(*schema.Set); ok && set != nil {
+		return schema.NewSet(flex.ResourceIBMVPCHash, set.List())
+	}
+	return schema.NewSet(flex.ResourceIBMVPCHash, nil)
+}
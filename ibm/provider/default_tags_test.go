@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+type defaultTagsSession struct {
+	conns.ClientSession
+	defaultTags conns.DefaultTags
+}
+
+func (s defaultTagsSession) DefaultTags() conns.DefaultTags {
+	return s.defaultTags
+}
+
+func sortedTags(v interface{}) []string {
+	tags := flex.FlattenSet(v.(*schema.Set))
+	sort.Strings(tags)
+	return tags
+}
+
+func TestSupportsDefaultTags(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name, supported := range map[string]bool{
+		"ibm_is_vpc":              true,
+		"ibm_resource_instance":   true,
+		"ibm_is_instance":         true,
+		"ibm_iam_access_group":    false,
+		"ibm_resource_tag":        false,
+		"ibm_compute_vm_instance": false,
+	} {
+		_, ok := resources[name].Schema["tags_all"]
+		if ok != supported {
+			t.Errorf("Expected %s to support default_tags: %t", name, supported)
+		}
+	}
+	if _, ok := resources["ibm_is_vpc"].Schema["access_tags_all"]; !ok {
+		t.Error("Expected ibm_is_vpc to report its merged access tags")
+	}
+}
+
+// taggedResource reads tags from the API and records the tags it
+// was planned with.
+func taggedResource(tags []string, planned *[]string) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"crn": {Type: schema.TypeString, Computed: true},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      flex.ResourceIBMVPCHash,
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.Set("tags", tags)
+			return nil
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			*planned = sortedTags(diff.Get("tags"))
+			return nil
+		},
+	}
+	return withDefaultTags(r)
+}
+
+func TestDefaultTagsRead(t *testing.T) {
+	meta := defaultTagsSession{defaultTags: conns.DefaultTags{Tags: []string{"env:test", "team:a"}}}
+	var planned []string
+	r := taggedResource([]string{"app:web", "env:test", "team:a", "added:outside"}, &planned)
+
+	for _, tc := range []struct {
+		name     string
+		state    []interface{}
+		wantTags []string
+	}{
+		{
+			name:     "default tags are not reported as tags",
+			state:    []interface{}{"app:web"},
+			wantTags: []string{"added:outside", "app:web"},
+		},
+		{
+			name:     "default tags set on the resource are kept",
+			state:    []interface{}{"app:web", "team:a"},
+			wantTags: []string{"added:outside", "app:web", "team:a"},
+		},
+		{
+			name:     "import",
+			wantTags: []string{"added:outside", "app:web"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := r.TestResourceData()
+			d.SetId("r006-1")
+			d.Set("tags", tc.state)
+			if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			if got := sortedTags(d.Get("tags")); !equalTags(got, tc.wantTags) {
+				t.Errorf("Expected tags %v, got %v", tc.wantTags, got)
+			}
+			want := []string{"added:outside", "app:web", "env:test", "team:a"}
+			if got := sortedTags(d.Get("tags_all")); !equalTags(got, want) {
+				t.Errorf("Expected tags_all %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestDefaultTagsDiff(t *testing.T) {
+	var planned []string
+	r := taggedResource(nil, &planned)
+	d := r.TestResourceData()
+	d.SetId("r006-1")
+	d.Set("tags", []string{"app:web"})
+	d.Set("tags_all", []string{"app:web", "env:test"})
+	state := d.State()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": []interface{}{"app:web"}})
+
+	meta := defaultTagsSession{defaultTags: conns.DefaultTags{Tags: []string{"env:test"}}}
+	diff, err := r.SimpleDiff(context.Background(), state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("Expected no changes when the default tags are attached, got %v", diff.Attributes)
+	}
+
+	meta.defaultTags.Tags = []string{"env:prod"}
+	diff, err = r.SimpleDiff(context.Background(), state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes[fmt.Sprintf("tags_all.%d", flex.ResourceIBMVPCHash("env:prod"))] == nil {
+		t.Fatal("Expected changing the default tags to update tags_all")
+	}
+	if !equalTags(planned, []string{"app:web"}) {
+		t.Errorf("Expected the resource to be planned with its own tags, got %v", planned)
+	}
+}
+
+func TestDefaultTagsChanges(t *testing.T) {
+	set := func(tags ...string) *schema.Set {
+		return flex.NewStringSet(flex.ResourceIBMVPCHash, tags)
+	}
+	for _, tc := range []struct {
+		name                     string
+		oldAll, oldTags, newTags *schema.Set
+		defaults                 *schema.Set
+		attach, detach           []string
+	}{
+		{
+			name:     "create",
+			oldAll:   set(),
+			oldTags:  set(),
+			newTags:  set("app:web"),
+			defaults: set("env:test"),
+			attach:   []string{"env:test"},
+		},
+		{
+			name:     "up to date",
+			oldAll:   set("app:web", "env:test"),
+			oldTags:  set("app:web"),
+			newTags:  set("app:web", "app:new"),
+			defaults: set("env:test"),
+		},
+		{
+			name:     "default tag removed from the resource tags",
+			oldAll:   set("app:web", "env:test"),
+			oldTags:  set("app:web", "env:test"),
+			newTags:  set("app:web"),
+			defaults: set("env:test"),
+			attach:   []string{"env:test"},
+		},
+		{
+			name:     "default tags changed",
+			oldAll:   set("app:web", "env:test"),
+			oldTags:  set("app:web"),
+			newTags:  set("app:web"),
+			defaults: set("env:prod"),
+			attach:   []string{"env:prod"},
+			detach:   []string{"env:test"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			attach, detach := defaultTagsChanges(tc.oldAll, tc.oldTags, tc.newTags, tc.defaults)
+			if got := sortedTags(attach); !equalTags(got, tc.attach) {
+				t.Errorf("Expected to attach %v, got %v", tc.attach, got)
+			}
+			if got := sortedTags(detach); !equalTags(got, tc.detach) {
+				t.Errorf("Expected to detach %v, got %v", tc.detach, got)
+			}
+		})
+	}
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTimeoutsKeys are the operations whose timeouts the provider
+// default_timeouts set.
+var defaultTimeoutsKeys = []string{schema.TimeoutCreate, schema.TimeoutUpdate, schema.TimeoutDelete}
+
+func defaultTimeoutsSchema() *schema.Schema {
+	timeouts := func(description string) map[string]*schema.Schema {
+		s := map[string]*schema.Schema{}
+		for _, key := range defaultTimeoutsKeys {
+			s[key] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTimeout,
+				Description:  fmt.Sprintf(description, key),
+			}
+		}
+		return s
+	}
+	overrides := timeouts("Timeout of the %s operation of the resource type. Example: 3h.")
+	overrides["resource_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Type of the resources the timeouts apply to. Example: ibm_database.",
+	}
+	defaults := timeouts("Timeout of the %s operation of every resource that has one. Example: 60m.")
+	defaults["override"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Timeouts of a resource type, instead of the default ones",
+		Elem:        &schema.Resource{Schema: overrides},
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Timeouts of the operations of the resources, instead of their own defaults. The timeouts blocks of the resources take precedence.",
+		Elem:        &schema.Resource{Schema: defaults},
+	}
+}
+
+func validateTimeout(v interface{}, k string) (ws []string, errors []error) {
+	timeout, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, such as 30m or 2h: %s", k, err))
+	} else if timeout <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be positive, got %s", k, v))
+	}
+	return
+}
+
+// expandTimeouts reads the timeouts of a default_timeouts or override
+// block, by operation.
+func expandTimeouts(block map[string]interface{}) map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	for _, key := range defaultTimeoutsKeys {
+		if v, _ := block[key].(string); v != "" {
+			// The value is validated by validateTimeout
+			timeouts[key], _ = time.ParseDuration(v)
+		}
+	}
+	return timeouts
+}
+
+// withDefaultTimeouts applies the default_timeouts of the provider to the
+// resources when it is configured. The operations of a resource that has
+// no timeout of its own are left unchanged, so that its timeouts block
+// keeps its arguments. The timeouts are planned with the resource, so they
+// apply to the delete operation of an existing resource once it has been
+// updated.
+func withDefaultTimeouts(configure schema.ConfigureContextFunc, resources map[string]*schema.Resource) schema.ConfigureContextFunc {
+	own := make(map[string]*schema.ResourceTimeout, len(resources))
+	for name, resource := range resources {
+		if resource.Timeouts != nil {
+			own[name] = resource.Timeouts
+		}
+	}
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		meta, diags := configure(ctx, d)
+		if diags.HasError() {
+			return meta, diags
+		}
+		return meta, append(diags, applyDefaultTimeouts(d, resources, own)...)
+	}
+}
+
+// applyDefaultTimeouts sets the timeouts of the resources to the
+// default_timeouts of the provider configuration d, or to their own ones.
+func applyDefaultTimeouts(d *schema.ResourceData, resources map[string]*schema.Resource, own map[string]*schema.ResourceTimeout) diag.Diagnostics {
+	var diags diag.Diagnostics
+	defaults := map[string]time.Duration{}
+	overrides := map[string]map[string]time.Duration{}
+	if block, ok := d.Get("default_timeouts.0").(map[string]interface{}); ok {
+		defaults = expandTimeouts(block)
+		list, _ := block["override"].([]interface{})
+		for _, o := range list {
+			override, _ := o.(map[string]interface{})
+			if override == nil {
+				continue
+			}
+			name := override["resource_type"].(string)
+			switch {
+			case resources[name] == nil:
+				return append(diags, diag.Errorf("[ERROR] default_timeouts override: resource type %s does not exist", name)...)
+			case own[name] == nil:
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("default_timeouts override: %s has no timeouts, the override is ignored", name),
+				})
+			}
+			overrides[name] = expandTimeouts(override)
+		}
+	}
+
+	for name, timeouts := range own {
+		t := *timeouts
+		for _, key := range defaultTimeoutsKeys {
+			field := timeoutField(&t, key)
+			if *field == nil {
+				continue
+			}
+			timeout, ok := overrides[name][key]
+			if !ok {
+				timeout, ok = defaults[key]
+			}
+			if ok {
+				*field = &timeout
+			}
+		}
+		resources[name].Timeouts = &t
+	}
+	return diags
+}
+
+func timeoutField(t *schema.ResourceTimeout, key string) **time.Duration {
+	switch key {
+	case schema.TimeoutCreate:
+		return &t.Create
+	case schema.TimeoutUpdate:
+		return &t.Update
+	}
+	return &t.Delete
+}
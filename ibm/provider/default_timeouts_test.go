@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDefaultTimeouts(t *testing.T) {
+	p := Provider()
+	configured := false
+	configure := withDefaultTimeouts(func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		configured = true
+		return nil, nil
+	}, p.ResourcesMap)
+
+	d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+		"default_timeouts": []interface{}{map[string]interface{}{
+			"create": "90m",
+			"update": "45m",
+			"override": []interface{}{map[string]interface{}{
+				"resource_type": "ibm_database",
+				"create":        "3h",
+			}},
+		}},
+	})
+	if _, diags := configure(context.Background(), d); diags.HasError() || !configured {
+		t.Fatalf("Unexpected configuration %t: %v", configured, diags)
+	}
+
+	for _, tc := range []struct {
+		resource, key string
+		want          time.Duration
+	}{
+		{"ibm_is_vpc", schema.TimeoutCreate, 90 * time.Minute},
+		{"ibm_is_vpc", schema.TimeoutDelete, 10 * time.Minute},
+		{"ibm_database", schema.TimeoutCreate, 3 * time.Hour},
+		{"ibm_database", schema.TimeoutUpdate, 45 * time.Minute},
+		{"ibm_database", schema.TimeoutDelete, 10 * time.Minute},
+		// ibm_is_vpc has no update timeout, the system default applies
+		{"ibm_is_vpc", schema.TimeoutUpdate, 20 * time.Minute},
+	} {
+		if got := p.ResourcesMap[tc.resource].Data(nil).Timeout(tc.key); got != tc.want {
+			t.Errorf("%s %s timeout is %s, want %s", tc.resource, tc.key, got, tc.want)
+		}
+	}
+	if p.ResourcesMap["ibm_is_vpc"].Timeouts.Update != nil {
+		t.Error("Expected ibm_is_vpc to have no update timeout, so that its timeouts block has no update argument")
+	}
+
+	// The timeouts block of a resource takes precedence
+	timeouts := &schema.ResourceTimeout{}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		schema.TimeoutsConfigKey: map[string]interface{}{"create": "5m"},
+	})
+	if err := timeouts.ConfigDecode(p.ResourcesMap["ibm_database"], config); err != nil {
+		t.Fatal(err)
+	}
+	if *timeouts.Create != 5*time.Minute || *timeouts.Update != 45*time.Minute {
+		t.Errorf("Unexpected timeouts create %s, update %s", *timeouts.Create, *timeouts.Update)
+	}
+
+	// Without default_timeouts, the resources get their own timeouts back
+	if _, diags := configure(context.Background(), schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{})); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := p.ResourcesMap["ibm_database"].Data(nil).Timeout(schema.TimeoutCreate); got != 60*time.Minute {
+		t.Errorf("ibm_database create timeout is %s, want its own 1h0m0s", got)
+	}
+}
+
+func TestDefaultTimeoutsOverrides(t *testing.T) {
+	p := Provider()
+	configure := withDefaultTimeouts(func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return nil, nil
+	}, p.ResourcesMap)
+
+	for resourceType, want := range map[string]string{
+		"ibm_not_a_resource": "resource type ibm_not_a_resource does not exist",
+		"ibm_iam_service_id": "ibm_iam_service_id has no timeouts",
+	} {
+		d := schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+			"default_timeouts": []interface{}{map[string]interface{}{
+				"override": []interface{}{map[string]interface{}{"resource_type": resourceType, "create": "1h"}},
+			}},
+		})
+		_, diags := configure(context.Background(), d)
+		if len(diags) != 1 || !strings.Contains(diags[0].Summary, want) {
+			t.Errorf("Expected a %q diagnostic, got %v", want, diags)
+		}
+	}
+
+	if _, errs := validateTimeout("forever", "create"); len(errs) == 0 {
+		t.Error("Expected an error for an invalid duration")
+	}
+	if _, errs := validateTimeout("-1m", "create"); len(errs) == 0 {
+		t.Error("Expected an error for a negative duration")
+	}
+}
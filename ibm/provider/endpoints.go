@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func endpointsSchema() *schema.Schema {
+	services := map[string]*schema.Schema{}
+	for service, key := range conns.EndpointServices {
+		services[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			Description:  fmt.Sprintf("Endpoint of the service, which overrides the endpoints file. The %s environment variable takes precedence.", key),
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Endpoints of the services, which override the endpoints file",
+		Elem:        &schema.Resource{Schema: services},
+	}
+}
+
+// expandEndpoints reads the endpoints block of the provider.
+func expandEndpoints(d *schema.ResourceData) map[string]string {
+	endpoints := map[string]string{}
+	for service := range conns.EndpointServices {
+		if v, ok := d.GetOk("endpoints.0." + service); ok {
+			endpoints[service] = v.(string)
+		}
+	}
+	return endpoints
+}
+
+// validateEndpointsFile reports the problems of the endpoints file of the
+// provider. Its unknown services, visibilities and regions are warnings.
+func validateEndpointsFile(path string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	warnings, err := conns.ValidateEndpointsFile(conns.EndpointsFilePath(path))
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning,
+		})
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid endpoints file",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
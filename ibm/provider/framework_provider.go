@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/functions"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+// ProtoV5ProviderServerFactory returns a factory for the provider's gRPC
+// server. The server muxes the SDKv2 provider, which owns the provider block
+// and the resources and data sources, with a plugin-framework provider that
+// serves the features only available through the framework, such as
+// ephemeral resources and provider functions.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	primary := Provider()
+	servers := []func() tfprotov5.ProviderServer{
+		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return &frameworkServer{
+				ProviderServer: providerserver.NewProtocol5(NewFrameworkProvider(primary))(),
+			}
+		},
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkServer hides the provider block from the plugin-framework
+// provider. The provider schema and its configuration belong to the SDKv2
+// provider, so the framework provider declares no schema of its own and is
+// configured from the client session built by the SDKv2 provider instead.
+type frameworkServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *frameworkServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+	}
+	return resp, err
+}
+
+func (s *frameworkServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return s.ProviderServer.PrepareProviderConfig(ctx, &tfprotov5.PrepareProviderConfigRequest{})
+}
+
+func (s *frameworkServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return s.ProviderServer.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion:   req.TerraformVersion,
+		ClientCapabilities: req.ClientCapabilities,
+	})
+}
+
+// frameworkProvider is the plugin-framework half of the provider. It shares
+// the conns.ClientSession of the SDKv2 provider it is muxed with.
+type frameworkProvider struct {
+	primary *schema.Provider
+}
+
+var _ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+var _ fwprovider.ProviderWithFunctions = &frameworkProvider{}
+
+// NewFrameworkProvider returns the plugin-framework provider that is served
+// alongside primary.
+func NewFrameworkProvider(primary *schema.Provider) fwprovider.Provider {
+	return &frameworkProvider{primary: primary}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "ibm"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{}
+}
+
+// Configure hands the client session of the SDKv2 provider to the framework
+// resources. The mux server configures the SDKv2 provider first, so its meta
+// is already populated here.
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	meta := p.primary.Meta()
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iamidentity.NewIBMIAMAuthTokenEphemeralResource,
+		kubernetes.NewIBMContainerClusterConfigEphemeralResource,
+		secretsmanager.NewIbmSmArbitrarySecretEphemeralResource,
+		secretsmanager.NewIbmSmKvSecretEphemeralResource,
+		secretsmanager.NewIbmSmUsernamePasswordSecretEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewBuildCRNFunction,
+		functions.NewCIDRFitsVPCAddressPrefixFunction,
+		functions.NewParseCRNFunction,
+		functions.NewRegionFromZoneFunction,
+		functions.NewValidateResourceNameFunction,
+	}
+}
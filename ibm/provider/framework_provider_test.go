@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProtoV5ProviderServerSchema(t *testing.T) {
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating the provider server: %s", err)
+	}
+
+	resp, err := serverFactory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting the provider schema: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error in the provider schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	if resp.Provider == nil || len(resp.Provider.Block.Attributes) == 0 {
+		t.Fatal("Expected the provider block of the SDKv2 provider to be served")
+	}
+	if _, ok := resp.ResourceSchemas["ibm_is_vpc"]; !ok {
+		t.Fatal("Expected the SDKv2 resources to be served")
+	}
+	for _, name := range []string{
+		"ibm_container_cluster_config",
+		"ibm_iam_auth_token",
+		"ibm_sm_arbitrary_secret",
+		"ibm_sm_kv_secret",
+		"ibm_sm_username_password_secret",
+	} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("Expected ephemeral resource %s to be served", name)
+		}
+	}
+}
+
+func TestProtoV5ProviderServerConfigure(t *testing.T) {
+	for _, env := range []string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+		"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+		t.Setenv(env, "")
+	}
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating the provider server: %s", err)
+	}
+	server := serverFactory()
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting the provider schema: %s", err)
+	}
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attributeType := range configType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, attributes))
+	if err != nil {
+		t.Fatalf("Error encoding the provider config: %s", err)
+	}
+
+	prepareResp, err := server.PrepareProviderConfig(context.Background(), &tfprotov5.PrepareProviderConfigRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("Error validating the provider config: %s", err)
+	}
+	for _, diag := range prepareResp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error validating the provider config: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	configureResp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("Error configuring the provider: %s", err)
+	}
+	for _, diag := range configureResp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error configuring the provider: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+}
+
+func TestProtoV5ProviderServerFunctions(t *testing.T) {
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating the provider server: %s", err)
+	}
+	server := serverFactory()
+
+	// Terraform discovers the functions through the provider schema before
+	// calling them.
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting the provider schema: %s", err)
+	}
+	for _, name := range []string{
+		"build_crn",
+		"cidr_fits_vpc_address_prefix",
+		"parse_crn",
+		"region_from_zone",
+		"validate_resource_name",
+	} {
+		if _, ok := schemaResp.Functions[name]; !ok {
+			t.Errorf("Expected function %s to be served", name)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		arguments []string
+		expected  tftypes.Value
+	}{
+		{"region_from_zone", []string{"eu-de-2"}, tftypes.NewValue(tftypes.String, "eu-de")},
+		{"validate_resource_name", []string{"ibm_is_vpc", "my-vpc"}, tftypes.NewValue(tftypes.Bool, true)},
+		{"validate_resource_name", []string{"ibm_is_vpc", "My_VPC"}, tftypes.NewValue(tftypes.Bool, false)},
+	}
+	for _, tc := range testCases {
+		arguments := make([]*tfprotov5.DynamicValue, 0, len(tc.arguments))
+		for _, argument := range tc.arguments {
+			value, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, argument))
+			if err != nil {
+				t.Fatalf("Error encoding the argument %q: %s", argument, err)
+			}
+			arguments = append(arguments, &value)
+		}
+
+		resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{Name: tc.name, Arguments: arguments})
+		if err != nil {
+			t.Fatalf("Error calling %s: %s", tc.name, err)
+		}
+		if resp.Error != nil {
+			t.Fatalf("Unexpected error calling %s%v: %s", tc.name, tc.arguments, resp.Error.Text)
+		}
+		result, err := resp.Result.Unmarshal(tc.expected.Type())
+		if err != nil {
+			t.Fatalf("Error decoding the result of %s: %s", tc.name, err)
+		}
+		if !result.Equal(tc.expected) {
+			t.Errorf("%s%v: expected %s, got %s", tc.name, tc.arguments, tc.expected, result)
+		}
+	}
+}
+
+func TestProtoV5ProviderServerResourceIdentity(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		if resource.Identity == nil {
+			continue
+		}
+		if resource.Importer == nil {
+			t.Errorf("Resource %s has an identity but does not support import", name)
+		}
+		if err := resource.Identity.InternalIdentityValidate(); err != nil {
+			t.Errorf("Resource %s has an invalid identity: %s", name, err)
+		}
+	}
+
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating the provider server: %s", err)
+	}
+	resp, err := serverFactory().GetResourceIdentitySchemas(context.Background(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("Error getting the resource identity schemas: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("Unexpected error in the resource identity schemas: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+	for _, name := range []string{
+		"ibm_cis_dns_record",
+		"ibm_iam_access_group_policy",
+		"ibm_is_security_group_rule",
+		"ibm_is_vpc",
+		"ibm_pi_instance",
+		"ibm_pi_volume",
+	} {
+		if _, ok := resp.IdentitySchemas[name]; !ok {
+			t.Errorf("Expected resource %s to have an identity schema", name)
+		}
+	}
+}
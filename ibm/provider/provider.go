@@ -13,7 +13,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -204,17 +206,61 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "IAM Trusted Profile ID",
-				ConflictsWith: []string{"iam_profile_name"},
+				ConflictsWith: []string{"iam_profile_name", "iam_profile_crn"},
 				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
 			"iam_profile_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"iam_profile_id"},
+				ConflictsWith: []string{"iam_profile_id", "iam_profile_crn"},
 				RequiredWith:  []string{"ibmcloud_account_id"},
 				Description:   "IAM Trusted Profile Name",
 				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
 			},
+			"iam_profile_crn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"iam_profile_id", "iam_profile_name"},
+				Description:   "IAM Trusted Profile CRN, which a VPC instance can assume",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_CRN", "IBMCLOUD_IAM_PROFILE_CRN"}, nil),
+			},
+			"iam_compute_resource": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(conns.ComputeResources),
+				Description:  "Authenticates as the compute resource that the provider runs on, with the trusted profile of iam_profile_id, iam_profile_name or iam_profile_crn. Example: vpc_instance or container.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_IAM_COMPUTE_RESOURCE", "IBMCLOUD_IAM_COMPUTE_RESOURCE"}, nil),
+			},
+			"iam_compute_resource_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the compute resource token of a container, which defaults to the token that IBM Cloud Kubernetes Service projects into pods",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_COMPUTE_RESOURCE_TOKEN_FILE", "IBMCLOUD_IAM_COMPUTE_RESOURCE_TOKEN_FILE"}, nil),
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Trusted profiles that are assumed in turn after authenticating, each with the token of the one before it, e.g. a trusted profile of an enterprise account and then one of a child account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the trusted profile",
+						},
+						"profile_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the trusted profile in account_id",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Account of the trusted profile. It is required with profile_name, and with profile_id the token of the profile is checked to belong to it.",
+						},
+					},
+				},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -247,6 +293,59 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"endpoints":        endpointsSchema(),
+			"default_tags":     defaultTagsSchema(),
+			"default_timeouts": defaultTimeoutsSchema(),
+			"http_trace": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Writes a record of every HTTP call made by the provider to a file, with credentials redacted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path of the file that the records are appended to",
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      conns.HTTPTraceFormatJSONL,
+							ValidateFunc: validate.ValidateAllowedStringValues(conns.HTTPTraceFormats),
+							Description:  "Format of the records. Example: jsonl.",
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Limits the rate of the HTTP calls made by the provider, and retries the calls that IBM Cloud throttles with a backoff",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Rate of the HTTP calls of all the services. There is no limit when it is 0.",
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of HTTP calls that can be made at once. It defaults to the rate, rounded up.",
+						},
+						"per_service": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeFloat},
+							Description: "Rate of the HTTP calls of the services whose client names, as in the http_trace records, start with a key. Example: vpc or cis.",
+						},
+					},
+				},
+			},
 			"ibmcloud_account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -642,6 +741,8 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_network_interfaces":  vpc.DataSourceIBMIsVirtualNetworkInterfaces(),
 			"ibm_is_public_address_range":        vpc.DataSourceIBMIsPublicAddressRange(),
 			"ibm_is_public_address_ranges":       vpc.DataSourceIBMIsPublicAddressRanges(),
+			"ibm_is_reachability_analysis":       vpc.DataSourceIBMIsReachabilityAnalysis(),
+			"ibm_is_rule_conflicts":              vpc.DataSourceIBMIsRuleConflicts(),
 			// vni
 
 			"ibm_is_virtual_network_interface_floating_ip":  vpc.DataSourceIBMIsVirtualNetworkInterfaceFloatingIP(),
@@ -1859,7 +1960,7 @@ func Provider() *schema.Provider {
 			"ibm_pdr_validate_apikey": drautomationservice.ResourceIBMPdrValidateApikey(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	wrappedProvider := wrapProvider(provider)
@@ -1872,6 +1973,9 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 
 	for key, value := range provider.ResourcesMap {
 		wrappedResourcesMap[key] = wrapResource(key, value)
+		if supportsDefaultTags(key, value) {
+			wrappedResourcesMap[key] = withDefaultTags(wrappedResourcesMap[key])
+		}
 	}
 
 	for key, value := range provider.DataSourcesMap {
@@ -1879,10 +1983,10 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	}
 
 	return schema.Provider{
-		Schema:         provider.Schema,
-		DataSourcesMap: wrappedDataSourcesMap,
-		ResourcesMap:   wrappedResourcesMap,
-		ConfigureFunc:  provider.ConfigureFunc,
+		Schema:               provider.Schema,
+		DataSourcesMap:       wrappedDataSourcesMap,
+		ResourcesMap:         wrappedResourcesMap,
+		ConfigureContextFunc: withDefaultTimeouts(provider.ConfigureContextFunc, wrappedResourcesMap),
 	}
 }
 
@@ -1901,8 +2005,9 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
 		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
-		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
+		CustomizeDiff:        wrapCustomizeDiff(name, withCrossFieldRules(name, resource.CustomizeDiff)),
 		Importer:             resource.Importer,
+		Identity:             resource.Identity,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
 		Description:          resource.Description,
@@ -1955,6 +2060,11 @@ func wrapFunction(
 				}
 			}
 
+			if isDataSource {
+				context = conns.WithTraceResource(context, "data."+resourceName, schema.Id(), operationName)
+			} else {
+				context = conns.WithTraceResource(context, resourceName, schema.Id(), operationName)
+			}
 			return function(context, schema, meta)
 		}
 	} else if fallback != nil {
@@ -1998,6 +2108,19 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	)
 }
 
+// withCrossFieldRules adds the cross-attribute rules of the resource in
+// the validator dictionary to its CustomizeDiff function.
+func withCrossFieldRules(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	rules := validate.InvokeCrossFieldValidator(resourceName)
+	if rules == nil {
+		return function
+	}
+	if function == nil {
+		return rules
+	}
+	return customdiff.All(rules, function)
+}
+
 func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	if function == nil {
 		return nil
@@ -2100,6 +2223,13 @@ func Validator() validate.ValidatorDict {
 				"ibm_tg_connection_rgre_tunnel":                transitgateway.ResourceIBMTransitGatewayConnectionRgreTunnelValidator(),
 				"ibm_dl_virtual_connection":                    directlink.ResourceIBMDLGatewayVCValidator(),
 				"ibm_dl_gateway":                               directlink.ResourceIBMDLGatewayValidator(),
+				"ibm_compute_bare_metal":                       classicinfrastructure.ResourceIBMComputeBareMetalValidator(),
+				"ibm_compute_vm_instance":                      classicinfrastructure.ResourceIBMComputeVmInstanceValidator(),
+				"ibm_subnet":                                   classicinfrastructure.ResourceIBMSubnetValidator(),
+				"ibm_lb":                                       classicinfrastructure.ResourceIBMLbValidator(),
+				"ibm_lb_vpx_vip":                               classicinfrastructure.ResourceIBMLbVpxVipValidator(),
+				"ibm_lbaas":                                    classicinfrastructure.ResourceIBMLbaasValidator(),
+				"ibm_cloudant":                                 cloudant.ResourceIBMCloudantValidator(),
 				"ibm_dl_provider_gateway":                      directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_dl_gateway_action":                        directlink.ResourceIBMDLGatewayActionValidator(),
 				"ibm_dl_gateway_macsec_cak":                    directlink.ResourceIBMdlGatewayMacsecCakValidator(),
@@ -2203,9 +2333,11 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_vpn_server_route":                            vpc.ResourceIBMIsVPNServerRouteValidator(),
 				"ibm_is_reservation":                                 vpc.ResourceIBMISReservationValidator(),
 				"ibm_kms_key_rings":                                  kms.ResourceIBMKeyRingValidator(),
+				"ibm_kms_key":                                        kms.ResourceIBMKmskeyValidator(),
 				"ibm_dns_glb_monitor":                                dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_custom_resolver":                            dnsservices.ResourceIBMPrivateDNSCustomResolverValidator(),
 				"ibm_dns_custom_resolver_forwarding_rule":            dnsservices.ResourceIBMPrivateDNSForwardingRuleValidator(),
+				"ibm_dns_resource_record":                            dnsservices.ResourceIBMPrivateDNSResourceRecordValidator(),
 				"ibm_schematics_action":                              schematics.ResourceIBMSchematicsActionValidator(),
 				"ibm_schematics_job":                                 schematics.ResourceIBMSchematicsJobValidator(),
 				"ibm_schematics_workspace":                           schematics.ResourceIBMSchematicsWorkspaceValidator(),
@@ -2220,7 +2352,13 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_access_tag":                                 globaltagging.ResourceIBMIamAccessTagValidator(),
 				"ibm_satellite_location":                             satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                              satellite.ResourceIBMSatelliteClusterValidator(),
+				"ibm_pi_capture":                                     power.ResourceIBMPICaptureValidator(),
+				"ibm_pi_instance":                                    power.ResourceIBMPIInstanceValidator(),
+				"ibm_pi_network":                                     power.ResourceIBMPINetworkValidator(),
+				"ibm_pi_network_security_group_rule":                 power.ResourceIBMPINetworkSecurityGroupRuleValidator(),
 				"ibm_pi_volume":                                      power.ResourceIBMPIVolumeValidator(),
+				"ibm_pi_image":                                       power.ResourceIBMPIImageValidator(),
+				"ibm_pi_virtual_serial_number":                       power.ResourceIBMPIVirtualSerialNumberValidator(),
 				"ibm_atracker_target":                                atracker.ResourceIBMAtrackerTargetValidator(),
 				"ibm_atracker_route":                                 atracker.ResourceIBMAtrackerRouteValidator(),
 				"ibm_atracker_settings":                              atracker.ResourceIBMAtrackerSettingsValidator(),
@@ -2390,6 +2528,8 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_is_vpc":                          vpc.DataSourceIBMISVpcValidator(),
 				"ibm_is_volume":                       vpc.DataSourceIBMISVolumeValidator(),
+				"ibm_is_reachability_analysis":        vpc.DataSourceIBMIsReachabilityAnalysisValidator(),
+				"ibm_is_rule_conflicts":               vpc.DataSourceIBMIsRuleConflictsValidator(),
 				"ibm_cis_webhooks":                    cis.DataSourceIBMCISAlertWebhooksValidator(),
 				"ibm_cis_alerts":                      cis.DataSourceIBMCISAlertsValidator(),
 				"ibm_cis_bot_managements":             cis.DataSourceIBMCISBotManagementValidator(),
@@ -2470,7 +2610,7 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId, iamTrustedProfileName, account string
@@ -2492,6 +2632,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if tname, ok := d.GetOk("iam_profile_name"); ok {
 		iamTrustedProfileName = tname.(string)
 	}
+	var iamTrustedProfileCRN, iamComputeResource, iamComputeResourceTokenFile string
+	if tcrn, ok := d.GetOk("iam_profile_crn"); ok {
+		iamTrustedProfileCRN = tcrn.(string)
+	}
+	if cr, ok := d.GetOk("iam_compute_resource"); ok {
+		iamComputeResource = cr.(string)
+	}
+	if tfile, ok := d.GetOk("iam_compute_resource_token_file"); ok {
+		iamComputeResourceTokenFile = tfile.(string)
+	}
 	if taccount, ok := d.GetOk("ibmcloud_account_id"); ok {
 		account = taccount.(string)
 	}
@@ -2540,6 +2690,31 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var httpTracePath, httpTraceFormat string
+	if p, ok := d.GetOk("http_trace.0.path"); ok {
+		httpTracePath = p.(string)
+		httpTraceFormat = d.Get("http_trace.0.format").(string)
+	}
+	var rateLimit *conns.RateLimit
+	if _, ok := d.GetOk("rate_limit"); ok {
+		rateLimit = &conns.RateLimit{
+			RequestsPerSecond: d.Get("rate_limit.0.requests_per_second").(float64),
+			Burst:             d.Get("rate_limit.0.burst").(int),
+			PerService:        map[string]float64{},
+		}
+		for service, requestsPerSecond := range d.Get("rate_limit.0.per_service").(map[string]interface{}) {
+			rateLimit.PerService[service] = requestsPerSecond.(float64)
+		}
+	}
+
+	var assumeTrustedProfiles []conns.TrustedProfile
+	for _, p := range d.Get("assume_trusted_profile").([]interface{}) {
+		profile, _ := p.(map[string]interface{})
+		id, _ := profile["profile_id"].(string)
+		name, _ := profile["profile_name"].(string)
+		accountID, _ := profile["account_id"].(string)
+		assumeTrustedProfiles = append(assumeTrustedProfiles, conns.TrustedProfile{ProfileID: id, ProfileName: name, AccountID: accountID})
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
@@ -2550,7 +2725,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 	// Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
@@ -2558,28 +2733,45 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := conns.Config{
-		BluemixAPIKey:         bluemixAPIKey,
-		Region:                region,
-		ResourceGroup:         resourceGrp,
-		BluemixTimeout:        time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:      time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:     softlayerUsername,
-		SoftLayerAPIKey:       softlayerAPIKey,
-		RetryCount:            retryCount,
-		SoftLayerEndpointURL:  softlayerEndpointUrl,
-		RetryDelay:            conns.RetryAPIDelay,
-		FunctionNameSpace:     wskNameSpace,
-		RiaasEndPoint:         riaasEndPoint,
-		IAMToken:              iamToken,
-		IAMRefreshToken:       iamRefreshToken,
-		Zone:                  zone,
-		Visibility:            visibility,
-		PrivateEndpointType:   privateEndpointType,
-		EndpointsFile:         file,
-		IAMTrustedProfileID:   iamTrustedProfileId,
-		IAMTrustedProfileName: iamTrustedProfileName,
-		Account:               account,
+		DefaultTags:                 expandDefaultTags(d),
+		BluemixAPIKey:               bluemixAPIKey,
+		Region:                      region,
+		ResourceGroup:               resourceGrp,
+		BluemixTimeout:              time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:            time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:           softlayerUsername,
+		SoftLayerAPIKey:             softlayerAPIKey,
+		RetryCount:                  retryCount,
+		SoftLayerEndpointURL:        softlayerEndpointUrl,
+		RetryDelay:                  conns.RetryAPIDelay,
+		FunctionNameSpace:           wskNameSpace,
+		RiaasEndPoint:               riaasEndPoint,
+		IAMToken:                    iamToken,
+		IAMRefreshToken:             iamRefreshToken,
+		Zone:                        zone,
+		Visibility:                  visibility,
+		PrivateEndpointType:         privateEndpointType,
+		EndpointsFile:               file,
+		Endpoints:                   expandEndpoints(d),
+		IAMTrustedProfileID:         iamTrustedProfileId,
+		IAMTrustedProfileName:       iamTrustedProfileName,
+		IAMTrustedProfileCRN:        iamTrustedProfileCRN,
+		IAMComputeResource:          iamComputeResource,
+		IAMComputeResourceTokenFile: iamComputeResourceTokenFile,
+		Account:                     account,
+		HTTPTracePath:               httpTracePath,
+		HTTPTraceFormat:             httpTraceFormat,
+		RateLimit:                   rateLimit,
+		AssumeTrustedProfiles:       assumeTrustedProfiles,
 	}
 
-	return config.ClientSession()
+	diags := validateEndpointsFile(file)
+	if diags.HasError() {
+		return nil, diags
+	}
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return session, diags
 }
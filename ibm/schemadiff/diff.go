@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Change is a difference between two snapshots.
+type Change struct {
+	// Path is the path of the resource or attribute, e.g. ibm_is_vpc,
+	// data.ibm_is_vpc.name, provider.region or ibm_is_instance.boot_volume.name.
+	Path string
+
+	Message string
+
+	// Breaking reports whether configurations or states that work with the
+	// old schema can fail, or plan other changes, with the new one.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	kind := "non-breaking"
+	if c.Breaking {
+		kind = "BREAKING"
+	}
+	return fmt.Sprintf("%s: %s: %s", kind, c.Path, c.Message)
+}
+
+// Diff returns the changes from the old snapshot to the new one, sorted by
+// path.
+func Diff(old, new *Snapshot) []Change {
+	d := &diff{}
+	d.attributes("provider.", old.Provider, new.Provider)
+	d.resources("", "resource", old.Resources, new.Resources)
+	d.resources("data.", "data source", old.DataSources, new.DataSources)
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+	return d.changes
+}
+
+// Breaking returns the breaking changes.
+func Breaking(changes []Change) []Change {
+	var breaking []Change
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		}
+	}
+	return breaking
+}
+
+type diff struct {
+	changes []Change
+}
+
+func (d *diff) add(path string, breaking bool, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Path: path, Message: fmt.Sprintf(format, args...), Breaking: breaking})
+}
+
+func (d *diff) resources(prefix, kind string, old, new map[string]*Resource) {
+	for _, name := range sortedKeys(old) {
+		if _, ok := new[name]; !ok {
+			d.add(prefix+name, true, "%s removed", kind)
+		}
+	}
+	for _, name := range sortedKeys(new) {
+		o, n := old[name], new[name]
+		path := prefix + name
+		if o == nil {
+			d.add(path, false, "%s added", kind)
+			continue
+		}
+		if o.SchemaVersion != n.SchemaVersion {
+			// The provider upgrades the states of older versions, but
+			// older versions cannot read the upgraded states.
+			d.add(path, false, "schema version changed from %d to %d, the state cannot be used with older versions", o.SchemaVersion, n.SchemaVersion)
+		}
+		if !o.Deprecated && n.Deprecated {
+			d.add(path, false, "%s deprecated", kind)
+		}
+		d.attributes(path+".", o.Attributes, n.Attributes)
+	}
+}
+
+func (d *diff) attributes(prefix string, old, new map[string]*Attribute) {
+	for _, name := range sortedKeys(old) {
+		if _, ok := new[name]; !ok {
+			d.add(prefix+name, true, "removed")
+		}
+	}
+	for _, name := range sortedKeys(new) {
+		o, n := old[name], new[name]
+		path := prefix + name
+		if o == nil {
+			if n.Required {
+				d.add(path, true, "required argument added")
+			} else {
+				d.add(path, false, "added")
+			}
+			continue
+		}
+		d.attribute(path, o, n)
+	}
+}
+
+func (d *diff) attribute(path string, o, n *Attribute) {
+	if o.Type != n.Type {
+		d.add(path, true, "type changed from %s to %s", o.Type, n.Type)
+		return
+	}
+
+	switch {
+	case !o.Required && n.Required:
+		d.add(path, true, "became required")
+	case o.Required && n.Optional:
+		d.add(path, false, "became optional")
+	}
+	switch {
+	case (o.Optional || o.Required) && !n.Optional && !n.Required:
+		d.add(path, true, "can no longer be set")
+	case !o.Optional && !o.Required && (n.Optional || n.Required):
+		d.add(path, false, "can be set")
+	}
+	// An optional argument that is no longer computed plans the removal of
+	// the value set by the provider when it is not configured.
+	switch {
+	case o.Computed && !n.Computed && n.Optional:
+		d.add(path, true, "is no longer computed when not set")
+	case !o.Computed && n.Computed && o.Optional:
+		d.add(path, false, "is computed when not set")
+	}
+	switch {
+	case !o.ForceNew && n.ForceNew:
+		d.add(path, true, "changes now replace the resource")
+	case o.ForceNew && !n.ForceNew:
+		d.add(path, true, "changes no longer replace the resource, they are updated in place")
+	}
+	switch {
+	case !o.Sensitive && n.Sensitive:
+		// Outputs of sensitive values must be marked sensitive.
+		d.add(path, true, "became sensitive")
+	case o.Sensitive && !n.Sensitive:
+		d.add(path, false, "is no longer sensitive")
+	}
+	if !o.Deprecated && n.Deprecated {
+		d.add(path, false, "deprecated")
+	}
+	if o.Default != n.Default {
+		d.add(path, true, "default changed from %q to %q", o.Default, n.Default)
+	}
+	if n.MinItems > o.MinItems {
+		d.add(path, true, "min_items increased from %d to %d", o.MinItems, n.MinItems)
+	}
+	switch {
+	case o.MaxItems == 0 && n.MaxItems != 0:
+		d.add(path, true, "max_items set to %d", n.MaxItems)
+	case n.MaxItems != 0 && n.MaxItems < o.MaxItems:
+		d.add(path, true, "max_items decreased from %d to %d", o.MaxItems, n.MaxItems)
+	}
+
+	switch {
+	case o.Elem != nil && n.Elem != nil:
+		if o.Elem.Type != n.Elem.Type {
+			d.add(path, true, "element type changed from %s to %s", o.Elem.Type, n.Elem.Type)
+		}
+	case isBlock(o) && isBlock(n):
+		d.attributes(path+".", o.Block, n.Block)
+	case isBlock(o) != isBlock(n):
+		d.add(path, true, "element changed between values and nested blocks")
+	}
+}
+
+// isBlock reports whether a is a list or set of nested blocks. The
+// attributes of blocks without any are left out of snapshots.
+func isBlock(a *Attribute) bool {
+	return a.Elem == nil && (a.Type == "list" || a.Type == "set")
+}
+
+// sortedKeys returns the keys of m, sorted.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schemadiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old := &Snapshot{
+		Provider: map[string]*Attribute{"region": {Type: "string", Optional: true}},
+		Resources: map[string]*Resource{
+			"ibm_thing": {Attributes: map[string]*Attribute{
+				"name":     {Type: "string", Optional: true},
+				"size":     {Type: "int", Optional: true, Default: "10"},
+				"zone":     {Type: "string", Required: true, ForceNew: true},
+				"profile":  {Type: "string", Optional: true, Computed: true},
+				"tags":     {Type: "set", Optional: true, Elem: &Attribute{Type: "string"}},
+				"crn":      {Type: "string", Computed: true},
+				"password": {Type: "string", Optional: true},
+				"rule": {Type: "list", Optional: true, MaxItems: 2, Block: map[string]*Attribute{
+					"port": {Type: "int", Optional: true},
+				}},
+			}},
+			"ibm_gone": {Attributes: map[string]*Attribute{}},
+		},
+		DataSources: map[string]*Resource{},
+	}
+	new := &Snapshot{
+		Provider: map[string]*Attribute{"region": {Type: "string", Optional: true, Deprecated: true}},
+		Resources: map[string]*Resource{
+			"ibm_thing": {SchemaVersion: 1, Attributes: map[string]*Attribute{
+				"name":     {Type: "string", Required: true},
+				"size":     {Type: "string", Optional: true, Default: "10"},
+				"zone":     {Type: "string", Optional: true},
+				"profile":  {Type: "string", Optional: true},
+				"tags":     {Type: "set", Optional: true, Elem: &Attribute{Type: "int"}},
+				"password": {Type: "string", Optional: true, Sensitive: true},
+				"rule": {Type: "list", Optional: true, MaxItems: 1, Block: map[string]*Attribute{
+					"port":     {Type: "int", Optional: true},
+					"protocol": {Type: "string", Required: true},
+				}},
+				"vpc": {Type: "string", Optional: true},
+			}},
+		},
+		DataSources: map[string]*Resource{"ibm_things": {Attributes: map[string]*Attribute{}}},
+	}
+
+	var got []string
+	for _, c := range Diff(old, new) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"non-breaking: data.ibm_things: data source added",
+		"BREAKING: ibm_gone: resource removed",
+		"non-breaking: ibm_thing: schema version changed from 0 to 1, the state cannot be used with older versions",
+		"BREAKING: ibm_thing.crn: removed",
+		"BREAKING: ibm_thing.name: became required",
+		"BREAKING: ibm_thing.password: became sensitive",
+		"BREAKING: ibm_thing.profile: is no longer computed when not set",
+		"BREAKING: ibm_thing.rule: max_items decreased from 2 to 1",
+		"BREAKING: ibm_thing.rule.protocol: required argument added",
+		"BREAKING: ibm_thing.size: type changed from int to string",
+		"BREAKING: ibm_thing.tags: element type changed from string to int",
+		"non-breaking: ibm_thing.vpc: added",
+		"non-breaking: ibm_thing.zone: became optional",
+		"BREAKING: ibm_thing.zone: changes no longer replace the resource, they are updated in place",
+		"non-breaking: provider.region: deprecated",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if n := len(Breaking(Diff(old, new))); n != 10 {
+		t.Errorf("Expected 10 breaking changes, got %d", n)
+	}
+	if changes := Diff(new, new); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %v", changes)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	s := &Snapshot{
+		Provider:    map[string]*Attribute{"region": {Type: "string", Optional: true}},
+		Resources:   map[string]*Resource{"ibm_thing": {SchemaVersion: 1, Attributes: map[string]*Attribute{"name": {Type: "string", Required: true}}}},
+		DataSources: map[string]*Resource{},
+	}
+	src, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "provider": {
+    "region": {"type":"string","optional":true}
+  },
+  "resources": {
+    "ibm_thing": {
+      "schema_version": 1,
+      "attributes": {
+        "name": {"type":"string","required":true}
+      }
+    }
+  },
+  "data_sources": {
+  }
+}
+`
+	if string(src) != want {
+		t.Errorf("Unexpected snapshot:\n%s\nwant:\n%s", src, want)
+	}
+	if _, err := Unmarshal(src); err != nil {
+		t.Errorf("Snapshot is not valid JSON: %s", err)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package schemadiff snapshots the schema of the provider and compares two
+// snapshots, to find the changes of a release that break configurations or
+// states written for the previous one.
+package schemadiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Snapshot is the part of the schema of the provider that configurations
+// and states depend on. Descriptions are left out.
+type Snapshot struct {
+	Provider    map[string]*Attribute `json:"provider"`
+	Resources   map[string]*Resource  `json:"resources"`
+	DataSources map[string]*Resource  `json:"data_sources"`
+}
+
+// Resource is the schema of a resource or data source.
+type Resource struct {
+	SchemaVersion int                   `json:"schema_version,omitempty"`
+	Deprecated    bool                  `json:"deprecated,omitempty"`
+	Attributes    map[string]*Attribute `json:"attributes"`
+}
+
+// Attribute is the schema of an argument, attribute or nested block.
+type Attribute struct {
+	Type       string `json:"type"`
+	Optional   bool   `json:"optional,omitempty"`
+	Required   bool   `json:"required,omitempty"`
+	Computed   bool   `json:"computed,omitempty"`
+	ForceNew   bool   `json:"force_new,omitempty"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+	Default    string `json:"default,omitempty"`
+	MinItems   int    `json:"min_items,omitempty"`
+	MaxItems   int    `json:"max_items,omitempty"`
+
+	// Elem is the element of a list, set or map of primitive values.
+	Elem *Attribute `json:"elem,omitempty"`
+
+	// Block are the attributes of the elements of a list or set of nested
+	// blocks.
+	Block map[string]*Attribute `json:"block,omitempty"`
+}
+
+// New returns the snapshot of the schema of a provider.
+func New(p *schema.Provider) *Snapshot {
+	s := &Snapshot{
+		Provider:    attributes(p.Schema),
+		Resources:   map[string]*Resource{},
+		DataSources: map[string]*Resource{},
+	}
+	for name, r := range p.ResourcesMap {
+		s.Resources[name] = resource(r)
+	}
+	for name, r := range p.DataSourcesMap {
+		s.DataSources[name] = resource(r)
+	}
+	return s
+}
+
+func resource(r *schema.Resource) *Resource {
+	return &Resource{
+		SchemaVersion: r.SchemaVersion,
+		Deprecated:    r.DeprecationMessage != "",
+		Attributes:    attributes(r.Schema),
+	}
+}
+
+func attributes(args map[string]*schema.Schema) map[string]*Attribute {
+	attrs := make(map[string]*Attribute, len(args))
+	for name, s := range args {
+		attrs[name] = attribute(s)
+	}
+	return attrs
+}
+
+func attribute(s *schema.Schema) *Attribute {
+	a := &Attribute{
+		Type:       typeName(s.Type),
+		Optional:   s.Optional,
+		Required:   s.Required,
+		Computed:   s.Computed,
+		ForceNew:   s.ForceNew,
+		Sensitive:  s.Sensitive,
+		Deprecated: s.Deprecated != "",
+		MinItems:   s.MinItems,
+		MaxItems:   s.MaxItems,
+	}
+	if s.Default != nil {
+		a.Default = fmt.Sprint(s.Default)
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Schema:
+		a.Elem = attribute(elem)
+	case *schema.Resource:
+		a.Block = attributes(elem.Schema)
+	}
+	return a
+}
+
+func typeName(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeSet:
+		return "set"
+	}
+	return "invalid"
+}
+
+// Marshal returns the canonical JSON of the snapshot: its maps are sorted,
+// and each attribute of a resource is on its own line so that the diffs of
+// snapshots are readable.
+func (s *Snapshot) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	if err := writeAttributes(&buf, `"provider"`, s.Provider, "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteString(",\n")
+	if err := writeResources(&buf, "resources", s.Resources); err != nil {
+		return nil, err
+	}
+	buf.WriteString(",\n")
+	if err := writeResources(&buf, "data_sources", s.DataSources); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n}\n")
+	return buf.Bytes(), nil
+}
+
+func writeResources(buf *bytes.Buffer, key string, resources map[string]*Resource) error {
+	fmt.Fprintf(buf, "  %q: {", key)
+	for i, name := range sortedKeys(resources) {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		r := resources[name]
+		fmt.Fprintf(buf, "\n    %q: {", name)
+		if r.SchemaVersion != 0 {
+			fmt.Fprintf(buf, "\n      \"schema_version\": %d,", r.SchemaVersion)
+		}
+		if r.Deprecated {
+			buf.WriteString("\n      \"deprecated\": true,")
+		}
+		buf.WriteString("\n")
+		if err := writeAttributes(buf, `"attributes"`, r.Attributes, "      "); err != nil {
+			return err
+		}
+		buf.WriteString("\n    }")
+	}
+	buf.WriteString("\n  }")
+	return nil
+}
+
+func writeAttributes(buf *bytes.Buffer, key string, attrs map[string]*Attribute, indent string) error {
+	fmt.Fprintf(buf, "%s%s: {", indent, key)
+	for i, name := range sortedKeys(attrs) {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		attr, err := json.Marshal(attrs[name])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(buf, "\n%s  %q: %s", indent, name, attr)
+	}
+	fmt.Fprintf(buf, "\n%s}", indent)
+	return nil
+}
+
+// Unmarshal reads a snapshot.
+func Unmarshal(src []byte) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.Unmarshal(src, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
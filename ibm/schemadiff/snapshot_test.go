@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schemadiff
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+var update = flag.Bool("update", false, "write the snapshot of the provider schema")
+
+// snapshotPath is the committed snapshot of the schema of the provider.
+const snapshotPath = "testdata/schema.json"
+
+// TestSnapshot fails when the committed snapshot is not the snapshot of the
+// provider. Review the changes it reports, then update it with
+// go test ./ibm/schemadiff -run TestSnapshot -update.
+func TestSnapshot(t *testing.T) {
+	got, err := New(provider.Provider()).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := os.WriteFile(snapshotPath, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	src, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(got, src) {
+		return
+	}
+	committed, err := Unmarshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := Unmarshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changes []string
+	for _, c := range Diff(committed, current) {
+		changes = append(changes, c.String())
+	}
+	t.Errorf("%s is out of date, review the changes of the schema and run go test ./ibm/schemadiff -run TestSnapshot -update:\n%s", snapshotPath, strings.Join(changes, "\n"))
+}
@@ -17,11 +17,13 @@ import (
 
 func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmIbmAppConfigFeatureCreate,
-		Read:     resourceIbmIbmAppConfigFeatureRead,
-		Update:   resourceIbmIbmAppConfigFeatureUpdate,
-		Delete:   resourceIbmIbmAppConfigFeatureDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIbmIbmAppConfigFeatureCreate,
+		Read:   resourceIbmIbmAppConfigFeatureRead,
+		Update: resourceIbmIbmAppConfigFeatureUpdate,
+		Delete: resourceIbmIbmAppConfigFeatureDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "guid", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
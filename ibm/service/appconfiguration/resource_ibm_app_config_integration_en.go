@@ -14,11 +14,13 @@ import (
 
 func ResourceIBMAppConfigIntegrationEn() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceIntegrationEnRead,
-		Create:   resourceIntegrationEnCreate,
-		Update:   resourceIntegrationEnUpdate,
-		Delete:   resourceIntegrationEnDelete,
-		Importer: &schema.ResourceImporter{},
+		Read:   resourceIntegrationEnRead,
+		Create: resourceIntegrationEnCreate,
+		Update: resourceIntegrationEnUpdate,
+		Delete: resourceIntegrationEnDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIntegrationEnImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -158,6 +160,39 @@ func resourceIntegrationEnRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceIntegrationEnImport sets the arguments that read leaves alone, as
+// they only change with a new integration: the integration ID and the EN
+// instance it sends to.
+func resourceIntegrationEnImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	options := &appconfigurationv1.GetIntegrationOptions{}
+	options.SetIntegrationID(parts[1])
+
+	result, response, err := appconfigClient.GetIntegration(options)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetIntegration failed %s\n%s", err, response)
+	}
+
+	d.Set("integration_id", parts[1])
+	if metadata, ok := result.Metadata.(*appconfigurationv1.IntegrationMetadata); ok {
+		if metadata.EventNotificationsEndpoint != nil {
+			d.Set("en_endpoint", *metadata.EventNotificationsEndpoint)
+		}
+		if metadata.EventNotificationsInstanceCrn != nil {
+			d.Set("en_instance_crn", *metadata.EventNotificationsInstanceCrn)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIntegrationEnDelete(d *schema.ResourceData, meta interface{}) error {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
@@ -14,11 +14,13 @@ import (
 
 func ResourceIBMAppConfigIntegrationKms() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceIntegrationKmsRead,
-		Create:   resourceIntegrationKmsCreate,
-		Update:   resourceIntegrationKmsUpdate,
-		Delete:   resourceIntegrationKmsDelete,
-		Importer: &schema.ResourceImporter{},
+		Read:   resourceIntegrationKmsRead,
+		Create: resourceIntegrationKmsCreate,
+		Update: resourceIntegrationKmsUpdate,
+		Delete: resourceIntegrationKmsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIntegrationKmsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -154,6 +156,42 @@ func resourceIntegrationKmsRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceIntegrationKmsImport sets the arguments that read leaves alone, as
+// they only change with a new integration: the integration ID and the KMS
+// instance and root key it uses.
+func resourceIntegrationKmsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	options := &appconfigurationv1.GetIntegrationOptions{}
+	options.SetIntegrationID(parts[1])
+
+	result, response, err := appconfigClient.GetIntegration(options)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetIntegration failed %s\n%s", err, response)
+	}
+
+	d.Set("integration_id", parts[1])
+	if metadata, ok := result.Metadata.(*appconfigurationv1.IntegrationMetadata); ok {
+		if metadata.RootKeyID != nil {
+			d.Set("root_key_id", *metadata.RootKeyID)
+		}
+		if metadata.KmsEndpoint != nil {
+			d.Set("kms_endpoint", *metadata.KmsEndpoint)
+		}
+		if metadata.KmsInstanceCrn != nil {
+			d.Set("kms_instance_crn", *metadata.KmsInstanceCrn)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIntegrationKmsDelete(d *schema.ResourceData, meta interface{}) error {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
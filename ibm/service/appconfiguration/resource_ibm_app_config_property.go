@@ -16,11 +16,13 @@ import (
 
 func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmIbmAppConfigPropertyCreate,
-		Read:     resourceIbmIbmAppConfigPropertyRead,
-		Update:   resourceIbmIbmAppConfigPropertyUpdate,
-		Delete:   resourceIbmIbmAppConfigPropertyDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIbmIbmAppConfigPropertyCreate,
+		Read:   resourceIbmIbmAppConfigPropertyRead,
+		Update: resourceIbmIbmAppConfigPropertyUpdate,
+		Delete: resourceIbmIbmAppConfigPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "guid", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
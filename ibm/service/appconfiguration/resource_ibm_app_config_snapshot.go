@@ -11,11 +11,13 @@ import (
 
 func ResourceIBMIbmAppConfigSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIbmIbmAppConfigSnapshotCreate,
-		Read:     resourceIbmIbmAppConfigSnapshotRead,
-		Update:   resourceIbmIbmAppConfigSnapshotUpdate,
-		Delete:   resourceIbmIbmAppConfigSnapshotDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIbmIbmAppConfigSnapshotCreate,
+		Read:   resourceIbmIbmAppConfigSnapshotRead,
+		Update: resourceIbmIbmAppConfigSnapshotUpdate,
+		Delete: resourceIbmIbmAppConfigSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIbmIbmAppConfigSnapshotImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -279,6 +281,38 @@ func resourceIbmIbmAppConfigSnapshotRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// resourceIbmIbmAppConfigSnapshotImport sets the collection and environment
+// of the git config, which read leaves alone as they are only sent on create.
+func resourceIbmIbmAppConfigSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 {
+		return nil, flex.FmtErrorf("Kindly check the id")
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	options := &appconfigurationv1.GetGitconfigOptions{}
+	options.SetGitConfigID(parts[1])
+
+	result, response, err := appconfigClient.GetGitconfig(options)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetGitconfigs failed %s\n%s", err, response)
+	}
+
+	if result.Collection != nil && result.Collection.CollectionID != nil {
+		d.Set("collection_id", result.Collection.CollectionID)
+	}
+	if result.Environment != nil && result.Environment.EnvironmentID != nil {
+		d.Set("environment_id", result.Environment.EnvironmentID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIbmIbmAppConfigSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	parts, err := flex.IdParts(d.Id())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -72,10 +73,26 @@ func ResourceIBMAppIDCloudDirectoryUser() *schema.Resource {
 				Optional:    true,
 			},
 			"password": {
-				Description: "User password",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:  "User password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Description:  "User password. The value is not persisted in the state; change `password_wo_version` to update it",
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				RequiredWith: []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Description:  "The version of `password_wo`. Change it to set the user password to the current `password_wo`",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"password_wo"},
 			},
 			"status": {
 				Description:  "Accepted values `PENDING` or `CONFIRMED`",
@@ -217,7 +234,10 @@ func resourceIBMAppIDCloudDirectoryUserCreate(ctx context.Context, d *schema.Res
 	}
 
 	tenantID := d.Get("tenant_id").(string)
-	password := d.Get("password").(string)
+	password, err := getAppIDCloudDirectoryUserPassword(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	status := d.Get("status").(string)
 	active := d.Get("active").(bool)
 	createProfile := d.Get("create_profile").(bool)
@@ -285,7 +305,10 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 	}
 
 	tenantID := d.Get("tenant_id").(string)
-	password := d.Get("password").(string)
+	password, err := getAppIDCloudDirectoryUserPassword(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	status := d.Get("status").(string)
 	active := d.Get("active").(bool)
 	emails := d.Get("email").(*schema.Set)
@@ -317,9 +340,7 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 		return diag.Errorf("Error updating AppID Cloud Directory user: %s\n%s", err, resp)
 	}
 
-	if d.HasChanges("password") {
-		password = d.Get("password").(string)
-
+	if d.HasChanges("password", "password_wo_version") {
 		_, resp, err = appIDClient.ChangePasswordWithContext(ctx, &appid.ChangePasswordOptions{
 			TenantID:    &tenantID,
 			UUID:        &userID,
@@ -334,6 +355,14 @@ func resourceIBMAppIDCloudDirectoryUserUpdate(ctx context.Context, d *schema.Res
 	return resourceIBMAppIDCloudDirectoryUserRead(ctx, d, meta)
 }
 
+// getAppIDCloudDirectoryUserPassword returns the password configured in either password or password_wo
+func getAppIDCloudDirectoryUserPassword(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("password_wo_version"); ok {
+		return flex.GetWriteOnlyString(d, "password_wo")
+	}
+	return d.Get("password").(string), nil
+}
+
 func expandAppIDUserEmails(e []interface{}) []appid.CreateNewUserEmailsItem {
 	if len(e) == 0 {
 		return nil
@@ -6,9 +6,11 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMAppIDIDPFacebook() *schema.Resource {
@@ -46,10 +48,26 @@ func ResourceIBMAppIDIDPFacebook() *schema.Resource {
 							Required:    true,
 						},
 						"application_secret": {
-							Description: "Facebook application secret",
-							Type:        schema.TypeString,
-							Required:    true,
-							Sensitive:   true,
+							Description:  "Facebook application secret",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"config.0.application_secret", "config.0.application_secret_wo"},
+						},
+						"application_secret_wo": {
+							Description:  "Facebook application secret. The value is not persisted in the state; change `application_secret_wo_version` to update it",
+							Type:         schema.TypeString,
+							Optional:     true,
+							WriteOnly:    true,
+							ExactlyOneOf: []string{"config.0.application_secret", "config.0.application_secret_wo"},
+							RequiredWith: []string{"config.0.application_secret_wo_version"},
+						},
+						"application_secret_wo_version": {
+							Description:  "The version of `application_secret_wo`. Change it to apply the current `application_secret_wo`",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"config.0.application_secret_wo"},
 						},
 					},
 				},
@@ -93,7 +111,14 @@ func resourceIBMAppIDIDPFacebookRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	if fb.Config != nil {
-		if err := d.Set("config", flattenIBMAppIDFacebookIDPConfig(fb.Config)); err != nil {
+		config := flattenIBMAppIDFacebookIDPConfig(fb.Config)
+		// the secret set through application_secret_wo is kept out of the state
+		if version, ok := d.GetOk("config.0.application_secret_wo_version"); ok && len(config) > 0 {
+			mConfig := config[0].(map[string]interface{})
+			delete(mConfig, "application_secret")
+			mConfig["application_secret_wo_version"] = version
+		}
+		if err := d.Set("config", config); err != nil {
 			return diag.Errorf("Failed setting AppID Facebook IDP config: %s", err)
 		}
 	}
@@ -122,6 +147,14 @@ func resourceIBMAppIDIDPFacebookCreate(ctx context.Context, d *schema.ResourceDa
 
 	if isActive {
 		config.IDP.Config = expandAppIDFBIDPConfig(d.Get("config").([]interface{}))
+
+		if _, ok := d.GetOk("config.0.application_secret_wo_version"); ok && config.IDP.Config != nil {
+			secret, err := flex.GetWriteOnlyString(d, "config.0.application_secret_wo")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			config.IDP.Config.Secret = helpers.String(secret)
+		}
 	}
 
 	_, resp, err := appIDClient.SetFacebookIDPWithContext(ctx, config)
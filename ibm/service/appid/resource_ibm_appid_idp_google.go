@@ -6,9 +6,11 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMAppIDIDPGoogle() *schema.Resource {
@@ -46,10 +48,26 @@ func ResourceIBMAppIDIDPGoogle() *schema.Resource {
 							Required:    true,
 						},
 						"application_secret": {
-							Description: "Google application secret",
-							Type:        schema.TypeString,
-							Required:    true,
-							Sensitive:   true,
+							Description:  "Google application secret",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"config.0.application_secret", "config.0.application_secret_wo"},
+						},
+						"application_secret_wo": {
+							Description:  "Google application secret. The value is not persisted in the state; change `application_secret_wo_version` to update it",
+							Type:         schema.TypeString,
+							Optional:     true,
+							WriteOnly:    true,
+							ExactlyOneOf: []string{"config.0.application_secret", "config.0.application_secret_wo"},
+							RequiredWith: []string{"config.0.application_secret_wo_version"},
+						},
+						"application_secret_wo_version": {
+							Description:  "The version of `application_secret_wo`. Change it to apply the current `application_secret_wo`",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"config.0.application_secret_wo"},
 						},
 					},
 				},
@@ -93,7 +111,14 @@ func resourceIBMAppIDIDPGoogleRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if gg.Config != nil {
-		if err := d.Set("config", flattenIBMAppIDGoogleIDPConfig(gg.Config)); err != nil {
+		config := flattenIBMAppIDGoogleIDPConfig(gg.Config)
+		// the secret set through application_secret_wo is kept out of the state
+		if version, ok := d.GetOk("config.0.application_secret_wo_version"); ok && len(config) > 0 {
+			mConfig := config[0].(map[string]interface{})
+			delete(mConfig, "application_secret")
+			mConfig["application_secret_wo_version"] = version
+		}
+		if err := d.Set("config", config); err != nil {
 			return diag.Errorf("Failed setting AppID Google IDP config: %s", err)
 		}
 	}
@@ -122,6 +147,14 @@ func resourceIBMAppIDIDPGoogleCreate(ctx context.Context, d *schema.ResourceData
 
 	if isActive {
 		config.IDP.Config = expandAppIDGoogleIDPConfig(d.Get("config").([]interface{}))
+
+		if _, ok := d.GetOk("config.0.application_secret_wo_version"); ok && config.IDP.Config != nil {
+			secret, err := flex.GetWriteOnlyString(d, "config.0.application_secret_wo")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			config.IDP.Config.Secret = helpers.String(secret)
+		}
 	}
 
 	_, resp, err := appIDClient.SetGoogleIDPWithContext(ctx, config)
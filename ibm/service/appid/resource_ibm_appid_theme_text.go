@@ -18,6 +18,9 @@ func ResourceIBMAppIDThemeText() *schema.Resource {
 		ReadContext:   resourceIBMAppIDThemeTextRead,
 		UpdateContext: resourceIBMAppIDThemeTextUpdate,
 		DeleteContext: resourceIBMAppIDThemeTextDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Type:        schema.TypeString,
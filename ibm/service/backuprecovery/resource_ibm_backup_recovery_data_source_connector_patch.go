@@ -28,7 +28,9 @@ func ResourceIbmBackupRecoveryDataSourceConnectorPatch() *schema.Resource {
 		ReadContext:   resourceIbmBackupRecoveryDataSourceConnectorPatchRead,
 		DeleteContext: resourceIbmBackupRecoveryDataSourceConnectorPatchDelete,
 		UpdateContext: resourceIbmBackupRecoveryDataSourceConnectorPatchUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "connector_id"),
+		},
 		CustomizeDiff: checkDiffResourceIbmBackupRecoveryDataSourceConnectorPatch,
 		Schema: map[string]*schema.Schema{
 			"connector_id": &schema.Schema{
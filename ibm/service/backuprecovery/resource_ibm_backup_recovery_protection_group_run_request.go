@@ -27,7 +27,9 @@ func ResourceIbmBackupRecoveryProtectionGroupRunRequest() *schema.Resource {
 		ReadContext:   resourceIbmBackupRecoveryProtectionGroupRunRequestRead,
 		DeleteContext: resourceIbmBackupRecoveryProtectionGroupRunRequestDelete,
 		UpdateContext: resourceIbmBackupRecoveryProtectionGroupRunRequestUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "group_id"),
+		},
 		CustomizeDiff: checkDiffResourceIbmBackupRecoveryProtectionGroupRun,
 		Schema: map[string]*schema.Schema{
 			"x_ibm_tenant_id": &schema.Schema{
@@ -29,7 +29,9 @@ func ResourceIbmBackupRecoveryUpdateProtectionGroupRunRequest() *schema.Resource
 		DeleteContext: resourceIbmBackupRecoveryUpdateProtectionGroupRunRequestDelete,
 		UpdateContext: resourceIbmBackupRecoveryUpdateProtectionGroupRunRequestUpdate,
 		CustomizeDiff: checkDiffResourceIbmBackupRecoveryUpdateProtectionGroupRunRequest,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "group_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"x_ibm_tenant_id": &schema.Schema{
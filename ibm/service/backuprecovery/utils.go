@@ -42,11 +42,9 @@ func getClientWithInstanceEndpoint(originalClient *backuprecoveryv1.BackupRecove
 	domain := "cloud.ibm.com"
 	serviceName := "backup-recovery"
 
-	endpointsFile := bmxsession.Config.EndpointsFile
-
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		iamUrl = conns.ServiceEndpoint(bmxsession, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
 	}
 
 	if strings.Contains(iamUrl, "test") {
@@ -158,11 +156,10 @@ func getManagerClientWithInstanceEndpoint(originalClient *backuprecoveryv1.Backu
 
 	domain := "cloud.ibm.com"
 	serviceName := "backup-recovery"
-	endpointsFile := bmxsession.Config.EndpointsFile
 
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		iamUrl = conns.ServiceEndpoint(bmxsession, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
 	}
 	if strings.Contains(iamUrl, "test") {
 		domain = "test.cloud.ibm.com"
@@ -27,7 +27,9 @@ func ResourceIBMCmVersion() *schema.Resource {
 		ReadContext:   resourceIBMCmVersionRead,
 		UpdateContext: resourceIBMCmVersionUpdate,
 		DeleteContext: resourceIBMCmVersionDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCmVersionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"catalog_id": &schema.Schema{
@@ -2082,6 +2084,28 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	return resourceIBMCmVersionRead(context, d, meta)
 }
 
+// resourceIBMCmVersionImport sets the offering the version was imported to,
+// which read leaves alone as it cannot change without a new version.
+func resourceIBMCmVersionImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return nil, err
+	}
+
+	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
+	getVersionOptions.SetVersionLocID(strings.Replace(d.Id(), "/", ".", 1))
+
+	offering, response, err := catalogManagementClient.GetVersionWithContext(context, getVersionOptions)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetVersionWithContext failed %s\n%s", err, response)
+	}
+
+	if len(offering.Kinds) > 0 && len(offering.Kinds[0].Versions) > 0 {
+		d.Set("offering_id", offering.Kinds[0].Versions[0].OfferingID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMCmVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
@@ -203,7 +203,7 @@ func dataSourceIBMCdToolchainToolAppconfigRead(context context.Context, d *schem
 		"environment_id":      "environment-name",
 		"collection_id":       "collection-name",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolAppconfig(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolAppconfig(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_appconfig", "read", "set-parameters").GetDiag()
@@ -217,7 +217,7 @@ func dataSourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *sch
 	}
 
 	parameters := []map[string]interface{}{}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolArtifactory(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolArtifactory(), nil)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_artifactory", "read", "set-parameters").GetDiag()
@@ -239,7 +239,7 @@ func dataSourceIBMCdToolchainToolBitbucketgitRead(context context.Context, d *sc
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolBitbucketgit(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolBitbucketgit(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_bitbucketgit", "read", "set-parameters").GetDiag()
@@ -209,7 +209,7 @@ func dataSourceIBMCdToolchainToolCosRead(context context.Context, d *schema.Reso
 	}
 
 	parameters := []map[string]interface{}{}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolCos(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolCos(), nil)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_cos", "read", "set-parameters").GetDiag()
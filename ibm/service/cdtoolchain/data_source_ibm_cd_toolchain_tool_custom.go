@@ -212,7 +212,7 @@ func dataSourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.R
 		"documentation_url":     "documentationUrl",
 		"additional_properties": "additional-properties",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolCustom(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolCustom(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_custom", "read", "set-parameters").GetDiag()
@@ -179,7 +179,7 @@ func dataSourceIBMCdToolchainToolEventnotificationsRead(context context.Context,
 	remapFields := map[string]string{
 		"instance_crn": "instance-crn",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolEventnotifications(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolEventnotifications(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_eventnotifications", "read", "set-parameters").GetDiag()
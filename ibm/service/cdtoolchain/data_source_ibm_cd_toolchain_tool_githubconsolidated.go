@@ -270,7 +270,7 @@ func dataSourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context,
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolGithubconsolidated(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_githubconsolidated", "read", "set-parameters").GetDiag()
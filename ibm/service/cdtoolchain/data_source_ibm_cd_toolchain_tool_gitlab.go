@@ -265,7 +265,7 @@ func dataSourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.R
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolGitlab(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolGitlab(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_gitlab", "read", "set-parameters").GetDiag()
@@ -230,7 +230,7 @@ func dataSourceIBMCdToolchainToolHashicorpvaultRead(context context.Context, d *
 	}
 
 	parameters := []map[string]interface{}{}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolHashicorpvault(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolHashicorpvault(), nil)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_hashicorpvault", "read", "set-parameters").GetDiag()
@@ -250,7 +250,7 @@ func dataSourceIBMCdToolchainToolHostedgitRead(context context.Context, d *schem
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolHostedgit(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolHostedgit(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_hostedgit", "read", "set-parameters").GetDiag()
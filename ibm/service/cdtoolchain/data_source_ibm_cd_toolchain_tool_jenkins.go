@@ -193,7 +193,7 @@ func dataSourceIBMCdToolchainToolJenkinsRead(context context.Context, d *schema.
 	}
 
 	parameters := []map[string]interface{}{}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolJenkins(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolJenkins(), nil)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_jenkins", "read", "set-parameters").GetDiag()
@@ -195,7 +195,7 @@ func dataSourceIBMCdToolchainToolJiraRead(context context.Context, d *schema.Res
 	remapFields := map[string]string{
 		"api_token": "password",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolJira(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolJira(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_jira", "read", "set-parameters").GetDiag()
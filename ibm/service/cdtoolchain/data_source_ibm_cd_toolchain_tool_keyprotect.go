@@ -191,7 +191,7 @@ func dataSourceIBMCdToolchainToolKeyprotectRead(context context.Context, d *sche
 		"resource_group_name": "resource-group",
 		"instance_name":       "instance-name",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolKeyprotect(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolKeyprotect(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_keyprotect", "read", "set-parameters").GetDiag()
@@ -210,7 +210,7 @@ func dataSourceIBMCdToolchainToolNexusRead(context context.Context, d *schema.Re
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolNexus(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolNexus(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_nexus", "read", "set-parameters").GetDiag()
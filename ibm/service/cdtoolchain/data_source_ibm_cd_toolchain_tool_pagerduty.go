@@ -182,7 +182,7 @@ func dataSourceIBMCdToolchainToolPagerdutyRead(context context.Context, d *schem
 	}
 
 	parameters := []map[string]interface{}{}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolPagerduty(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolPagerduty(), nil)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_pagerduty", "read", "set-parameters").GetDiag()
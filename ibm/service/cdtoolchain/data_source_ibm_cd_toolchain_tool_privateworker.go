@@ -186,7 +186,7 @@ func dataSourceIBMCdToolchainToolPrivateworkerRead(context context.Context, d *s
 		"worker_queue_credentials": "workerQueueCredentials",
 		"worker_queue_identifier":  "workerQueueIdentifier",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolPrivateworker(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolPrivateworker(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_privateworker", "read", "set-parameters").GetDiag()
@@ -180,7 +180,7 @@ func dataSourceIBMCdToolchainToolSaucelabsRead(context context.Context, d *schem
 	remapFields := map[string]string{
 		"access_key": "key",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolSaucelabs(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolSaucelabs(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_saucelabs", "read", "set-parameters").GetDiag()
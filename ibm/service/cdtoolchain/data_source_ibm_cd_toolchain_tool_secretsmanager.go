@@ -203,7 +203,7 @@ func dataSourceIBMCdToolchainToolSecretsmanagerRead(context context.Context, d *
 		"instance_name":       "instance-name",
 		"instance_crn":        "instance-crn",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolSecretsmanager(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolSecretsmanager(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_secretsmanager", "read", "set-parameters").GetDiag()
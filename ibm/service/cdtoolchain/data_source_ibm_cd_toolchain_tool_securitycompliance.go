@@ -225,7 +225,7 @@ func dataSourceIBMCdToolchainToolSecuritycomplianceRead(context context.Context,
 	remapFields := map[string]string{
 		"evidence_repo_url": "evidence_repo_name",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolSecuritycompliance(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolSecuritycompliance(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_securitycompliance", "read", "set-parameters").GetDiag()
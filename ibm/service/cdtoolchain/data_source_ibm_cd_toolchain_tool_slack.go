@@ -211,7 +211,7 @@ func dataSourceIBMCdToolchainToolSlackRead(context context.Context, d *schema.Re
 		"webhook":   "api_token",
 		"team_name": "team_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolSlack(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolSlack(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_slack", "read", "set-parameters").GetDiag()
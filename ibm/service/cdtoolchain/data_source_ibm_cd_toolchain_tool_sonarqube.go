@@ -195,7 +195,7 @@ func dataSourceIBMCdToolchainToolSonarqubeRead(context context.Context, d *schem
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, DataSourceIBMCdToolchainToolSonarqube(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, DataSourceIBMCdToolchainToolSonarqube(), remapFields)
 	parameters = append(parameters, parametersMap)
 	if err = d.Set("parameters", parameters); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting parameters: %s", err), "(Data) ibm_cd_toolchain_tool_sonarqube", "read", "set-parameters").GetDiag()
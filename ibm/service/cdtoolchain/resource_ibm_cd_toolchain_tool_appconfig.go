@@ -193,7 +193,12 @@ func resourceIBMCdToolchainToolAppconfigCreate(context context.Context, d *schem
 		"environment_id":      "environment-name",
 		"collection_id":       "collection-name",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolAppconfig(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolAppconfig(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_appconfig", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -271,7 +276,7 @@ func resourceIBMCdToolchainToolAppconfigRead(context context.Context, d *schema.
 		"environment_id":      "environment-name",
 		"collection_id":       "collection-name",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolAppconfig(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolAppconfig(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_appconfig", "read", "set-parameters").GetDiag()
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolArtifactoryCreate,
 		ReadContext:   resourceIBMCdToolchainToolArtifactoryRead,
 		UpdateContext: resourceIBMCdToolchainToolArtifactoryUpdate,
@@ -166,7 +166,7 @@ func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolArtifactoryValidator() *validate.ResourceValidator {
@@ -208,7 +208,12 @@ func resourceIBMCdToolchainToolArtifactoryCreate(context context.Context, d *sch
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("artifactory")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolArtifactory(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolArtifactory(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_artifactory", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -279,7 +284,7 @@ func resourceIBMCdToolchainToolArtifactoryRead(context context.Context, d *schem
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_artifactory", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolArtifactory(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolArtifactory(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_artifactory", "read", "set-parameters").GetDiag()
@@ -366,6 +371,12 @@ func resourceIBMCdToolchainToolArtifactoryUpdate(context context.Context, d *sch
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolArtifactoryToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolArtifactory(), nil)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_artifactory", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -296,7 +296,12 @@ func resourceIBMCdToolchainToolBitbucketgitCreate(context context.Context, d *sc
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolBitbucketgit(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolBitbucketgit(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_bitbucketgit", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -370,7 +375,7 @@ func resourceIBMCdToolchainToolBitbucketgitRead(context context.Context, d *sche
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolBitbucketgit(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolBitbucketgit(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_bitbucketgit", "read", "set-parameters").GetDiag()
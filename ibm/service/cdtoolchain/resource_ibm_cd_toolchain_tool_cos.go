@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolCos() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolCosCreate,
 		ReadContext:   resourceIBMCdToolchainToolCosRead,
 		UpdateContext: resourceIBMCdToolchainToolCosUpdate,
@@ -160,7 +160,7 @@ func ResourceIBMCdToolchainToolCos() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolCosValidator() *validate.ResourceValidator {
@@ -202,7 +202,12 @@ func resourceIBMCdToolchainToolCosCreate(context context.Context, d *schema.Reso
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("cloudobjectstorage")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolCos(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolCos(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_cos", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -273,7 +278,7 @@ func resourceIBMCdToolchainToolCosRead(context context.Context, d *schema.Resour
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_cos", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolCos(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolCos(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_cos", "read", "set-parameters").GetDiag()
@@ -360,6 +365,12 @@ func resourceIBMCdToolchainToolCosUpdate(context context.Context, d *schema.Reso
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolCosToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolCos(), nil)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_cos", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
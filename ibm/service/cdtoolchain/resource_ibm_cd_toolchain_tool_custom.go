@@ -202,7 +202,12 @@ func resourceIBMCdToolchainToolCustomCreate(context context.Context, d *schema.R
 		"documentation_url":     "documentationUrl",
 		"additional_properties": "additional-properties",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolCustom(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolCustom(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_custom", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -279,7 +284,7 @@ func resourceIBMCdToolchainToolCustomRead(context context.Context, d *schema.Res
 		"documentation_url":     "documentationUrl",
 		"additional_properties": "additional-properties",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolCustom(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolCustom(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_custom", "read", "set-parameters").GetDiag()
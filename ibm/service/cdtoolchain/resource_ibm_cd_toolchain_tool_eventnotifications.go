@@ -169,7 +169,12 @@ func resourceIBMCdToolchainToolEventnotificationsCreate(context context.Context,
 	remapFields := map[string]string{
 		"instance_crn": "instance-crn",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolEventnotifications(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolEventnotifications(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_eventnotifications", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -243,7 +248,7 @@ func resourceIBMCdToolchainToolEventnotificationsRead(context context.Context, d
 	remapFields := map[string]string{
 		"instance_crn": "instance-crn",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolEventnotifications(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolEventnotifications(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_eventnotifications", "read", "set-parameters").GetDiag()
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolGithubconsolidatedCreate,
 		ReadContext:   resourceIBMCdToolchainToolGithubconsolidatedRead,
 		UpdateContext: resourceIBMCdToolchainToolGithubconsolidatedUpdate,
@@ -313,7 +313,7 @@ func ResourceIBMCdToolchainToolGithubconsolidated() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolGithubconsolidatedValidator() *validate.ResourceValidator {
@@ -358,7 +358,12 @@ func resourceIBMCdToolchainToolGithubconsolidatedCreate(context context.Context,
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_githubconsolidated", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -432,7 +437,7 @@ func resourceIBMCdToolchainToolGithubconsolidatedRead(context context.Context, d
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_githubconsolidated", "read", "set-parameters").GetDiag()
@@ -508,10 +513,10 @@ func resourceIBMCdToolchainToolGithubconsolidatedUpdate(context context.Context,
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"toolchain_issues_enabled": "has_issues",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"toolchain_issues_enabled": "has_issues",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -522,6 +527,12 @@ func resourceIBMCdToolchainToolGithubconsolidatedUpdate(context context.Context,
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolGithubconsolidatedToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolGithubconsolidated(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_githubconsolidated", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
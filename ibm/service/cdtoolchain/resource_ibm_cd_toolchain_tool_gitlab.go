@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolGitlabCreate,
 		ReadContext:   resourceIBMCdToolchainToolGitlabRead,
 		UpdateContext: resourceIBMCdToolchainToolGitlabUpdate,
@@ -300,7 +300,7 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolGitlabValidator() *validate.ResourceValidator {
@@ -345,7 +345,12 @@ func resourceIBMCdToolchainToolGitlabCreate(context context.Context, d *schema.R
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolGitlab(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolGitlab(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_gitlab", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -419,7 +424,7 @@ func resourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.Res
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolGitlab(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolGitlab(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_gitlab", "read", "set-parameters").GetDiag()
@@ -495,10 +500,10 @@ func resourceIBMCdToolchainToolGitlabUpdate(context context.Context, d *schema.R
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"toolchain_issues_enabled": "has_issues",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"toolchain_issues_enabled": "has_issues",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolGitlab(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -509,6 +514,12 @@ func resourceIBMCdToolchainToolGitlabUpdate(context context.Context, d *schema.R
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolGitlabToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolGitlab(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_gitlab", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolHashicorpvault() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolHashicorpvaultCreate,
 		ReadContext:   resourceIBMCdToolchainToolHashicorpvaultRead,
 		UpdateContext: resourceIBMCdToolchainToolHashicorpvaultUpdate,
@@ -182,7 +182,7 @@ func ResourceIBMCdToolchainToolHashicorpvault() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolHashicorpvaultValidator() *validate.ResourceValidator {
@@ -224,7 +224,12 @@ func resourceIBMCdToolchainToolHashicorpvaultCreate(context context.Context, d *
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("hashicorpvault")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolHashicorpvault(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolHashicorpvault(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hashicorpvault", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -295,7 +300,7 @@ func resourceIBMCdToolchainToolHashicorpvaultRead(context context.Context, d *sc
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hashicorpvault", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolHashicorpvault(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolHashicorpvault(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hashicorpvault", "read", "set-parameters").GetDiag()
@@ -382,6 +387,12 @@ func resourceIBMCdToolchainToolHashicorpvaultUpdate(context context.Context, d *
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolHashicorpvaultToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolHashicorpvault(), nil)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hashicorpvault", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolHostedgit() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolHostedgitCreate,
 		ReadContext:   resourceIBMCdToolchainToolHostedgitRead,
 		UpdateContext: resourceIBMCdToolchainToolHostedgitUpdate,
@@ -263,7 +263,7 @@ func ResourceIBMCdToolchainToolHostedgit() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolHostedgitValidator() *validate.ResourceValidator {
@@ -308,7 +308,12 @@ func resourceIBMCdToolchainToolHostedgitCreate(context context.Context, d *schem
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolHostedgit(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolHostedgit(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hostedgit", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -382,7 +387,7 @@ func resourceIBMCdToolchainToolHostedgitRead(context context.Context, d *schema.
 	remapFields := map[string]string{
 		"toolchain_issues_enabled": "has_issues",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolHostedgit(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolHostedgit(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hostedgit", "read", "set-parameters").GetDiag()
@@ -458,10 +463,10 @@ func resourceIBMCdToolchainToolHostedgitUpdate(context context.Context, d *schem
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"toolchain_issues_enabled": "has_issues",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"toolchain_issues_enabled": "has_issues",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolHostedgit(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -472,6 +477,12 @@ func resourceIBMCdToolchainToolHostedgitUpdate(context context.Context, d *schem
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolHostedgitToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolHostedgit(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_hostedgit", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolJenkins() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolJenkinsCreate,
 		ReadContext:   resourceIBMCdToolchainToolJenkinsRead,
 		UpdateContext: resourceIBMCdToolchainToolJenkinsUpdate,
@@ -143,7 +143,7 @@ func ResourceIBMCdToolchainToolJenkins() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolJenkinsValidator() *validate.ResourceValidator {
@@ -185,7 +185,12 @@ func resourceIBMCdToolchainToolJenkinsCreate(context context.Context, d *schema.
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("jenkins")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolJenkins(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolJenkins(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jenkins", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -256,7 +261,7 @@ func resourceIBMCdToolchainToolJenkinsRead(context context.Context, d *schema.Re
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jenkins", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolJenkins(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolJenkins(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jenkins", "read", "set-parameters").GetDiag()
@@ -343,6 +348,12 @@ func resourceIBMCdToolchainToolJenkinsUpdate(context context.Context, d *schema.
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolJenkinsToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolJenkins(), nil)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jenkins", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolJira() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolJiraCreate,
 		ReadContext:   resourceIBMCdToolchainToolJiraRead,
 		UpdateContext: resourceIBMCdToolchainToolJiraUpdate,
@@ -142,7 +142,7 @@ func ResourceIBMCdToolchainToolJira() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolJiraValidator() *validate.ResourceValidator {
@@ -187,7 +187,12 @@ func resourceIBMCdToolchainToolJiraCreate(context context.Context, d *schema.Res
 	remapFields := map[string]string{
 		"api_token": "password",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolJira(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolJira(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jira", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	parametersModel["type"] = "existing"
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
@@ -262,7 +267,7 @@ func resourceIBMCdToolchainToolJiraRead(context context.Context, d *schema.Resou
 	remapFields := map[string]string{
 		"api_token": "password",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolJira(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolJira(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jira", "read", "set-parameters").GetDiag()
@@ -338,10 +343,10 @@ func resourceIBMCdToolchainToolJiraUpdate(context context.Context, d *schema.Res
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"api_token": "password",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"api_token": "password",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolJira(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -352,6 +357,12 @@ func resourceIBMCdToolchainToolJiraUpdate(context context.Context, d *schema.Res
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolJiraToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolJira(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jira", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolNexus() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolNexusCreate,
 		ReadContext:   resourceIBMCdToolchainToolNexusRead,
 		UpdateContext: resourceIBMCdToolchainToolNexusUpdate,
@@ -156,7 +156,7 @@ func ResourceIBMCdToolchainToolNexus() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolNexusValidator() *validate.ResourceValidator {
@@ -201,7 +201,12 @@ func resourceIBMCdToolchainToolNexusCreate(context context.Context, d *schema.Re
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolNexus(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolNexus(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_nexus", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -275,7 +280,7 @@ func resourceIBMCdToolchainToolNexusRead(context context.Context, d *schema.Reso
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolNexus(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolNexus(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_nexus", "read", "set-parameters").GetDiag()
@@ -351,10 +356,10 @@ func resourceIBMCdToolchainToolNexusUpdate(context context.Context, d *schema.Re
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"server_url": "dashboard_url",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"server_url": "dashboard_url",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolNexus(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -365,6 +370,12 @@ func resourceIBMCdToolchainToolNexusUpdate(context context.Context, d *schema.Re
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolNexusToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolNexus(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_nexus", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
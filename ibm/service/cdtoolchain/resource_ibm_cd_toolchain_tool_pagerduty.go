@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolPagerduty() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolPagerdutyCreate,
 		ReadContext:   resourceIBMCdToolchainToolPagerdutyRead,
 		UpdateContext: resourceIBMCdToolchainToolPagerdutyUpdate,
@@ -132,7 +132,7 @@ func ResourceIBMCdToolchainToolPagerduty() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolPagerdutyValidator() *validate.ResourceValidator {
@@ -174,7 +174,12 @@ func resourceIBMCdToolchainToolPagerdutyCreate(context context.Context, d *schem
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("pagerduty")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolPagerduty(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolPagerduty(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pagerduty", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	parametersModel["key_type"] = "service"
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
@@ -246,7 +251,7 @@ func resourceIBMCdToolchainToolPagerdutyRead(context context.Context, d *schema.
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pagerduty", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolPagerduty(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolPagerduty(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pagerduty", "read", "set-parameters").GetDiag()
@@ -333,6 +338,12 @@ func resourceIBMCdToolchainToolPagerdutyUpdate(context context.Context, d *schem
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolPagerdutyToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolPagerduty(), nil)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pagerduty", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
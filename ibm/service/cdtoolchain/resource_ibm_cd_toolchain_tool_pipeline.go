@@ -161,7 +161,12 @@ func resourceIBMCdToolchainToolPipelineCreate(context context.Context, d *schema
 
 	createToolOptions.SetToolchainID(d.Get("toolchain_id").(string))
 	createToolOptions.SetToolTypeID("pipeline")
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolPipeline(), nil)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolPipeline(), nil)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pipeline", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	parametersModel["type"] = "tekton"
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
@@ -233,7 +238,7 @@ func resourceIBMCdToolchainToolPipelineRead(context context.Context, d *schema.R
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pipeline", "read", "set-name").GetDiag()
 		}
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolPipeline(), nil)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolPipeline(), nil)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_pipeline", "read", "set-parameters").GetDiag()
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolPrivateworker() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolPrivateworkerCreate,
 		ReadContext:   resourceIBMCdToolchainToolPrivateworkerRead,
 		UpdateContext: resourceIBMCdToolchainToolPrivateworkerUpdate,
@@ -132,7 +132,7 @@ func ResourceIBMCdToolchainToolPrivateworker() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolPrivateworkerValidator() *validate.ResourceValidator {
@@ -178,7 +178,12 @@ func resourceIBMCdToolchainToolPrivateworkerCreate(context context.Context, d *s
 		"worker_queue_credentials": "workerQueueCredentials",
 		"worker_queue_identifier":  "workerQueueIdentifier",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_privateworker", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -253,7 +258,7 @@ func resourceIBMCdToolchainToolPrivateworkerRead(context context.Context, d *sch
 		"worker_queue_credentials": "workerQueueCredentials",
 		"worker_queue_identifier":  "workerQueueIdentifier",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_privateworker", "read", "set-parameters").GetDiag()
@@ -329,11 +334,11 @@ func resourceIBMCdToolchainToolPrivateworkerUpdate(context context.Context, d *s
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"worker_queue_credentials": "workerQueueCredentials",
+		"worker_queue_identifier":  "workerQueueIdentifier",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"worker_queue_credentials": "workerQueueCredentials",
-			"worker_queue_identifier":  "workerQueueIdentifier",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -344,6 +349,12 @@ func resourceIBMCdToolchainToolPrivateworkerUpdate(context context.Context, d *s
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolPrivateworkerToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolPrivateworker(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_privateworker", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolSaucelabs() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolSaucelabsCreate,
 		ReadContext:   resourceIBMCdToolchainToolSaucelabsRead,
 		UpdateContext: resourceIBMCdToolchainToolSaucelabsUpdate,
@@ -126,7 +126,7 @@ func ResourceIBMCdToolchainToolSaucelabs() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolSaucelabsValidator() *validate.ResourceValidator {
@@ -171,7 +171,12 @@ func resourceIBMCdToolchainToolSaucelabsCreate(context context.Context, d *schem
 	remapFields := map[string]string{
 		"access_key": "key",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_saucelabs", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -245,7 +250,7 @@ func resourceIBMCdToolchainToolSaucelabsRead(context context.Context, d *schema.
 	remapFields := map[string]string{
 		"access_key": "key",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_saucelabs", "read", "set-parameters").GetDiag()
@@ -321,10 +326,10 @@ func resourceIBMCdToolchainToolSaucelabsUpdate(context context.Context, d *schem
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"access_key": "key",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"access_key": "key",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -335,6 +340,12 @@ func resourceIBMCdToolchainToolSaucelabsUpdate(context context.Context, d *schem
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolSaucelabsToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolSaucelabs(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_saucelabs", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolSecuritycompliance() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolSecuritycomplianceCreate,
 		ReadContext:   resourceIBMCdToolchainToolSecuritycomplianceRead,
 		UpdateContext: resourceIBMCdToolchainToolSecuritycomplianceUpdate,
@@ -171,7 +171,7 @@ func ResourceIBMCdToolchainToolSecuritycompliance() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolSecuritycomplianceValidator() *validate.ResourceValidator {
@@ -216,7 +216,12 @@ func resourceIBMCdToolchainToolSecuritycomplianceCreate(context context.Context,
 	remapFields := map[string]string{
 		"evidence_repo_url": "evidence_repo_name",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_securitycompliance", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -290,7 +295,7 @@ func resourceIBMCdToolchainToolSecuritycomplianceRead(context context.Context, d
 	remapFields := map[string]string{
 		"evidence_repo_url": "evidence_repo_name",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_securitycompliance", "read", "set-parameters").GetDiag()
@@ -366,10 +371,10 @@ func resourceIBMCdToolchainToolSecuritycomplianceUpdate(context context.Context,
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"evidence_repo_url": "evidence_repo_name",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"evidence_repo_url": "evidence_repo_name",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -380,6 +385,12 @@ func resourceIBMCdToolchainToolSecuritycomplianceUpdate(context context.Context,
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolSecuritycomplianceToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolSecuritycompliance(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_securitycompliance", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
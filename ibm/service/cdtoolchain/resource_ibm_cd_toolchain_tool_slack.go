@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolSlack() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolSlackCreate,
 		ReadContext:   resourceIBMCdToolchainToolSlackRead,
 		UpdateContext: resourceIBMCdToolchainToolSlackUpdate,
@@ -161,7 +161,7 @@ func ResourceIBMCdToolchainToolSlack() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolSlackValidator() *validate.ResourceValidator {
@@ -207,7 +207,12 @@ func resourceIBMCdToolchainToolSlackCreate(context context.Context, d *schema.Re
 		"webhook":   "api_token",
 		"team_name": "team_url",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolSlack(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolSlack(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_slack", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -282,7 +287,7 @@ func resourceIBMCdToolchainToolSlackRead(context context.Context, d *schema.Reso
 		"webhook":   "api_token",
 		"team_name": "team_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolSlack(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolSlack(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_slack", "read", "set-parameters").GetDiag()
@@ -358,11 +363,11 @@ func resourceIBMCdToolchainToolSlackUpdate(context context.Context, d *schema.Re
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"webhook":   "api_token",
+		"team_name": "team_url",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"webhook":   "api_token",
-			"team_name": "team_url",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolSlack(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -373,6 +378,12 @@ func resourceIBMCdToolchainToolSlackUpdate(context context.Context, d *schema.Re
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolSlackToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolSlack(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_slack", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
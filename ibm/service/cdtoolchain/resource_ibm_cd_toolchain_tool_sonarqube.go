@@ -25,7 +25,7 @@ import (
 )
 
 func ResourceIBMCdToolchainToolSonarqube() *schema.Resource {
-	return &schema.Resource{
+	return AddWriteOnlyParameters(&schema.Resource{
 		CreateContext: resourceIBMCdToolchainToolSonarqubeCreate,
 		ReadContext:   resourceIBMCdToolchainToolSonarqubeRead,
 		UpdateContext: resourceIBMCdToolchainToolSonarqubeUpdate,
@@ -142,7 +142,7 @@ func ResourceIBMCdToolchainToolSonarqube() *schema.Resource {
 				Description: "Tool ID.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMCdToolchainToolSonarqubeValidator() *validate.ResourceValidator {
@@ -187,7 +187,12 @@ func resourceIBMCdToolchainToolSonarqubeCreate(context context.Context, d *schem
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersModel := GetParametersForCreate(d, ResourceIBMCdToolchainToolSonarqube(), remapFields)
+	parametersModel, err := GetParametersForCreate(d, ResourceIBMCdToolchainToolSonarqube(), remapFields)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_sonarqube", "create", "parse-parameters")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
 	createToolOptions.SetParameters(parametersModel)
 	if _, ok := d.GetOk("name"); ok {
 		createToolOptions.SetName(d.Get("name").(string))
@@ -261,7 +266,7 @@ func resourceIBMCdToolchainToolSonarqubeRead(context context.Context, d *schema.
 	remapFields := map[string]string{
 		"server_url": "dashboard_url",
 	}
-	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolSonarqube(), remapFields)
+	parametersMap := GetParametersFromRead(d, toolchainTool.Parameters, ResourceIBMCdToolchainToolSonarqube(), remapFields)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_sonarqube", "read", "set-parameters").GetDiag()
@@ -337,10 +342,10 @@ func resourceIBMCdToolchainToolSonarqubeUpdate(context context.Context, d *schem
 		patchVals.Name = &newName
 		hasChange = true
 	}
+	remapFields := map[string]string{
+		"server_url": "dashboard_url",
+	}
 	if d.HasChange("parameters") {
-		remapFields := map[string]string{
-			"server_url": "dashboard_url",
-		}
 		parameters := GetParametersForUpdate(d, ResourceIBMCdToolchainToolSonarqube(), remapFields)
 		patchVals.Parameters = parameters
 		hasChange = true
@@ -351,6 +356,12 @@ func resourceIBMCdToolchainToolSonarqubeUpdate(context context.Context, d *schem
 		// so we need to re-add them to support removing arguments
 		// in merge-patch operations sent to the service.
 		updateToolOptions.ToolchainToolPrototypePatch = ResourceIBMCdToolchainToolSonarqubeToolchainToolPrototypePatchAsPatch(patchVals, d)
+		err = SetWriteOnlyParametersPatch(d, updateToolOptions.ToolchainToolPrototypePatch, ResourceIBMCdToolchainToolSonarqube(), remapFields)
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_sonarqube", "update", "parse-parameters")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
 
 		_, _, err = cdToolchainClient.UpdateToolWithContext(context, updateToolOptions)
 		if err != nil {
@@ -1,20 +1,124 @@
 package cdtoolchain
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
 )
 
-func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
+// AddWriteOnlyParameters adds a write-only variant, <key>_wo, and its
+// companion <key>_wo_version to every sensitive parameter of a tool, so that
+// secrets can be set without being stored in the state. A required parameter
+// becomes optional, and exactly one of it and its variant must be set.
+func AddWriteOnlyParameters(resource *schema.Resource) *schema.Resource {
+	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
+	keys := make([]string, 0, len(parametersSchema))
+	for key := range parametersSchema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		element := parametersSchema[key]
+		if !element.Sensitive || element.Computed || element.Type != schema.TypeString {
+			continue
+		}
+		woKey := key + writeOnlySuffix
+		versionKey := key + writeOnlyVersionSuffix
+		woSchema := &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			RequiredWith: []string{"parameters.0." + versionKey},
+			Description:  fmt.Sprintf("Write-only variant of `%s`. The value is not persisted in the state; change `%s` to update it.", key, versionKey),
+		}
+		if element.Required {
+			element.Required = false
+			element.Optional = true
+			element.ExactlyOneOf = []string{"parameters.0." + key, "parameters.0." + woKey}
+			woSchema.ExactlyOneOf = element.ExactlyOneOf
+		} else {
+			element.ConflictsWith = append(element.ConflictsWith, "parameters.0."+woKey)
+			woSchema.ConflictsWith = []string{"parameters.0." + key}
+		}
+		parametersSchema[woKey] = woSchema
+		parametersSchema[versionKey] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"parameters.0." + woKey},
+			Description:  fmt.Sprintf("The version of `%s`. Change it to send the current value of `%s` to the tool.", woKey, woKey),
+		}
+	}
+	return resource
+}
+
+// isWriteOnlyParameter reports whether key is one of the attributes added by
+// AddWriteOnlyParameters.
+func isWriteOnlyParameter(key string, parametersSchema map[string]*schema.Schema) bool {
+	if strings.HasSuffix(key, writeOnlyVersionSuffix) {
+		_, ok := parametersSchema[strings.TrimSuffix(key, writeOnlyVersionSuffix)+writeOnlySuffix]
+		return ok
+	}
+	return parametersSchema[key] != nil && parametersSchema[key].WriteOnly
+}
+
+// getWriteOnlyParameters returns the write-only parameters whose version is
+// set, keyed by the name of the parameter they replace. When onlyChanged is
+// true, only the parameters whose version changed are returned.
+func getWriteOnlyParameters(d *schema.ResourceData, parametersSchema map[string]*schema.Schema, onlyChanged bool) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	for key, element := range parametersSchema {
+		if !element.WriteOnly {
+			continue
+		}
+		baseKey := strings.TrimSuffix(key, writeOnlySuffix)
+		versionKey := "parameters.0." + baseKey + writeOnlyVersionSuffix
+		if _, ok := d.GetOk(versionKey); !ok {
+			continue
+		}
+		if onlyChanged && !d.HasChange(versionKey) {
+			continue
+		}
+		value, err := flex.GetWriteOnlyString(d, "parameters.0."+key)
+		if err != nil {
+			return nil, err
+		}
+		params[baseKey] = value
+	}
+	return params, nil
+}
+
+func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, remapFields map[string]string) (map[string]interface{}, error) {
 	params := make(map[string]interface{})
 
 	if _, ok := d.GetOk("parameters"); ok {
 		srcParams := d.Get("parameters.0").(map[string]interface{})
 		parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
 		for key, element := range parametersSchema {
+			if isWriteOnlyParameter(key, parametersSchema) {
+				continue
+			}
 			if !element.Computed && srcParams[key] != nil {
 				params[getTargetField(key, remapFields)] = srcParams[key]
 			}
 		}
+		writeOnlyParams, err := getWriteOnlyParameters(d, parametersSchema, false)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range writeOnlyParams {
+			params[getTargetField(key, remapFields)] = value
+		}
 	}
 
 	if _, ok := d.GetOk("initialization"); ok {
@@ -27,7 +131,7 @@ func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, r
 		}
 	}
 
-	return params
+	return params, nil
 }
 
 func GetParametersForUpdate(d *schema.ResourceData, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
@@ -35,6 +139,9 @@ func GetParametersForUpdate(d *schema.ResourceData, resource *schema.Resource, r
 	srcParams := d.Get("parameters.0").(map[string]interface{})
 	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
 	for key, element := range parametersSchema {
+		if isWriteOnlyParameter(key, parametersSchema) {
+			continue
+		}
 		if !element.Computed && srcParams[key] != nil && d.HasChange("parameters.0."+key) {
 			params[getTargetField(key, remapFields)] = srcParams[key]
 		}
@@ -42,10 +149,42 @@ func GetParametersForUpdate(d *schema.ResourceData, resource *schema.Resource, r
 	return params
 }
 
-func GetParametersFromRead(readParams map[string]interface{}, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
+// SetWriteOnlyParametersPatch adds the write-only parameters whose version
+// changed to a tool patch. It is applied last, so that the values are not
+// dropped when switching a parameter to its write-only variant.
+func SetWriteOnlyParametersPatch(d *schema.ResourceData, patch map[string]interface{}, resource *schema.Resource, remapFields map[string]string) error {
+	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
+	writeOnlyParams, err := getWriteOnlyParameters(d, parametersSchema, true)
+	if err != nil || len(writeOnlyParams) == 0 {
+		return err
+	}
+	parametersPatch, ok := patch["parameters"].(map[string]interface{})
+	if !ok {
+		parametersPatch = make(map[string]interface{})
+		patch["parameters"] = parametersPatch
+	}
+	for key, value := range writeOnlyParams {
+		parametersPatch[getTargetField(key, remapFields)] = value
+	}
+	return nil
+}
+
+func GetParametersFromRead(d *schema.ResourceData, readParams map[string]interface{}, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
 	params := make(map[string]interface{})
 	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
 	for key := range parametersSchema {
+		if isWriteOnlyParameter(key, parametersSchema) {
+			continue
+		}
+		// Parameters set through their write-only variant are left out of the
+		// state, and the version that was applied is kept as is.
+		versionKey := key + writeOnlyVersionSuffix
+		if _, ok := parametersSchema[versionKey]; ok {
+			if version, ok := d.GetOk("parameters.0." + versionKey); ok {
+				params[versionKey] = version
+				continue
+			}
+		}
 		readKey := getTargetField(key, remapFields)
 		if readParams[readKey] != nil {
 			params[key] = readParams[readKey]
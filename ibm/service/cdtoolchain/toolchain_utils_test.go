@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtoolchain"
+)
+
+func TestAddWriteOnlyParametersRequired(t *testing.T) {
+	tools := map[string]func() *schema.Resource{
+		"webhook":                  cdtoolchain.ResourceIBMCdToolchainToolSlack,
+		"service_key":              cdtoolchain.ResourceIBMCdToolchainToolPagerduty,
+		"access_key":               cdtoolchain.ResourceIBMCdToolchainToolSaucelabs,
+		"worker_queue_credentials": cdtoolchain.ResourceIBMCdToolchainToolPrivateworker,
+	}
+	for key, tool := range tools {
+		r := tool()
+		require.NoError(t, r.InternalValidate(nil, true), key)
+
+		parameters := r.Schema["parameters"].Elem.(*schema.Resource).Schema
+		assert.True(t, parameters[key].Optional, key)
+		assert.False(t, parameters[key].Required, key)
+		assert.Equal(t, []string{"parameters.0." + key, "parameters.0." + key + "_wo"}, parameters[key].ExactlyOneOf, key)
+		require.Contains(t, parameters, key+"_wo")
+		assert.True(t, parameters[key+"_wo"].WriteOnly, key)
+		assert.Equal(t, parameters[key].ExactlyOneOf, parameters[key+"_wo"].ExactlyOneOf, key)
+	}
+}
+
+func TestAddWriteOnlyParametersExactlyOneOf(t *testing.T) {
+	r := cdtoolchain.ResourceIBMCdToolchainToolPagerduty()
+	validate := func(parameters map[string]interface{}) bool {
+		parameters["service_url"] = "https://mycompany.example.pagerduty.com/services/AS34FR4"
+		diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"toolchain_id": "a5f5b3b4-0b0d-4b9e-9d3e-2f1a1c0e5d7b",
+			"parameters":   []interface{}{parameters},
+		}))
+		return !diags.HasError()
+	}
+
+	assert.True(t, validate(map[string]interface{}{"service_key": "key"}))
+	assert.True(t, validate(map[string]interface{}{"service_key_wo": "key", "service_key_wo_version": 1}))
+	assert.False(t, validate(map[string]interface{}{}))
+	assert.False(t, validate(map[string]interface{}{"service_key": "key", "service_key_wo": "key", "service_key_wo_version": 1}))
+
+}
@@ -34,7 +34,7 @@ const (
 )
 
 func ResourceIBMCISInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   ResourceIBMCISInstanceCreate,
 		Read:     ResourceIBMCISInstanceRead,
 		Update:   ResourceIBMCISInstanceUpdate,
@@ -143,7 +143,9 @@ func ResourceIBMCISInstance() *schema.Resource {
 				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about the resource",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func ResourceIBMCISValidator() *validate.ResourceValidator {
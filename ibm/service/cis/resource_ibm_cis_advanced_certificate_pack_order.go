@@ -30,11 +30,13 @@ const (
 
 func ResourceIBMCISAdvancedCertificatePackOrder() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISAdvancedCertificatePackOrderCreate,
-		Update:   ResourceIBMCISAdvancedCertificatePackOrderRead,
-		Read:     ResourceIBMCISAdvancedCertificatePackOrderRead,
-		Delete:   ResourceIBMCISAdvancedCertificatePackOrderDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: ResourceIBMCISAdvancedCertificatePackOrderCreate,
+		Update: ResourceIBMCISAdvancedCertificatePackOrderRead,
+		Read:   ResourceIBMCISAdvancedCertificatePackOrderRead,
+		Delete: ResourceIBMCISAdvancedCertificatePackOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts(":", cisAdvancedCertificatePackOrderID, cisDomainID, cisID),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
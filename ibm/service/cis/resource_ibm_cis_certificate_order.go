@@ -27,7 +27,7 @@ const (
 )
 
 func ResourceIBMCISCertificateOrder() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   ResourceIBMCISCertificateOrderCreate,
 		Update:   ResourceIBMCISCertificateOrderRead,
 		Read:     ResourceIBMCISCertificateOrderRead,
@@ -73,7 +73,10 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"certificate_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 
 func ResourceIBMCISCertificateOrderValidator() *validate.ResourceValidator {
@@ -16,10 +16,11 @@ const ()
 
 func ResourceIBMCISCustomListItems() *schema.Resource {
 	return &schema.Resource{
-		Create: ResourceIBMCISCustomListItemsCreate,
-		Update: ResourceIBMCISCustomListItemsUpdate,
-		Delete: ResourceIBMCISCustomListItemsDelete,
-		Read:   ResourceIBMCISCustomListItemsRead,
+		Create:   ResourceIBMCISCustomListItemsCreate,
+		Update:   ResourceIBMCISCustomListItemsUpdate,
+		Delete:   ResourceIBMCISCustomListItemsDelete,
+		Read:     ResourceIBMCISCustomListItemsRead,
+		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
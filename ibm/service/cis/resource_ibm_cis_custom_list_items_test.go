@@ -25,6 +25,11 @@ func TestAccIBMCisCustomListItems_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "items.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "ibm_cis_custom_list_items.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -50,7 +50,7 @@ const (
 )
 
 func ResourceIBMCISDnsRecord() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   ResourceIBMCISDnsRecordCreate,
 		Read:     ResourceIBMCISDnsRecordRead,
 		Update:   ResourceIBMCISDnsRecordUpdate,
@@ -139,7 +139,10 @@ func ResourceIBMCISDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"record_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 func ResourceIBMCISDnsRecordValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -151,9 +154,30 @@ func ResourceIBMCISDnsRecordValidator() *validate.ResourceValidator {
 			CloudDataType:              "resource_instance",
 			CloudDataRange:             []string{"service:internet-svcs"},
 			Required:                   true})
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: cisDNSRecordProxied, AllowedValues: "true"}},
+			Identifier: cisDNSRecordTTL,
+			MinValue:   "1",
+			MaxValue:   "1",
+			Message:    "proxied records have an automatic TTL of 1",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: cisDNSRecordType, AllowedValues: "A, AAAA, CNAME, SPF, TXT, NS, PTR, MX"}},
+			Targets: []string{cisDNSRecordContent},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: cisDNSRecordType, AllowedValues: "LOC, CAA, SRV"}},
+			Targets: []string{cisDNSRecordData},
+		},
+	}
 	ibmCISDNSRecordValidator := validate.ResourceValidator{
 		ResourceName: "ibm_cis_dns_record",
-		Schema:       validateSchema}
+		Schema:       validateSchema,
+		Rules:        rules}
 	return &ibmCISDNSRecordValidator
 }
 func ResourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ const (
 )
 
 func ResourceIBMCISDomain() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -85,7 +85,10 @@ func ResourceIBMCISDomain() *schema.Resource {
 		Update:   resourceCISdomainUpdate,
 		Delete:   resourceCISdomainDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 
 func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
@@ -77,7 +77,7 @@ const (
 )
 
 func ResourceIBMCISSettings() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -508,7 +508,10 @@ func ResourceIBMCISSettings() *schema.Resource {
 		Update:   resourceCISSettingsUpdate,
 		Delete:   resourceCISSettingsDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 
 func ResourceIBMCISDomainSettingValidator() *validate.ResourceValidator {
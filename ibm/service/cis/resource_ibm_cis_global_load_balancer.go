@@ -35,7 +35,7 @@ const (
 )
 
 func ResourceIBMCISGlb() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -173,7 +173,10 @@ func ResourceIBMCISGlb() *schema.Resource {
 		Exists:   resourceCISGlbExists,
 		Delete:   resourceCISGlbDelete,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"glb_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 func ResourceIBMCISGlbValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
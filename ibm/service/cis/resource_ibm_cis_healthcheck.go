@@ -36,7 +36,7 @@ const (
 )
 
 func ResourceIBMCISHealthCheck() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 
 		Create:   resourceCISHealthCheckCreate,
 		Read:     resourceCISHealthCheckRead,
@@ -165,7 +165,10 @@ func ResourceIBMCISHealthCheck() *schema.Resource {
 				Set: hashByMapKey(cisGLBHealthCheckHeadersHeader),
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"monitor_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 
 func ResourceIBMCISHealthCheckValidator() *validate.ResourceValidator {
@@ -28,7 +28,9 @@ func ResourceIBMCISMtls() *schema.Resource {
 		ReadContext:   resourceIBMCISMtlsRead,
 		UpdateContext: resourceIBMCISMtlsUpdate,
 		DeleteContext: resourceIBMCISMtlsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCISMtlsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -180,6 +182,30 @@ func resourceIBMCISMtlsRead(context context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// resourceIBMCISMtlsImport sets the name and host names of the certificate,
+// which read leaves alone as they are only sent on create.
+func resourceIBMCISMtlsImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		return nil, err
+	}
+
+	certID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	getOptions := sess.NewGetAccessCertificateOptions(zoneID, certID)
+	result, response, err := sess.GetAccessCertificate(getOptions)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetAccessCertificate failed: %s\n%s", err, response)
+	}
+
+	d.Set(cisMtlsCertName, result.Result.Name)
+	d.Set(cisMtlsHostNames, result.Result.AssociatedHostnames)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMCISMtlsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisMtlsSession()
 	if err != nil {
@@ -38,7 +38,9 @@ func ResourceIBMCISMtlsApp() *schema.Resource {
 		ReadContext:   resourceIBMCISMtlsAppRead,
 		UpdateContext: resourceIBMCISMtlsAppUpdate,
 		DeleteContext: resourceIBMCISMtlsAppDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCISMtlsAppImport,
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -276,6 +278,31 @@ func resourceIBMCISMtlsAppRead(context context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// resourceIBMCISMtlsAppImport sets the name and domain of the application,
+// which read leaves alone as they are only sent on create.
+func resourceIBMCISMtlsAppImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).CisMtlsSession()
+	if err != nil {
+		return nil, err
+	}
+
+	appID, _, zoneID, crn, err := flex.ConvertTfToCisFourVar(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	getAppOptions := sess.NewGetAccessApplicationOptions(zoneID, appID)
+	getAppResult, getAppResp, err := sess.GetAccessApplication(getAppOptions)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] GetAccessApplication failed: %s\n%s", err, getAppResp)
+	}
+
+	d.Set(cisMtlsAppName, getAppResult.Result.Name)
+	d.Set(cisMtlsHostDomain, getAppResult.Result.Domain)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMCISMtlsAppUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisMtlsSession()
 	if err != nil {
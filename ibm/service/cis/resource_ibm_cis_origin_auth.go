@@ -36,7 +36,9 @@ func ResourceIBMCISOriginAuthPull() *schema.Resource {
 		ReadContext:   resourceIBMCISOriginAuthPullRead,
 		UpdateContext: resourceIBMCISOriginAuthPullUpdate,
 		DeleteContext: resourceIBMCISOriginAuthPullDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts(":", "", cisOriginAuthLevel, "", ""),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -38,7 +38,7 @@ const (
 )
 
 func ResourceIBMCISPool() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -157,7 +157,10 @@ func ResourceIBMCISPool() *schema.Resource {
 		Delete:   resourceCISPoolDelete,
 		Exists:   resourceCISPoolExists,
 		Importer: &schema.ResourceImporter{},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"pool_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 func ResourceIBMCISPoolValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
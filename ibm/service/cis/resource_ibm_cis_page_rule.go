@@ -43,7 +43,7 @@ const (
 )
 
 func ResourceIBMCISPageRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   resourceCISPageRuleCreate,
 		Read:     resourceCISPageRuleRead,
 		Update:   resourceCISPageRuleUpdate,
@@ -163,7 +163,10 @@ func ResourceIBMCISPageRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"rule_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 
 func ResourceIBMCISPageRuleValidator() *validate.ResourceValidator {
@@ -49,7 +49,7 @@ const (
 )
 
 func ResourceIBMCISRangeApp() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   ResourceIBMCISRangeAppCreate,
 		Read:     ResourceIBMCISRangeAppRead,
 		Update:   ResourceIBMCISRangeAppUpdate,
@@ -161,7 +161,10 @@ func ResourceIBMCISRangeApp() *schema.Resource {
 				Description: "modified on date",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"app_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 func ResourceIBMCISRangeAppValidator() *validate.ResourceValidator {
 
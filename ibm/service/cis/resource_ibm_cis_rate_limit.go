@@ -24,7 +24,7 @@ const (
 )
 
 func ResourceIBMCISRateLimit() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   ResourceIBMCISRateLimitCreate,
 		Read:     ResourceIBMCISRateLimitRead,
 		Update:   ResourceIBMCISRateLimitUpdate,
@@ -252,7 +252,10 @@ func ResourceIBMCISRateLimit() *schema.Resource {
 				Description: "Rate Limit rule Id",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"rule_id", "domain_id", "cis_id"},
+		Separator:  ":",
+	})
 }
 func ResourceIBMCISRateLimitValidator() *validate.ResourceValidator {
 
@@ -355,7 +358,20 @@ func ResourceIBMCISRateLimitValidator() *validate.ResourceValidator {
 			MinValueLength:             0,
 			MaxValueLength:             1024})
 
-	ibmCISRateLimitResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cis_rate_limit", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "action.0.mode", AllowedValues: "simulate, ban"}},
+			Targets: []string{"action.0.timeout"},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "action.0.mode", AllowedValues: "challenge, js_challenge"}},
+			Targets: []string{"action.0.timeout"},
+		},
+	}
+
+	ibmCISRateLimitResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cis_rate_limit", Schema: validateSchema, Rules: rules}
 	return &ibmCISRateLimitResourceValidator
 }
 func ResourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
@@ -305,11 +305,13 @@ var CISRulesetsRulesObject = &schema.Resource{
 
 func ResourceIBMCISRulesetRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISRulesetRuleCreate,
-		Read:     ResourceIBMCISRulesetRuleRead,
-		Update:   ResourceIBMCISRulesetRuleUpdate,
-		Delete:   ResourceIBMCISRulesetRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: ResourceIBMCISRulesetRuleCreate,
+		Read:   ResourceIBMCISRulesetRuleRead,
+		Update: ResourceIBMCISRulesetRuleUpdate,
+		Delete: ResourceIBMCISRulesetRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts(":", "", CISRulesetsId, cisDomainID, cisID),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -26,6 +26,7 @@ func ResourceIBMCDN() *schema.Resource {
 		Update:             resourceIBMCDNUpdate,
 		Delete:             resourceIBMCDNDelete,
 		Exists:             resourceIBMCDNExists,
+		Importer:           &schema.ResourceImporter{},
 		DeprecationMessage: "This service is deprecated",
 		Schema: map[string]*schema.Schema{
 			"host_name": {
@@ -351,32 +352,39 @@ func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
 	cdnId := sl.String(d.Id())
 	///read the changes in the remote resource and update in the local resource.
 	read, err := service.ListDomainMappingByUniqueId(cdnId)
-	///Print the response of the requested the service.
-	d.Set("originaddress", *read[0].OriginHost)
-	d.Set("vendorname", *read[0].VendorName)
-	d.Set("domain", *read[0].Domain)
-	d.Set("header", *read[0].Header)
-	d.Set("cname", *read[0].Cname)
-	d.Set("origin_type", *read[0].OriginType)
-	d.Set("status", *read[0].Status)
-	if *read[0].OriginType == "OBJECT_STORAGE" {
-		d.Set("bucketname", *read[0].BucketName)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving CDN domain mapping %s: %s", d.Id(), err)
 	}
-	if *read[0].Protocol == "HTTP" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpport", *read[0].HttpPort)
+	if len(read) == 0 {
+		log.Printf("[WARN] CDN domain mapping %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
-	if *read[0].Protocol == "HTTPS" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpsport", *read[0].HttpsPort)
+	mapping := read[0]
+	d.Set("host_name", mapping.Domain)
+	d.Set("vendor_name", mapping.VendorName)
+	d.Set("origin_address", mapping.OriginHost)
+	d.Set("origin_type", mapping.OriginType)
+	d.Set("header", mapping.Header)
+	d.Set("cname", mapping.Cname)
+	d.Set("status", mapping.Status)
+	if mapping.OriginType != nil && *mapping.OriginType == "OBJECT_STORAGE" {
+		d.Set("bucket_name", mapping.BucketName)
+		d.Set("file_extension", mapping.FileExtension)
 	}
-	d.Set("protocol", *read[0].Protocol)
-	d.Set("respectheaders", *read[0].RespectHeaders)
-	d.Set("certificationtype", *read[0].CertificateType)
-	d.Set("cachekeyqueryrule", *read[0].CacheKeyQueryRule)
-	d.Set("path", *read[0].Path)
-	d.Set("performanceconfiguration", *read[0].PerformanceConfiguration)
-	if err != nil {
-		log.Println(err)
+	protocol := sl.Get(mapping.Protocol, "").(string)
+	if protocol == "HTTP" || protocol == "HTTP_AND_HTTPS" {
+		d.Set("http_port", mapping.HttpPort)
+	}
+	if protocol == "HTTPS" || protocol == "HTTP_AND_HTTPS" {
+		d.Set("https_port", mapping.HttpsPort)
 	}
+	d.Set("protocol", mapping.Protocol)
+	d.Set("respect_headers", mapping.RespectHeaders)
+	d.Set("certificate_type", mapping.CertificateType)
+	d.Set("cache_key_query_rule", mapping.CacheKeyQueryRule)
+	d.Set("path", mapping.Path)
+	d.Set("performance_configuration", mapping.PerformanceConfiguration)
 	return nil
 }
 
@@ -41,6 +41,12 @@ func TestAccIBMCDN_Basic(t *testing.T) {
 				),
 				Destroy: false,
 			},
+			{
+				ResourceName:            "ibm_cdn.test_cdn111",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_extension"},
+			},
 		},
 	})
 }
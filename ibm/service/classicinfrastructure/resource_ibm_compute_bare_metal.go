@@ -528,6 +528,32 @@ func getBareMetalOrderFromResourceData(d *schema.ResourceData, meta interface{})
 	return hardware, nil
 }
 
+func ResourceIBMComputeBareMetalValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "private_network_only", AllowedValues: "true"}, {Identifier: "secondary_ip_count"}, {Identifier: "secondary_ip_count", AllowedValues: "0", Not: true}},
+			Targets: []string{"secondary_ip_count"},
+			Message: "secondary IP addresses are public",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "private_network_only", AllowedValues: "true"}, {Identifier: "ipv6_enabled", AllowedValues: "true"}},
+			Targets: []string{"ipv6_enabled"},
+			Message: "the IPv6 address is public",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "private_network_only", AllowedValues: "true"}, {Identifier: "ipv6_static_enabled", AllowedValues: "true"}},
+			Targets: []string{"ipv6_static_enabled"},
+			Message: "the static IPv6 addresses are public",
+		},
+	}
+
+	ibmComputeBareMetalResourceValidator := validate.ResourceValidator{ResourceName: "ibm_compute_bare_metal", Rules: rules}
+	return &ibmComputeBareMetalResourceValidator
+}
+
 func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	hwService := services.GetHardwareService(sess)
@@ -1033,6 +1033,26 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
+func ResourceIBMComputeVmInstanceValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "datacenter", Not: true}},
+			Targets: []string{"datacenter_choice"},
+			Message: "one of datacenter or datacenter_choice must be set",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "bulk_vms", Not: true}},
+			Targets: []string{"hostname", "domain"},
+			Message: "one of hostname and domain or bulk_vms must be set",
+		},
+	}
+
+	ibmComputeVmInstanceResourceValidator := validate.ResourceValidator{ResourceName: "ibm_compute_vm_instance", Rules: rules}
+	return &ibmComputeVmInstanceResourceValidator
+}
+
 func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
@@ -52,6 +52,12 @@ data "ibm_dns_domain_registration" "wcpclouduk" {
 				),
 				Destroy: false,
 			},
+			{
+				ResourceName:            "ibm_dns_domain_registration_nameservers.acceptance_test_dns_domain-1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_name_servers"},
+			},
 		},
 	})
 }
@@ -16,12 +16,14 @@ import (
 
 func ResourceIBMDNSReverseRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSREVERSERecordExists,
-		Create:   resourceIBMDNSREVERSERecordCreate,
-		Read:     resourceIBMDNSREVERSERecordRead,
-		Update:   resourceIBMDNSREVERSERecordUpdate,
-		Delete:   resourceIBMDNSREVERSERecordDelete,
-		Importer: &schema.ResourceImporter{},
+		Exists: resourceIBMDNSREVERSERecordExists,
+		Create: resourceIBMDNSREVERSERecordCreate,
+		Read:   resourceIBMDNSREVERSERecordRead,
+		Update: resourceIBMDNSREVERSERecordUpdate,
+		Delete: resourceIBMDNSREVERSERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMDNSREVERSERecordImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"ipaddress": {
 				Type:        schema.TypeString,
@@ -85,6 +87,29 @@ func resourceIBMDNSREVERSERecordRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// Sets the host name and TTL of an imported DNS Domain Reverse Record, which
+// read leaves alone
+func resourceIBMDNSREVERSERecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	service := services.GetDnsDomainResourceRecordService(sess)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Not  a valid ID, must be an integer: %s", err)
+	}
+
+	record, err := service.Id(id).GetObject()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving DNS Reverse Record: %s", err)
+	}
+	if record.Data != nil {
+		d.Set("hostname", *record.Data)
+	}
+	if record.Ttl != nil {
+		d.Set("ttl", *record.Ttl)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 // Updates DNS Domain Reverse Record in SL system
 // https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
 func resourceIBMDNSREVERSERecordUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -108,6 +109,23 @@ func ResourceIBMLb() *schema.Resource {
 	}
 }
 
+func ResourceIBMLbValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: "ha_enabled", AllowedValues: "true"},
+				{Identifier: "dedicated", AllowedValues: "true", Not: true},
+			},
+			Targets: []string{"ha_enabled"},
+			Message: "high availability is not supported by shared load balancers",
+		},
+	}
+
+	ibmLbResourceValidator := validate.ResourceValidator{ResourceName: "ibm_lb", Rules: rules}
+	return &ibmLbResourceValidator
+}
+
 func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
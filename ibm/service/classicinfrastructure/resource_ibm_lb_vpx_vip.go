@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -135,6 +136,19 @@ func ResourceIBMLbVpxVip() *schema.Resource {
 	}
 }
 
+func ResourceIBMLbVpxVipValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "type", AllowedValues: "SSL", Not: true}},
+			Targets: []string{"security_certificate_id"},
+		},
+	}
+
+	ibmLbVpxVipResourceValidator := validate.ResourceValidator{ResourceName: "ibm_lb_vpx_vip", Rules: rules}
+	return &ibmLbVpxVipResourceValidator
+}
+
 func resourceIBMLbVpxVipCreate(d *schema.ResourceData, meta interface{}) error {
 	version, err := getVPXVersion(d.Get("nad_controller_id").(int), meta.(conns.ClientSession).SoftLayerSession())
 	if err != nil {
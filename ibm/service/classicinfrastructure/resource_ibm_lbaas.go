@@ -243,6 +243,19 @@ func ResourceIBMLbaas() *schema.Resource {
 	}
 }
 
+func ResourceIBMLbaasValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "protocols.*.frontend_protocol", AllowedValues: "HTTPS", Not: true}},
+			Targets: []string{"protocols.*.tls_certificate_id"},
+		},
+	}
+
+	ibmLbaasResourceValidator := validate.ResourceValidator{ResourceName: "ibm_lbaas", Rules: rules}
+	return &ibmLbaasResourceValidator
+}
+
 func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
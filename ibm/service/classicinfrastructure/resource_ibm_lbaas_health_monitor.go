@@ -17,11 +17,13 @@ import (
 
 func ResourceIBMLbaasHealthMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasHealthMonitorCreate,
-		Read:     resourceIBMLbaasHealthMonitorRead,
-		Delete:   resourceIBMLbaasHealthMonitorDelete,
-		Update:   resourceIBMLbaasHealthMonitorUpdate,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMLbaasHealthMonitorCreate,
+		Read:   resourceIBMLbaasHealthMonitorRead,
+		Delete: resourceIBMLbaasHealthMonitorDelete,
+		Update: resourceIBMLbaasHealthMonitorUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "lbaas_id", "monitor_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -24,6 +24,9 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
 		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
 		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMNetworkInterfaceSGAttachmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -124,12 +127,24 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 	}
 	for _, b := range bindings {
 		if *b.NetworkComponentId == interfaceID {
+			d.Set("security_group_id", sgID)
+			d.Set("network_interface_id", interfaceID)
 			return nil
 		}
 	}
 	return fmt.Errorf("[ERROR] No association found between security group %d and network interface %d", sgID, interfaceID)
 }
 
+func resourceIBMNetworkInterfaceSGAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := decomposeNetworkSGAttachmentID(d.Id()); err != nil {
+		return nil, err
+	}
+	// soft_reboot only applies when the attachment is created, so an imported
+	// attachment takes the default instead of being replaced.
+	d.Set("soft_reboot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
 	conns.IbmMutexKV.Lock(mk)
@@ -37,6 +37,11 @@ func TestAccIBMNetworkInterfaceSGAttachment(t *testing.T) {
 					testAccCheckNetworkInterfaceSGAttachmentExists("ibm_network_interface_sg_attachment.http"),
 				),
 			},
+			{
+				ResourceName:      "ibm_network_interface_sg_attachment.ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
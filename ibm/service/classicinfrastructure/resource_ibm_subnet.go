@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -139,6 +140,19 @@ func ResourceIBMSubnet() *schema.Resource {
 	}
 }
 
+func ResourceIBMSubnetValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "type", AllowedValues: "Static", Not: true}},
+			Targets: []string{"endpoint_ip"},
+		},
+	}
+
+	ibmSubnetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_subnet", Rules: rules}
+	return &ibmSubnetResourceValidator
+}
+
 func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 
@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -134,6 +135,21 @@ func ResourceIBMCloudant() *schema.Resource {
 	}
 }
 
+func ResourceIBMCloudantValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: "plan", AllowedValues: "lite"}},
+			Identifier: "capacity",
+			MaxValue:   "1",
+			Message:    "the capacity of lite instances cannot be changed",
+		},
+	}
+
+	ibmCloudantResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cloudant", Rules: rules}
+	return &ibmCloudantResourceValidator
+}
+
 func resourceIBMCloudantCreate(d *schema.ResourceData, meta interface{}) error {
 	d.Set("service", "cloudantnosqldb")
 
@@ -27,7 +27,9 @@ func ResourceIbmCodeEngineAllowedOutboundDestination() *schema.Resource {
 		ReadContext:   resourceIbmCodeEngineAllowedOutboundDestinationRead,
 		UpdateContext: resourceIbmCodeEngineAllowedOutboundDestinationUpdate,
 		DeleteContext: resourceIbmCodeEngineAllowedOutboundDestinationDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "project_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -19,7 +19,9 @@ func ResourceIBMCOSBackupVault() *schema.Resource {
 		ReadContext:   resourceIBMCOSBackupVaultRead,
 		UpdateContext: resourceIBMCOSBackupVaultUpdate,
 		DeleteContext: resourceIBMCOSBackupVaultDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCOSBackupVaultImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -152,6 +154,13 @@ func resourceIBMCOSBackupVaultUpdate(ctx context.Context, d *schema.ResourceData
 	return resourceIBMCOSBackupVaultRead(ctx, d, meta)
 }
 
+// resourceIBMCOSBackupVaultImport sets the name of the backup vault from its
+// ID, as read does not.
+func resourceIBMCOSBackupVaultImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("backup_vault_name", parseBackupVaultID(d.Id(), "backupVaultName"))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMCOSBackupVaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	backupVaultName := parseBackupVaultID(d.Id(), "backupVaultName")
 	instanceCRN := parseBackupVaultID(d.Id(), "instanceCRN")
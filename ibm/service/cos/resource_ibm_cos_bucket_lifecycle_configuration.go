@@ -496,7 +496,7 @@ func resourceIBMCOSBucketLifecycleConfigurationCreate(ctx context.Context, d *sc
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	lifecycleRule := d.Get("lifecycle_rule")
 	rules := lifecycleConfigurationSet(lifecycleRule.([]interface{})) // setting each lifecycle rule
 	if err != nil {
@@ -527,7 +527,7 @@ func resourceIBMCOSBucketLifecycleConfigurationUpdate(ctx context.Context, d *sc
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if d.HasChange("lifecycle_rule") {
 		lifecycleRule := d.Get("lifecycle_rule")
 		rules := lifecycleConfigurationSet(lifecycleRule.([]interface{}))
@@ -563,7 +563,7 @@ func resourceIBMCOSBucketLifecycleConfigurationRead(ctx context.Context, d *sche
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
@@ -620,7 +620,7 @@ func resourceIBMCOSBucketLifecycleConfigurationDelete(ctx context.Context, d *sc
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.Errorf("%v", err)
 	}
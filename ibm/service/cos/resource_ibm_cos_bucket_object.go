@@ -22,7 +22,6 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
-	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -168,7 +167,7 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -275,7 +274,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -366,7 +365,7 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -470,7 +469,7 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3Client(m, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -505,14 +504,14 @@ func getCosEndpoint(bucketLocation string, endpointType string) string {
 	return ""
 }
 
-func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+func getS3Client(meta interface{}, bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 	visibility := endpointType
 	if endpointType == "direct" {
 		visibility = "private"
 	}
 	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
-	apiEndpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	apiEndpoint = conns.ServiceEndpoint(bxSession, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
@@ -540,7 +539,7 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 		}
 		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
 	}
-	s3Sess := session.Must(session.NewSession())
+	s3Sess := newS3Session(meta)
 	return s3.New(s3Sess, s3Conf), nil
 }
 
@@ -116,7 +116,7 @@ func resourceIBMCOSBucketObjectlockCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	var objectLockConfiguration *s3.ObjectLockConfiguration
 	configuration, ok := d.GetOk("object_lock_configuration")
 	if ok {
@@ -150,7 +150,7 @@ func resourceIBMCOSBucketObjectlockUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -191,7 +191,7 @@ func resourceIBMCOSBucketObjectlockRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -228,7 +228,7 @@ func resourceIBMCOSBucketObjectlockDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -354,7 +354,7 @@ func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	var websiteConfiguration *s3.WebsiteConfiguration
 	configuration, ok := d.GetOk("website_configuration")
 	if ok {
@@ -387,7 +387,7 @@ func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -429,7 +429,7 @@ func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta i
 	if err != nil {
 		return err
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -465,7 +465,7 @@ func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -169,7 +169,7 @@ func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	var rules []*s3.ReplicationRule
 
 	replication, ok := d.GetOk("replication_rule")
@@ -210,7 +210,7 @@ func resourceIBMCOSBucketReplicationConfigurationUpdate(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -259,7 +259,7 @@ func resourceIBMCOSBucketReplicationConfigurationRead(d *schema.ResourceData, me
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -295,7 +295,7 @@ func resourceIBMCOSBucketReplicationConfigurationDelete(d *schema.ResourceData,
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, err := getS3ClientSession(meta, bxSession, bucketLocation, endpointType, instanceCRN)
 	if err != nil {
 		return err
 	}
@@ -360,7 +360,16 @@ func getCosEndpointType(bucketLocation string, endpointType string) string {
 	return ""
 }
 
-func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+// newS3Session returns the session of an S3 client, whose calls go through
+// the same middleware as the other clients of the provider, e.g. the
+// http_trace.
+func newS3Session(meta interface{}) *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		HTTPClient: meta.(conns.ClientSession).HTTPClient("COS"),
+	}))
+}
+
+func getS3ClientSession(meta interface{}, bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 
 	visibility := endpointType
@@ -368,7 +377,7 @@ func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, end
 		visibility = "private"
 	}
 	apiEndpoint := getCosEndpointType(bucketLocation, endpointType)
-	apiEndpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
+	apiEndpoint = conns.ServiceEndpoint(bxSession, visibility, "IBMCLOUD_COS_ENDPOINT", bucketLocation, apiEndpoint)
 	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
 		return nil, fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
@@ -396,7 +405,7 @@ func getS3ClientSession(bxSession *bxsession.Session, bucketLocation string, end
 		}
 		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
 	}
-	s3Sess := session.Must(session.NewSession())
+	s3Sess := newS3Session(meta)
 	return s3.New(s3Sess, s3Conf), nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -72,6 +73,9 @@ type DatabaseUser struct {
 	Password string
 	Role     *string
 	Type     string
+	// PasswordWOVersion is the version of the password of a user whose
+	// password is set through user_password_wo, or 0.
+	PasswordWOVersion int
 }
 
 type databaseUserValidationError struct {
@@ -148,7 +152,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			resourceIBMDatabaseInstanceDiff,
 			validateGroupsDiff,
 			validateUsersDiff,
-			validateRemoteLeaderIDDiff,
+			validateUserPasswordsDiff,
 			validateVersionDiff,
 		),
 
@@ -227,11 +231,31 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					validation.StringLenBetween(15, 72),
 					DatabaseUserPasswordValidator("database"),
 				),
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"adminpassword_wo"},
 				// DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 				//  return true
 				// },
 			},
+			"adminpassword_wo": {
+				Description: "The admin user password for the instance. The value is not persisted in the state; change adminpassword_wo_version to update it",
+				Type:        schema.TypeString,
+				Optional:    true,
+				WriteOnly:   true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(15, 72),
+					DatabaseUserPasswordValidator("database"),
+				),
+				ConflictsWith: []string{"adminpassword"},
+				RequiredWith:  []string{"adminpassword_wo_version"},
+			},
+			"adminpassword_wo_version": {
+				Description:  "The version of adminpassword_wo. Change it to update the admin user password",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"adminpassword_wo"},
+			},
 			"configuration": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -337,12 +361,18 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(4, 32),
 						},
 						"password": {
-							Description:  "User password",
+							Description:  "User password. Exactly one of password and password_wo_version must be set",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringLenBetween(15, 32),
 						},
+						"password_wo_version": {
+							Description:  "The version of the password set for this user in a user_password_wo block. Change it to update the password",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"type": {
 							Description:  "User type",
 							Type:         schema.TypeString,
@@ -360,6 +390,36 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			// Set blocks cannot hold write-only attributes, so the write-only
+			// passwords of users are given in a list next to users.
+			"user_password_wo": {
+				Description: "Write-only passwords of the users that set password_wo_version",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "User name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description:  "User type",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "database",
+							ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+						},
+						"password": {
+							Description:  "User password. The value is not persisted in the state; change password_wo_version of the user to update it",
+							Type:         schema.TypeString,
+							Required:     true,
+							WriteOnly:    true,
+							ValidateFunc: validation.StringLenBetween(15, 32),
+						},
+					},
+				},
+			},
 			"allowlist": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -917,7 +977,29 @@ func ResourceIBMICDValidator() *validate.ResourceValidator {
 			AllowedValues:              "member, analytics, bi_connector",
 			Required:                   true})
 
-	ibmICDResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "service", AllowedValues: "databases-for-postgresql", Not: true}},
+			Targets: []string{"logical_replication_slot"},
+		},
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: "service", AllowedValues: "databases-for-mongodb", Not: true},
+				{Identifier: "plan", AllowedValues: "enterprise", Not: true},
+			},
+			Targets: []string{"offline_restore"},
+			Message: "offline restore is only supported by databases-for-mongodb enterprise",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "service", AllowedValues: "databases-for-postgresql, databases-for-enterprisedb, databases-for-mysql", Not: true}},
+			Targets: []string{"remote_leader_id"},
+		},
+	}
+
+	ibmICDResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database", Schema: validateSchema, Rules: rules}
 	return &ibmICDResourceValidator
 }
 
@@ -1059,13 +1141,6 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 	}
 
 	service := diff.Get("service").(string)
-	plan := diff.Get("plan").(string)
-
-	_, logicalReplicationSet := diff.GetOk("logical_replication_slot")
-
-	if service != "databases-for-postgresql" && logicalReplicationSet {
-		return fmt.Errorf("[ERROR] logical_replication_slot is only supported for databases-for-postgresql")
-	}
 
 	configJSON, configOk := diff.GetOk("configuration")
 
@@ -1116,11 +1191,6 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		}
 	}
 
-	_, offlineRestoreOk := diff.GetOk("offline_restore")
-	if offlineRestoreOk && service != "databases-for-mongodb" && plan != "enterprise" {
-		return fmt.Errorf("[ERROR] offline_restore is only supported for databases-for-mongodb enterprise")
-	}
-
 	return nil
 }
 
@@ -1390,8 +1460,14 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
 
-	if pw, ok := d.GetOk("adminpassword"); ok {
-		adminPassword := pw.(string)
+	adminPassword := d.Get("adminpassword").(string)
+	if _, ok := d.GetOk("adminpassword_wo_version"); ok {
+		adminPassword, err = flex.GetWriteOnlyString(d, "adminpassword_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if adminPassword != "" {
 
 		getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 			ID: core.StringPtr(instanceID),
@@ -1507,6 +1583,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 
 		users := expandUsers(userList.(*schema.Set).List())
+		setWriteOnlyUserPasswords(users, d.GetRawConfig())
 		for _, user := range users {
 			// Note: Some db users exist after provisioning (i.e. admin, repl)
 			// so we must attempt both methods
@@ -2006,9 +2083,15 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("adminpassword") {
+	if d.HasChange("adminpassword") || d.HasChange("adminpassword_wo_version") {
 		adminUser := d.Get("adminuser").(string)
 		password := d.Get("adminpassword").(string)
+		if _, ok := d.GetOk("adminpassword_wo_version"); ok {
+			password, err = flex.GetWriteOnlyString(d, "adminpassword_wo")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 
 		user := &clouddatabasesv5.UserUpdatePasswordSetting{
 			Password: &password,
@@ -2071,6 +2154,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	if d.HasChange("users") {
 		oldUsers, newUsers := d.GetChange("users")
 		userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
+		setWriteOnlyUserPasswords(newUserList(userChanges), d.GetRawConfig())
 
 		for _, change := range userChanges {
 			// Delete User
@@ -3090,6 +3174,7 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 
 	oldUsers, newUsers := diff.GetChange("users")
 	userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
+	setWriteOnlyUserPasswords(newUserList(userChanges), diff.GetRawConfig())
 
 	for _, change := range userChanges {
 		if change.isDelete() {
@@ -3097,7 +3182,10 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
+			// A write-only password that is not known yet is validated on apply.
+			if change.New.PasswordWOVersion == 0 || change.New.Password != "" {
+				err = change.New.ValidatePassword()
+			}
 
 			if err != nil {
 				return err
@@ -3142,6 +3230,9 @@ func expandUsers(_users []interface{}) []*DatabaseUser {
 				Password: tfUser["password"].(string),
 				Type:     tfUser["type"].(string),
 			}
+			if version, ok := tfUser["password_wo_version"].(int); ok {
+				user.PasswordWOVersion = version
+			}
 
 			// NOTE: cannot differentiate nil vs empty string
 			// https://github.com/hashicorp/terraform-plugin-sdk/issues/741
@@ -3158,6 +3249,118 @@ func expandUsers(_users []interface{}) []*DatabaseUser {
 	return users
 }
 
+// newUserList returns the users that are created or kept by userChanges.
+func newUserList(userChanges []*userChange) []*DatabaseUser {
+	users := make([]*DatabaseUser, 0, len(userChanges))
+	for _, change := range userChanges {
+		if change.New != nil {
+			users = append(users, change.New)
+		}
+	}
+	return users
+}
+
+// setWriteOnlyUserPasswords sets the password of the users that set
+// password_wo_version from the matching user_password_wo block of the raw
+// configuration. The passwords that are unknown until apply are left empty.
+func setWriteOnlyUserPasswords(users []*DatabaseUser, rawConfig cty.Value) {
+	passwords := map[string]string{}
+	for _, block := range rawConfigBlocks(rawConfig, "user_password_wo") {
+		name, userType, password := block.GetAttr("name"), block.GetAttr("type"), block.GetAttr("password")
+		if name.IsNull() || !name.IsKnown() || password.IsNull() || !password.IsKnown() {
+			continue
+		}
+		user := DatabaseUser{Username: name.AsString(), Type: "database"}
+		if !userType.IsNull() && userType.IsKnown() {
+			user.Type = userType.AsString()
+		}
+		passwords[user.ID()] = password.AsString()
+	}
+
+	for _, user := range users {
+		if user.PasswordWOVersion == 0 {
+			continue
+		}
+		if password, ok := passwords[user.ID()]; ok {
+			user.Password = password
+		}
+	}
+}
+
+// validateUserPasswordsDiff checks that every user of the configuration sets
+// exactly one of password and password_wo_version, and that the users that set
+// password_wo_version have a user_password_wo block. It reads the raw
+// configuration so that a password that is unknown until apply still fails
+// the plan.
+func validateUserPasswordsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateUserPasswords(diff.GetRawConfig())
+}
+
+func validateUserPasswords(rawConfig cty.Value) error {
+	// The users of the user_password_wo blocks by ID, or nil when one of
+	// their names is unknown.
+	writeOnly := map[string]bool{}
+	for _, block := range rawConfigBlocks(rawConfig, "user_password_wo") {
+		name, userType := block.GetAttr("name"), block.GetAttr("type")
+		if !name.IsKnown() || !userType.IsKnown() {
+			writeOnly = nil
+			break
+		}
+		if name.IsNull() {
+			continue
+		}
+		user := DatabaseUser{Username: name.AsString(), Type: "database"}
+		if !userType.IsNull() {
+			user.Type = userType.AsString()
+		}
+		writeOnly[user.ID()] = true
+	}
+
+	for _, block := range rawConfigBlocks(rawConfig, "users") {
+		name, userType := block.GetAttr("name"), block.GetAttr("type")
+		if !name.IsKnown() || name.IsNull() || !userType.IsKnown() {
+			continue
+		}
+		user := DatabaseUser{Username: name.AsString(), Type: "database"}
+		if !userType.IsNull() {
+			user.Type = userType.AsString()
+		}
+
+		password, version := block.GetAttr("password"), block.GetAttr("password_wo_version")
+		hasPassword := !password.IsNull() && !(password.IsKnown() && password.AsString() == "")
+		hasVersion := !version.IsNull() && !(version.IsKnown() && version.Equals(cty.Zero).True())
+		switch {
+		case hasPassword && hasVersion:
+			return fmt.Errorf("[ERROR] Only one of password and password_wo_version can be set for user %s", user.Username)
+		case !hasPassword && !hasVersion:
+			return fmt.Errorf("[ERROR] One of password and password_wo_version must be set for user %s", user.Username)
+		case hasVersion && writeOnly != nil && !writeOnly[user.ID()]:
+			return fmt.Errorf("[ERROR] User %s sets password_wo_version but has no user_password_wo block", user.Username)
+		}
+	}
+	return nil
+}
+
+// rawConfigBlocks returns the known blocks of the attribute name of the raw
+// configuration.
+func rawConfigBlocks(rawConfig cty.Value, name string) []cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return nil
+	}
+	blocks := rawConfig.GetAttr(name)
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	var values []cty.Value
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if !block.IsNull() && block.IsKnown() {
+			values = append(values, block)
+		}
+	}
+	return values
+}
+
 func expandUserChanges(_oldUsers []interface{}, _newUsers []interface{}) (userChanges []*userChange) {
 	oldUsers := expandUsers(_oldUsers)
 	newUsers := expandUsers(_newUsers)
@@ -3184,17 +3387,6 @@ func expandUserChanges(_oldUsers []interface{}, _newUsers []interface{}) (userCh
 	return userChanges
 }
 
-func validateRemoteLeaderIDDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	_, remoteLeaderIdOk := diff.GetOk("remote_leader_id")
-	service := diff.Get("service").(string)
-
-	if remoteLeaderIdOk && (service != "databases-for-postgresql" && service != "databases-for-mysql" && service != "databases-for-enterprisedb") {
-		return fmt.Errorf("[ERROR] remote_leader_id is only supported for databases-for-postgresql, databases-for-enterprisedb and databases-for-mysql")
-	}
-
-	return nil
-}
-
 func validateVersionDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	instanceID := diff.Id()
 	oldVersion, newVersion := diff.GetChange("version")
@@ -3222,10 +3414,19 @@ func (c *userChange) isCreate() bool {
 func (c *userChange) isUpdate() bool {
 	return c.New != nil &&
 		c.Old != nil &&
-		((c.Old.Password != c.New.Password) ||
+		(c.passwordChanged() ||
 			(c.Old.Role != c.New.Role))
 }
 
+// passwordChanged reports whether the password of the user changed. Write-only
+// passwords are not in the state, so only their version is compared.
+func (c *userChange) passwordChanged() bool {
+	if c.Old.PasswordWOVersion != c.New.PasswordWOVersion {
+		return true
+	}
+	return c.New.PasswordWOVersion == 0 && c.Old.Password != c.New.Password
+}
+
 func (u *DatabaseUser) ID() (id string) {
 	return fmt.Sprintf("%s-%s", u.Type, u.Username)
 }
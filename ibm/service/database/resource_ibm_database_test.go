@@ -10,6 +10,7 @@ import (
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"gotest.tools/assert"
 )
@@ -256,3 +257,101 @@ func TestUpgradeInProgressWarning(t *testing.T) {
 		t.Errorf("expected detail %v, got %v", detail, diags[0].Detail)
 	}
 }
+
+func TestUserPasswordWriteOnly(t *testing.T) {
+	assert.NilError(t, ResourceIBMDatabaseInstance().InternalValidate(nil, true))
+
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"user_password_wo": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("writer"),
+				"type":     cty.NullVal(cty.String),
+				"password": cty.StringVal("Password-from-wo-123"),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.StringVal("manager"),
+				"type":     cty.StringVal("ops_manager"),
+				"password": cty.StringVal("Manager-from-wo-123$"),
+			}),
+		}),
+	})
+
+	oldUsers := []interface{}{
+		map[string]interface{}{"name": "writer", "password": "", "password_wo_version": 1, "type": "database"},
+		map[string]interface{}{"name": "reader", "password": "Reader-password-123", "password_wo_version": 0, "type": "database"},
+	}
+	newUsers := []interface{}{
+		map[string]interface{}{"name": "writer", "password": "", "password_wo_version": 2, "type": "database"},
+		map[string]interface{}{"name": "reader", "password": "Reader-password-123", "password_wo_version": 0, "type": "database"},
+		map[string]interface{}{"name": "manager", "password": "", "password_wo_version": 1, "type": "ops_manager"},
+	}
+	userChanges := expandUserChanges(oldUsers, newUsers)
+	setWriteOnlyUserPasswords(newUserList(userChanges), rawConfig)
+
+	for _, change := range userChanges {
+		switch change.New.Username {
+		case "writer":
+			assert.Equal(t, "Password-from-wo-123", change.New.Password)
+			assert.Assert(t, change.isUpdate(), "a new password_wo_version updates the password")
+		case "reader":
+			assert.Equal(t, "Reader-password-123", change.New.Password)
+			assert.Assert(t, !change.isUpdate())
+		case "manager":
+			assert.Equal(t, "Manager-from-wo-123$", change.New.Password)
+			assert.Assert(t, change.isCreate())
+		}
+	}
+
+	// Unchanged versions do not update write-only passwords, which are not in
+	// the state.
+	userChanges = expandUserChanges(newUsers[:1], newUsers[:1])
+	setWriteOnlyUserPasswords(newUserList(userChanges), rawConfig)
+	assert.Assert(t, !userChanges[0].isUpdate())
+
+	// A password that is not known yet is left for the apply.
+	unknown := []*DatabaseUser{{Username: "other", Type: "database", PasswordWOVersion: 1}}
+	setWriteOnlyUserPasswords(unknown, rawConfig)
+	assert.Equal(t, "", unknown[0].Password)
+}
+
+func TestValidateUserPasswords(t *testing.T) {
+	user := func(name string, password, version cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":                cty.StringVal(name),
+			"type":                cty.NullVal(cty.String),
+			"password":            password,
+			"password_wo_version": version,
+		})
+	}
+	config := func(users ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"users": cty.SetVal(users),
+			"user_password_wo": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name":     cty.StringVal("writer"),
+					"type":     cty.NullVal(cty.String),
+					"password": cty.UnknownVal(cty.String),
+				}),
+			}),
+		})
+	}
+	noPassword, noVersion := cty.NullVal(cty.String), cty.NullVal(cty.Number)
+
+	assert.NilError(t, validateUserPasswords(config(
+		user("writer", noPassword, cty.NumberIntVal(1)),
+		user("reader", cty.StringVal("Reader-password-123"), noVersion),
+	)))
+	// A password that is unknown until apply is still set.
+	assert.ErrorContains(t, validateUserPasswords(config(
+		user("writer", cty.UnknownVal(cty.String), cty.NumberIntVal(1)),
+	)), "Only one of password and password_wo_version")
+	assert.ErrorContains(t, validateUserPasswords(config(
+		user("writer", noPassword, noVersion),
+	)), "One of password and password_wo_version")
+	assert.ErrorContains(t, validateUserPasswords(config(
+		user("writer", cty.StringVal(""), cty.NumberIntVal(0)),
+	)), "One of password and password_wo_version")
+	assert.ErrorContains(t, validateUserPasswords(config(
+		user("other", noPassword, cty.UnknownVal(cty.Number)),
+	)), "has no user_password_wo block")
+}
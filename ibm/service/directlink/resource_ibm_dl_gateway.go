@@ -658,7 +658,20 @@ func ResourceIBMDLGatewayValidator() *validate.ResourceValidator {
 			MinValue:                   "2",
 			MaxValue:                   "3967"})
 
-	ibmISDLGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: dlType, AllowedValues: "dedicated"}},
+			Targets: []string{dlCarrierName, dlCrossConnectRouter, dlLocationName, dlCustomerName},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: dlType, AllowedValues: "connect"}},
+			Targets: []string{dlPort},
+		},
+	}
+
+	ibmISDLGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway", Schema: validateSchema, Rules: rules}
 	return &ibmISDLGatewayResourceValidator
 }
 
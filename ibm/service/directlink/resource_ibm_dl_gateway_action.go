@@ -22,11 +22,13 @@ import (
 
 func ResourceIBMDLGatewayAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreateAction,
-		Read:     resourceIBMdlGatewayActionRead,
-		Update:   resourceIBMdlGatewayActionUpdate,
-		Delete:   resourceIBMdlGatewayActionDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMdlGatewayCreateAction,
+		Read:   resourceIBMdlGatewayActionRead,
+		Update: resourceIBMdlGatewayActionUpdate,
+		Delete: resourceIBMdlGatewayActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", dlGatewayId),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -23,7 +24,9 @@ func ResourceIBMDLGatewayMacsecCak() *schema.Resource {
 		DeleteContext: resourceIBMdlGatewayMacsecCakDelete,
 		Exists:        resourceIBMdlGatewayMacsecCakExists,
 		UpdateContext: resourceIBMdlGatewayMacsecCakUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMdlGatewayMacsecCakImport,
+		},
 		Schema: map[string]*schema.Schema{
 			dlGatewayId: {
 				Type:        schema.TypeString,
@@ -285,6 +288,49 @@ func resourceIBMdlGatewayMacsecCakRead(context context.Context, d *schema.Resour
 	return nil
 }
 
+// resourceIBMdlGatewayMacsecCakImport takes an ID of the form
+// <gateway_id>/<cak_id>, since the resource ID is the gateway ID and read
+// takes the CAK ID from the state. The arguments of the CAK are set from the
+// API, as read only sets them in its computed copy.
+func resourceIBMdlGatewayMacsecCakImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of gatewayID/cakID", d.Id())
+	}
+	gatewayID, cakID := parts[0], parts[1]
+
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	getGatewayMacsecCakOptionsModel := new(directlinkv1.GetGatewayMacsecCakOptions)
+	getGatewayMacsecCakOptionsModel.ID = &gatewayID
+	getGatewayMacsecCakOptionsModel.CakID = &cakID
+
+	instance, response, err := directLink.GetGatewayMacsecCak(getGatewayMacsecCakOptionsModel)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error Get DL Gateway Macsec CAK %s\n%s", err, response)
+	}
+
+	d.SetId(gatewayID)
+	d.Set(dlGatewayId, gatewayID)
+	d.Set(dlGatewayMacsecCakID, cakID)
+	if instance.Name != nil {
+		d.Set(dlGatewayMacsecCakName, *instance.Name)
+	}
+	if instance.Session != nil {
+		d.Set(dlGatewayMacsecCakSession, *instance.Session)
+	}
+	if instance.Key != nil && instance.Key.Crn != nil {
+		d.Set(dlGatewayMacsecHPCSKey, []map[string]interface{}{{dlGatewayMacsecHPCSCrn: *instance.Key.Crn}})
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMdlGatewayMacsecCakUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
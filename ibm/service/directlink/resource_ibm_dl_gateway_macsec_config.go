@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func ResourceIBMDLGatewayMacsecConfig() *schema.Resource {
@@ -19,7 +21,9 @@ func ResourceIBMDLGatewayMacsecConfig() *schema.Resource {
 		ReadContext:   resourceIBMdlGatewayMacsecConfigRead,
 		DeleteContext: resourceIBMdlGatewayMacsecConfigDelete,
 		UpdateContext: resourceIBMdlGatewayMacsecConfigUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", dlGatewayId),
+		},
 		Schema: map[string]*schema.Schema{
 			dlGatewayId: {
 				Type:        schema.TypeString,
@@ -16,10 +16,12 @@ import (
 
 func ResourceIBMDLGatewayRouteReport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayRouteReportCreate,
-		Read:     resourceIBMDLRouteReportRead,
-		Delete:   resourceIBMdlGatewayRouteReportDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMdlGatewayRouteReportCreate,
+		Read:   resourceIBMDLRouteReportRead,
+		Delete: resourceIBMdlGatewayRouteReportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", dlGatewayId, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -38,7 +38,7 @@ func ResourceIBMDNSLinkedZone() *schema.Resource {
 		UpdateContext: resourceIBMDNSLinkedZoneUpdate,
 		DeleteContext: resourceIBMDNSLinkedZoneDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceIBMDNSLinkedZoneImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -175,6 +175,37 @@ func resourceIBMDNSLinkedZoneRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceIBMDNSLinkedZoneImport sets the name and the owner zone of the
+// linked zone, which read leaves alone as they are only sent on create.
+func resourceIBMDNSLinkedZoneImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return nil, err
+	}
+	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) < 2 {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/linkedDnsZoneID", d.Id())
+	}
+	getLinkedZoneOptions := sess.NewGetLinkedZoneOptions(idSet[0], idSet[1])
+	resource, response, err := sess.GetLinkedZone(getLinkedZoneOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] GetLinkedZone failed with error: %s and response:\n%s", err, response)
+	}
+
+	d.Set(DnsLinkedZoneName, resource.Name)
+	if resource.LinkedTo != nil {
+		// The owner instance is only returned as a CRN, whose eighth
+		// segment is the instance GUID.
+		if resource.LinkedTo.InstanceCrn != nil {
+			if crnParts := strings.Split(*resource.LinkedTo.InstanceCrn, ":"); len(crnParts) > 7 {
+				d.Set(DnsLinkedZoneOwnerInstanceID, crnParts[7])
+			}
+		}
+		d.Set(DnsLinkedZoneOwnerZoneID, resource.LinkedTo.ZoneID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
@@ -240,7 +240,22 @@ func ResourceIBMPrivateDNSCustomResolverValidator() *validate.ResourceValidator
 			AllowedValues:              "essential, advanced, premier",
 		},
 	)
-	resourceValidator := validate.ResourceValidator{ResourceName: ibmDNSCustomResolver, Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: pdnsCRHighAvailability, AllowedValues: "false", Not: true}},
+			Identifier: pdnsCustomResolverLocations + ".#",
+			MinValue:   "2",
+			Message:    "high availability needs at least two resolver locations",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: pdnsCREnabled, AllowedValues: "false", Not: true}},
+			Targets: []string{pdnsCustomResolverLocations},
+			Message: "an enabled custom resolver needs an enabled location",
+		},
+	}
+	resourceValidator := validate.ResourceValidator{ResourceName: ibmDNSCustomResolver, Schema: validateSchema, Rules: rules}
 	return &resourceValidator
 }
 
@@ -128,7 +128,16 @@ func ResourceIBMPrivateDNSForwardingRuleValidator() *validate.ResourceValidator
 		},
 	)
 
-	resourceValidator := validate.ResourceValidator{ResourceName: pdnsCRForwardRule, Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: pdnsCRFRViews, Not: true}},
+			Targets: []string{pdnsCRFRForwardTo},
+			Message: "one of forward_to or views must be set",
+		},
+	}
+
+	resourceValidator := validate.ResourceValidator{ResourceName: pdnsCRForwardRule, Schema: validateSchema, Rules: rules}
 	return &resourceValidator
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -199,6 +200,19 @@ func ResourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	}
 }
 
+func ResourceIBMPrivateDNSResourceRecordValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: pdnsRecordType, AllowedValues: "SRV"}},
+			Targets: []string{pdnsSrvPort, pdnsSrvService, pdnsSrvProtocol},
+		},
+	}
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_dns_resource_record", Rules: rules}
+	return &resourceValidator
+}
+
 func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
@@ -24,7 +24,9 @@ func ResourceIBMEnIntegration() *schema.Resource {
 		ReadContext:   resourceIBMEnIntegrationRead,
 		UpdateContext: resourceIBMEnIntegrationUpdate,
 		DeleteContext: resourceIBMEnIntegrationDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMEnIntegrationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
@@ -160,6 +162,44 @@ func resourceIBMEnIntegrationRead(context context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// resourceIBMEnIntegrationImport sets the metadata of the integration, which
+// read leaves alone as it holds the KMS settings sent on update.
+func resourceIBMEnIntegrationImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return nil, err
+	}
+
+	options := &en.GetIntegrationOptions{}
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	result, response, err := enClient.GetIntegrationWithContext(context, options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] GetIntegrationWithContext failed %s\n%s", err, response)
+	}
+
+	if result.Metadata != nil {
+		metadata := map[string]interface{}{}
+		if result.Metadata.Endpoint != nil {
+			metadata["endpoint"] = *result.Metadata.Endpoint
+		}
+		if result.Metadata.CRN != nil {
+			metadata["crn"] = *result.Metadata.CRN
+		}
+		if result.Metadata.RootKeyID != nil {
+			metadata["root_key_id"] = *result.Metadata.RootKeyID
+		}
+		d.Set("metadata", []map[string]interface{}{metadata})
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMEnIntegrationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
 	if err != nil {
@@ -24,7 +24,9 @@ func ResourceIBMEnSMTPConfiguration() *schema.Resource {
 		ReadContext:   resourceIBMEnSMTPConfigurationRead,
 		UpdateContext: resourceIBMEnSMTPConfigurationUpdate,
 		DeleteContext: resourceIBMEnSMTPConfigurationDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
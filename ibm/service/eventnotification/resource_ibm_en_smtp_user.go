@@ -24,7 +24,9 @@ func ResourceIBMEnSMTPUser() *schema.Resource {
 		ReadContext:   resourceIBMEnSMTPUserRead,
 		UpdateContext: resourceIBMEnSMTPUserUpdate,
 		DeleteContext: resourceIBMEnSMTPUserDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance_id", "", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
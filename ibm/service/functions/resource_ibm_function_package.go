@@ -6,7 +6,6 @@ package functions
 import (
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 
@@ -283,7 +282,7 @@ func resourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) er
 
 	} else {
 		d.Set("bind_package_name", fmt.Sprintf("/%s/%s", pkg.Binding.Namespace, pkg.Binding.Name))
-		c, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		c, err := conns.NewOpenWhiskClient(bxSession, &whisk.Config{
 			Namespace:         pkg.Binding.Namespace,
 			AuthToken:         wskClient.AuthToken,
 			Host:              wskClient.Host,
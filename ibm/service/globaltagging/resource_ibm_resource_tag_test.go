@@ -263,3 +263,31 @@ func testAccCheckResourceTagCreate_replace(name string) string {
         }
     `, name)
 }
+
+func TestResourceTagMockCloud(t *testing.T) {
+	cloud := acc.UseMockCloud(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: acc.TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: cloud.ProviderConfig() + testAccCheckResourceTagCreate("tf-mock-cos", []string{"env:dev"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+				),
+			},
+			{
+				Config: cloud.ProviderConfig() + testAccCheckResourceTagCreate("tf-mock-cos", []string{"env:dev", "cpu:4"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_resource_tag.tag",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+		},
+	})
+}
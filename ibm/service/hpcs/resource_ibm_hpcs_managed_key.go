@@ -25,7 +25,9 @@ func ResourceIbmManagedKey() *schema.Resource {
 		ReadContext:   ResourceIbmManagedKeyRead,
 		UpdateContext: ResourceIbmManagedKeyUpdate,
 		DeleteContext: ResourceIbmManagedKeyDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIbmManagedKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
@@ -554,6 +556,43 @@ func ResourceIbmManagedKeyRead(context context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceIbmManagedKeyImport sets the name of the template the key was
+// created from, which read leaves alone as it is only sent on create.
+func resourceIbmManagedKeyImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ukoClient, err := meta.(conns.ClientSession).UkoV4()
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 4 {
+		return nil, fmt.Errorf("Incorrect ID %s: the ID should be in the format <region>/<instance_id>/<vault_id>/<key_id>", d.Id())
+	}
+
+	getManagedKeyOptions := &ukov4.GetManagedKeyOptions{}
+	getManagedKeyOptions.SetID(id[3])
+	getManagedKeyOptions.SetUKOVault(id[2])
+
+	url, err := getUkoUrl(context, id[0], id[1], ukoClient)
+	if err != nil {
+		return nil, err
+	}
+	ukoClient.SetServiceURL(url)
+
+	managedKey, response, err := ukoClient.GetManagedKeyWithContext(context, getManagedKeyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetManagedKeyWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetManagedKeyWithContext failed %s\n%s", err, response)
+	}
+
+	if managedKey.Template != nil {
+		if err = d.Set("template_name", managedKey.Template.Name); err != nil {
+			return nil, fmt.Errorf("Error setting template_name: %s", err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func ResourceIbmManagedKeyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ukoClient, err := meta.(conns.ClientSession).UkoV4()
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,7 +18,7 @@ import (
 )
 
 func ResourceIBMIAMAccessGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMIAMAccessGroupCreate,
 		ReadContext:   resourceIBMIAMAccessGroupRead,
 		UpdateContext: resourceIBMIAMAccessGroupUpdate,
@@ -54,7 +55,9 @@ func ResourceIBMIAMAccessGroup() *schema.Resource {
 				Description: "CRN of the access group",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func resourceIBMIAMAccessGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -14,7 +14,7 @@ import (
 )
 
 func ResourceIBMIAMDynamicRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   resourceIBMIAMDynamicRuleCreate,
 		Read:     resourceIBMIAMDynamicRuleRead,
 		Update:   resourceIBMIAMDynamicRuleUpdate,
@@ -75,7 +75,9 @@ func ResourceIBMIAMDynamicRule() *schema.Resource {
 				Description: "id of the rule",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"access_group_id", "rule_id"},
+	})
 }
 func ResourceIBMIAMDynamicRuleValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ibmIAMAuthTokenEphemeralModel struct {
+	IAMAccessToken  types.String `tfsdk:"iam_access_token"`
+	IAMRefreshToken types.String `tfsdk:"iam_refresh_token"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+// iamAuthTokenRenewMargin is how long before the access token expires that
+// Terraform renews the ephemeral resource.
+const iamAuthTokenRenewMargin = time.Minute
+
+type ibmIAMAuthTokenEphemeralResource struct {
+	clientSession conns.ClientSession
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ibmIAMAuthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithRenew     = &ibmIAMAuthTokenEphemeralResource{}
+)
+
+func NewIBMIAMAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ibmIAMAuthTokenEphemeralResource{}
+}
+
+func (r *ibmIAMAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_auth_token"
+}
+
+func (r *ibmIAMAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the IAM tokens of the credentials the provider is configured with, without persisting them to the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"iam_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token.",
+			},
+			"iam_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM refresh token.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the IAM access token expires, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *ibmIAMAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientSession, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected conns.ClientSession, got: %T", req.ProviderData))
+		return
+	}
+	r.clientSession = clientSession
+}
+
+func (r *ibmIAMAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.clientSession == nil {
+		resp.Diagnostics.AddError("Error reading ibm_iam_auth_token", "The provider has not been configured.")
+		return
+	}
+	bmxSess, err := r.clientSession.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_iam_auth_token", err.Error())
+		return
+	}
+	authenticator, err := r.clientSession.IAMAuthenticator()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_iam_auth_token", err.Error())
+		return
+	}
+
+	// The authenticator refreshes the token that the provider was configured
+	// with when it is about to expire.
+	authReq, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://iam.cloud.ibm.com", nil)
+	if err == nil {
+		err = authenticator.Authenticate(authReq)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_iam_auth_token", fmt.Sprintf("Error getting an IAM access token: %s", err))
+		return
+	}
+	accessToken := authReq.Header.Get("Authorization")
+
+	model := ibmIAMAuthTokenEphemeralModel{
+		IAMAccessToken:  types.StringValue(accessToken),
+		IAMRefreshToken: types.StringValue(bmxSess.Config.IAMRefreshToken),
+		ExpiresAt:       types.StringNull(),
+	}
+	if expiresAt, ok := iamAuthTokenExpiresAt(accessToken); ok {
+		model.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+		resp.RenewAt = expiresAt.Add(-iamAuthTokenRenewMargin)
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// Renew is called when the access token is about to expire. The values that
+// were read from the ephemeral resource cannot be changed, so it only warns
+// that they expire.
+func (r *ibmIAMAuthTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	resp.Diagnostics.AddWarning("The IAM access token of ibm_iam_auth_token expires",
+		fmt.Sprintf("The IAM access token expires in less than %s. The resources that use it after it expires fail to authenticate.", iamAuthTokenRenewMargin))
+}
+
+// iamAuthTokenExpiresAt returns the expiration of an IAM access token, with
+// or without its Bearer prefix.
+func iamAuthTokenExpiresAt(token string) (time.Time, bool) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(token, "Bearer "), claims); err != nil {
+		return time.Time{}, false
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return time.Time{}, false
+	}
+	return expiresAt.Time, true
+}
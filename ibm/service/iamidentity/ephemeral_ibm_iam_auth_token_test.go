@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+)
+
+func TestAccIBMIAMAuthTokenEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAuthTokenEphemeralConfig(),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAuthTokenEphemeralConfig() string {
+	return `
+	ephemeral "ibm_iam_auth_token" "testacc_ephemeral_token" {
+	}
+`
+}
+
+// stubTokenSession provides the tokens of a configured provider, whose
+// authenticator has refreshed the access token since.
+type stubTokenSession struct {
+	conns.ClientSession
+	accessToken string
+}
+
+func (stubTokenSession) BluemixSession() (*bxsession.Session, error) {
+	return &bxsession.Session{Config: &bluemix.Config{
+		IAMAccessToken:  "Bearer configured-access-token",
+		IAMRefreshToken: "refresh-token",
+	}}, nil
+}
+
+func (s stubTokenSession) IAMAuthenticator() (core.Authenticator, error) {
+	return core.NewBearerTokenAuthenticator(s.accessToken)
+}
+
+func TestIBMIAMAuthTokenEphemeralOpen(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": expiresAt.Unix()}).SignedString([]byte("key"))
+	require.NoError(t, err)
+
+	resp := acc.OpenEphemeralResource(t, iamidentity.NewIBMIAMAuthTokenEphemeralResource(), stubTokenSession{accessToken: token}, nil)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var accessToken, refreshToken, expires types.String
+	ctx := context.Background()
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("iam_access_token"), &accessToken).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("iam_refresh_token"), &refreshToken).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("expires_at"), &expires).HasError())
+	assert.Equal(t, "Bearer "+token, accessToken.ValueString())
+	assert.Equal(t, "refresh-token", refreshToken.ValueString())
+	assert.Equal(t, expiresAt.UTC().Format(time.RFC3339), expires.ValueString())
+	assert.Equal(t, expiresAt.Add(-time.Minute), resp.RenewAt)
+}
+
+func TestIBMIAMAuthTokenEphemeralOpenOpaqueToken(t *testing.T) {
+	resp := acc.OpenEphemeralResource(t, iamidentity.NewIBMIAMAuthTokenEphemeralResource(), stubTokenSession{accessToken: "access-token"}, nil)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var expires types.String
+	require.False(t, resp.Result.GetAttribute(context.Background(), path.Root("expires_at"), &expires).HasError())
+	assert.True(t, expires.IsNull())
+	assert.True(t, resp.RenewAt.IsZero())
+}
+
+func TestIBMIAMAuthTokenEphemeralOpenUnconfigured(t *testing.T) {
+	resp := acc.OpenEphemeralResource(t, iamidentity.NewIBMIAMAuthTokenEphemeralResource(), nil, nil)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "not been configured")
+}
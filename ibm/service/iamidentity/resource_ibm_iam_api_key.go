@@ -12,12 +12,13 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
 
 func ResourceIBMIAMApiKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIbmIamApiKeyCreate,
 		ReadContext:   resourceIbmIamApiKeyRead,
 		UpdateContext: resourceIbmIamApiKeyUpdate,
@@ -46,11 +47,28 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "The account ID of the API key.",
 			},
 			"apikey": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"apikey_wo"},
+				Description:   "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
+			},
+			"apikey_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"apikey"},
+				RequiredWith:  []string{"apikey_wo_version"},
+				Description:   "You can optionally passthrough the API key value for this API key without it being persisted in the state. The same rules as for `apikey` apply to the value.",
+			},
+			"apikey_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"apikey_wo"},
+				Description:  "The version of `apikey_wo`. Changing it re-creates the API key with the current value of `apikey_wo`.",
 			},
 			"store_value": {
 				Type:        schema.TypeBool,
@@ -105,7 +123,9 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -133,6 +153,13 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
 	}
+	if _, ok := d.GetOk("apikey_wo_version"); ok {
+		apikey, err := flex.GetWriteOnlyString(d, "apikey_wo")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createApiKeyOptions.SetApikey(apikey)
+	}
 	if _, ok := d.GetOk("store_value"); ok {
 		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
 	}
@@ -147,7 +174,9 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	if _, ok := d.GetOk("apikey_wo_version"); !ok {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -15,11 +15,12 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func ResourceIBMIAMServiceAPIKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   resourceIBMIAMServiceAPIkeyCreate,
 		Read:     resourceIBMIAMServiceAPIKeyRead,
 		Update:   resourceIBMIAMServiceAPIKeyUpdate,
@@ -57,12 +58,31 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 			},
 
 			"apikey": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "API key value for this API key",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"apikey_wo"},
+				Description:   "API key value for this API key",
+			},
+
+			"apikey_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"apikey"},
+				RequiredWith:  []string{"apikey_wo_version"},
+				Description:   "API key value for this API key. The value is not persisted in the state",
+			},
+
+			"apikey_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"apikey_wo"},
+				Description:  "The version of `apikey_wo`. Changing it re-creates the API key with the current value of `apikey_wo`.",
 			},
 
 			"locked": {
@@ -117,7 +137,9 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Description: "The date and time Service API Key was modified",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 func ResourceIBMIAMServiceAPIKeyValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -171,6 +193,13 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		apikeyString := key.(string)
 		createAPIKeyOptions.Apikey = &apikeyString
 	}
+	if _, ok := d.GetOk("apikey_wo_version"); ok {
+		apikeyString, err := flex.GetWriteOnlyString(d, "apikey_wo")
+		if err != nil {
+			return err
+		}
+		createAPIKeyOptions.Apikey = &apikeyString
+	}
 
 	if strvalue := d.Get("store_value"); strvalue != nil {
 		value := strvalue.(bool)
@@ -188,7 +217,9 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	if _, ok := d.GetOk("apikey_wo_version"); !ok {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
@@ -230,7 +261,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.AccountID != nil {
 		d.Set("account_id", *apiKey.AccountID)
 	}
-	if apiKey.Apikey != nil && *apiKey.Apikey != "" {
+	if _, ok := d.GetOk("apikey_wo_version"); !ok && apiKey.Apikey != nil && *apiKey.Apikey != "" {
 		d.Set("apikey", *apiKey.Apikey)
 	}
 	if apiKey.CRN != nil {
@@ -9,13 +9,14 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMIAMServiceID() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMIAMServiceIDCreate,
 		ReadContext:   resourceIBMIAMServiceIDRead,
 		UpdateContext: resourceIBMIAMServiceIDUpdate,
@@ -64,7 +65,9 @@ func ResourceIBMIAMServiceID() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func resourceIBMIAMServiceIDCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -23,7 +23,7 @@ import (
 )
 
 func ResourceIBMIAMTrustedProfile() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileCreate,
 		ReadContext:   resourceIBMIamTrustedProfileRead,
 		UpdateContext: resourceIBMIamTrustedProfileUpdate,
@@ -137,7 +137,9 @@ func ResourceIBMIAMTrustedProfile() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func resourceIBMIamTrustedProfileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
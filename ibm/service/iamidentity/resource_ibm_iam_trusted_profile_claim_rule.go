@@ -28,7 +28,7 @@ const (
 )
 
 func ResourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileClaimRuleCreate,
 		ReadContext:   resourceIBMIamTrustedProfileClaimRuleRead,
 		UpdateContext: resourceIBMIamTrustedProfileClaimRuleUpdate,
@@ -116,7 +116,9 @@ func ResourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
 				Description: "the unique identifier of the claim rule.",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"profile_id", "rule_id"},
+	})
 }
 func ResourceIBMIAMTrustedProfileClaimRuleValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -129,7 +131,20 @@ func ResourceIBMIAMTrustedProfileClaimRuleValidator() *validate.ResourceValidato
 			CloudDataRange:             []string{"service:trusted_profile", "resolved_to:id"},
 			Required:                   true})
 
-	iBMIAMTrustedProfileClaimRuleValidator := validate.ResourceValidator{ResourceName: "ibm_iam_trusted_profile_claim_rule", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "type", AllowedValues: "Profile-CR"}},
+			Targets: []string{"cr_type"},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "type", AllowedValues: "Profile-SAML"}},
+			Targets: []string{"realm_name"},
+		},
+	}
+
+	iBMIAMTrustedProfileClaimRuleValidator := validate.ResourceValidator{ResourceName: "ibm_iam_trusted_profile_claim_rule", Schema: validateSchema, Rules: rules}
 	return &iBMIAMTrustedProfileClaimRuleValidator
 }
 
@@ -23,7 +23,7 @@ import (
 )
 
 func ResourceIBMIAMTrustedProfileLink() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMIamTrustedProfileLinkCreate,
 		ReadContext:   resourceIBMIamTrustedProfileLinkRead,
 		DeleteContext: resourceIBMIamTrustedProfileLinkDelete,
@@ -108,7 +108,9 @@ func ResourceIBMIAMTrustedProfileLink() *schema.Resource {
 				Description: "the unique identifier of the link.",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"profile_id", "link_id"},
+	})
 }
 
 func ResourceIBMIAMTrustedProfileLinkValidator() *validate.ResourceValidator {
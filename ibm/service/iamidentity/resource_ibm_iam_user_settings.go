@@ -25,7 +25,9 @@ func ResourceIBMIAMUserSettings() *schema.Resource {
 		Update:   resourceIBMIAMUserSettingsUpdate,
 		Delete:   resourceIBMIAMUserSettingsDelete,
 		Exists:   resourceIBMIAMUserSettingsExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", iamUserSettingIamID),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -18,7 +18,7 @@ import (
 )
 
 func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create: resourceIBMIAMAccessGroupPolicyCreate,
 		Read:   resourceIBMIAMAccessGroupPolicyRead,
 		Update: resourceIBMIAMAccessGroupPolicyUpdate,
@@ -266,7 +266,9 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Description: "Pattern rule follows for time-based condition",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"access_group_id", "policy_id"},
+	})
 }
 
 func ResourceIBMIAMAccessGroupPolicyValidator() *validate.ResourceValidator {
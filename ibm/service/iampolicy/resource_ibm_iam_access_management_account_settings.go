@@ -23,7 +23,9 @@ func ResourceIBMIAMAccountSettingsExternalInteraction() *schema.Resource {
 		CreateContext: resourceIBMAccountSettingsExternalInteractionSet,
 		UpdateContext: resourceIBMAccountSettingsExternalInteractionSet,
 		DeleteContext: resourceIBMAccountSettingsExternalInteractionUnSet,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "account_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"external_account_identity_interaction": {
@@ -31,7 +31,9 @@ func ResourceIBMIAMActionControlAssignment() *schema.Resource {
 		ReadContext:   resourceIBMActionControlAssignmentRead,
 		UpdateContext: resourceIBMActionControlAssignmentUpdate,
 		DeleteContext: resourceIBMActionControlAssignmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMActionControlAssignmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -407,6 +409,35 @@ func resourceIBMActionControlAssignmentRead(context context.Context, d *schema.R
 	return nil
 }
 
+// resourceIBMActionControlAssignmentImport sets the templates the assignment
+// was created from, which read leaves alone as they are only sent on create.
+func resourceIBMActionControlAssignmentImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getActionControlAssignmentOptions := &iampolicymanagementv1.GetActionControlAssignmentOptions{}
+
+	getActionControlAssignmentOptions.SetAssignmentID(d.Id())
+
+	actionControlAssignment, _, err := iamPolicyManagementClient.GetActionControlAssignmentWithContext(context, getActionControlAssignmentOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetActionControlAssignmentWithContext failed: %s", err.Error())
+	}
+
+	if actionControlAssignment.Template != nil {
+		templates := []map[string]interface{}{{
+			"id":      flex.StringValue(actionControlAssignment.Template.ID),
+			"version": flex.StringValue(actionControlAssignment.Template.Version),
+		}}
+		if err = d.Set("templates", templates); err != nil {
+			return nil, fmt.Errorf("Error setting templates: %s", err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMActionControlAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
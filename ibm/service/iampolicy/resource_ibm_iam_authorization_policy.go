@@ -19,7 +19,7 @@ import (
 )
 
 func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   resourceIBMIAMAuthorizationPolicyCreate,
 		Read:     resourceIBMIAMAuthorizationPolicyRead,
 		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
@@ -195,7 +195,9 @@ func ResourceIBMIAMAuthorizationPolicy() *schema.Resource {
 				Description: "Set transactionID for debug",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func ResourceIBMIAMAuthorizationPolicyValidator() *validate.ResourceValidator {
@@ -217,7 +219,28 @@ func ResourceIBMIAMAuthorizationPolicyValidator() *validate.ResourceValidator {
 			CloudDataRange:             []string{"resolved_to:id"},
 			Optional:                   true})
 
-	iBMIAMAuthorizationPolicyValidator := validate.ResourceValidator{ResourceName: "ibm_iam_authorization_policy", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: "subject_attributes.*.operator", AllowedValues: "stringExists"},
+				{Identifier: "subject_attributes.*.value", AllowedValues: "true, false", Not: true},
+			},
+			Targets: []string{"subject_attributes.*.value"},
+			Message: "the value of a stringExists operator is \"true\" or \"false\"",
+		},
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: "resource_attributes.*.operator", AllowedValues: "stringExists"},
+				{Identifier: "resource_attributes.*.value", AllowedValues: "true, false", Not: true},
+			},
+			Targets: []string{"resource_attributes.*.value"},
+			Message: "the value of a stringExists operator is \"true\" or \"false\"",
+		},
+	}
+
+	iBMIAMAuthorizationPolicyValidator := validate.ResourceValidator{ResourceName: "ibm_iam_authorization_policy", Schema: validateSchema, Rules: rules}
 	return &iBMIAMAuthorizationPolicyValidator
 }
 func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
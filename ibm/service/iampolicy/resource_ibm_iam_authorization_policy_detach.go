@@ -5,9 +5,10 @@ package iampolicy
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,6 +18,9 @@ func ResourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
 		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
 		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMIAMAuthorizationPolicyDetachImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -44,7 +48,7 @@ func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta
 		return fmt.Errorf("[ERROR] Error detaching authorization policy: %s", err)
 	}
 
-	d.SetId(time.Now().UTC().String())
+	d.SetId(policyID)
 
 	return resourceIBMIAMAuthorizationPolicyDetachRead(d, meta)
 }
@@ -53,6 +57,30 @@ func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+// resourceIBMIAMAuthorizationPolicyDetachImport adopts a policy that has
+// already been detached, so that the import ID is the authorization policy ID.
+func resourceIBMIAMAuthorizationPolicyDetachImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	policyID := d.Id()
+
+	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
+		PolicyID: core.StringPtr(policyID),
+	}
+	policy, resp, err := iampapClient.GetPolicy(getPolicyOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return nil, fmt.Errorf("[ERROR] Error getting authorization policy %s: %s\n%s", policyID, err, resp)
+	}
+	if err == nil && policy != nil && (policy.State == nil || *policy.State != "deleted") {
+		return nil, fmt.Errorf("[ERROR] Authorization policy %s is still attached; apply the configuration to detach it instead of importing it", policyID)
+	}
+
+	d.Set("authorization_policy_id", policyID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId("")
@@ -23,7 +23,7 @@ const (
 )
 
 func ResourceIBMIAMCustomRole() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create:   resourceIBMIAMCustomRoleCreate,
 		Read:     resourceIBMIAMCustomRoleRead,
 		Update:   resourceIBMIAMCustomRoleUpdate,
@@ -86,7 +86,9 @@ func ResourceIBMIAMCustomRole() *schema.Resource {
 				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about the resource",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+	})
 }
 
 func ResourceIBMIAMCustomRoleValidator() *validate.ResourceValidator {
@@ -32,7 +32,9 @@ func ResourceIBMIAMPolicyAssignment() *schema.Resource {
 		ReadContext:   resourceIBMPolicyAssignmentRead,
 		UpdateContext: resourceIBMPolicyAssignmentUpdate,
 		DeleteContext: resourceIBMPolicyAssignmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPolicyAssignmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -414,6 +416,35 @@ func resourceIBMPolicyAssignmentRead(context context.Context, d *schema.Resource
 	return nil
 }
 
+// resourceIBMPolicyAssignmentImport sets the templates the assignment was
+// created from, which read leaves alone as they are only sent on create.
+func resourceIBMPolicyAssignmentImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getPolicyAssignmentOptions := &iampolicymanagementv1.GetPolicyAssignmentOptions{
+		AssignmentID: core.StringPtr(d.Id()),
+		Version:      core.StringPtr("1.0"),
+	}
+
+	assignmentResponse, _, err := iamPolicyManagementClient.GetPolicyAssignmentWithContext(context, getPolicyAssignmentOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetPolicyAssignmentWithContext failed: %s", err.Error())
+	}
+
+	assignmentDetails := assignmentResponse.(*iampolicymanagementv1.PolicyTemplateAssignmentItems)
+	templateMap, err := ResourceIBMPolicyAssignmentAssignmentTemplateDetailsToMap(assignmentDetails.Template)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.Set("templates", []map[string]interface{}{templateMap}); err != nil {
+		return nil, fmt.Errorf("error setting templates: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPolicyAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
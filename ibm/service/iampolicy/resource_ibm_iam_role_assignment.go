@@ -31,7 +31,9 @@ func ResourceIBMIAMRoleAssignment() *schema.Resource {
 		ReadContext:   resourceIBMRoleAssignmentRead,
 		UpdateContext: resourceIBMRoleAssignmentUpdate,
 		DeleteContext: resourceIBMRoleAssignmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMRoleAssignmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -420,6 +422,35 @@ func resourceIBMRoleAssignmentRead(context context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// resourceIBMRoleAssignmentImport sets the templates the assignment was
+// created from, which read leaves alone as they are only sent on create.
+func resourceIBMRoleAssignmentImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getRoleAssignmentOptions := &iampolicymanagementv1.GetRoleAssignmentOptions{}
+
+	getRoleAssignmentOptions.SetAssignmentID(d.Id())
+
+	roleAssignment, _, err := iamPolicyManagementClient.GetRoleAssignmentWithContext(context, getRoleAssignmentOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetRoleAssignmentWithContext failed: %s", err.Error())
+	}
+
+	if roleAssignment.Template != nil {
+		templates := []map[string]interface{}{{
+			"id":      flex.StringValue(roleAssignment.Template.ID),
+			"version": flex.StringValue(roleAssignment.Template.Version),
+		}}
+		if err = d.Set("templates", templates); err != nil {
+			return nil, fmt.Errorf("Error setting templates: %s", err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMRoleAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
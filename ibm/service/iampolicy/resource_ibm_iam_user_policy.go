@@ -17,7 +17,7 @@ import (
 )
 
 func ResourceIBMIAMUserPolicy() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		Create: resourceIBMIAMUserPolicyCreate,
 		Read:   resourceIBMIAMUserPolicyRead,
 		Update: resourceIBMIAMUserPolicyUpdate,
@@ -257,7 +257,9 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Description: "Pattern rule follows for time-based condition",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"ibm_id", "policy_id"},
+	})
 }
 
 func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
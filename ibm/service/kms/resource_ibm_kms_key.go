@@ -200,6 +200,30 @@ func ResourceIBMKmskey() *schema.Resource {
 	}
 }
 
+func ResourceIBMKmskeyValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "encrypted_nonce"}},
+			Targets: []string{"iv_value", "payload"},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "iv_value"}},
+			Targets: []string{"encrypted_nonce"},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "standard_key", AllowedValues: "true"}},
+			Targets: []string{"encrypted_nonce", "iv_value"},
+			Message: "import tokens only apply to root keys",
+		},
+	}
+
+	ibmKmsKeyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_kms_key", Rules: rules}
+	return &ibmKmsKeyResourceValidator
+}
+
 func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	keyData, instanceID, err := ExtractAndValidateKeyDataFromSchema(d, meta)
 	if err != nil {
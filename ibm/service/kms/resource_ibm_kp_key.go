@@ -18,12 +18,14 @@ import (
 
 func ResourceIBMkey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKeyCreate,
-		Read:     resourceIBMKeyRead,
-		Update:   resourceIBMKeyUpdate,
-		Delete:   resourceIBMKeyDelete,
-		Exists:   resourceIBMKeyExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMKeyCreate,
+		Read:   resourceIBMKeyRead,
+		Update: resourceIBMKeyUpdate,
+		Delete: resourceIBMKeyDelete,
+		Exists: resourceIBMKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMKeyImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -174,6 +176,17 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIBMKeyRead(d, meta)
 }
 
+// resourceIBMKeyImport sets the instance of the key from its CRN, which is
+// the ID, as read leaves it alone.
+func resourceIBMKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	crnData := strings.Split(d.Id(), ":")
+	if len(crnData) < 3 {
+		return nil, flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be the CRN of the key", d.Id())
+	}
+	d.Set("key_protect_id", crnData[len(crnData)-3])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 	api, err := meta.(conns.ClientSession).KeyProtectAPI()
 	if err != nil {
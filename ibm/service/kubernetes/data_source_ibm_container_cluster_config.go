@@ -16,6 +16,7 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -220,26 +221,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			d.Set("config_file_path", clusterKeyDetails.FilePath)
 
 		} else {
-			var clusterKeyDetails v1.ClusterKeyInfo
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				var err error
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv, endpointType)
-				if err != nil {
-					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if conns.IsResourceTimeoutError(err) {
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv, endpointType)
-			}
+			clusterKeyDetails, err := getClusterConfigDetail(csAPI, name, configDir, admin, targetEnv, endpointType)
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
 			}
@@ -256,3 +238,29 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// getClusterConfigDetail downloads the cluster config into dir, retrying the
+// intermittent login and user lookup failures.
+func getClusterConfigDetail(csAPI v2.Clusters, name, dir string, admin bool, targetEnv v2.ClusterTargetHeader, endpointType string) (v1.ClusterKeyInfo, error) {
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, dir, admin, targetEnv, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, dir, admin, targetEnv, endpointType)
+	}
+	return clusterKeyDetails, err
+}
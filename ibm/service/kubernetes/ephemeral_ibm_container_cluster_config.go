@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+type ibmContainerClusterConfigEphemeralModel struct {
+	ClusterNameID    types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID  types.String `tfsdk:"resource_group_id"`
+	Admin            types.Bool   `tfsdk:"admin"`
+	EndpointType     types.String `tfsdk:"endpoint_type"`
+	Kubeconfig       types.String `tfsdk:"kubeconfig"`
+	Host             types.String `tfsdk:"host"`
+	Token            types.String `tfsdk:"token"`
+	CACertificate    types.String `tfsdk:"ca_certificate"`
+	AdminKey         types.String `tfsdk:"admin_key"`
+	AdminCertificate types.String `tfsdk:"admin_certificate"`
+}
+
+type ibmContainerClusterConfigEphemeralResource struct {
+	clientSession conns.ClientSession
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ibmContainerClusterConfigEphemeralResource{}
+
+func NewIBMContainerClusterConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &ibmContainerClusterConfigEphemeralResource{}
+}
+
+func (r *ibmContainerClusterConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_cluster_config"
+}
+
+func (r *ibmContainerClusterConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the kubeconfig of a cluster without writing it to disk or persisting it to the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/id of the cluster",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true will get the config for admin",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "It can specify what kind of server URL will be used for the cluster context",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The content of the kubernetes config yml file",
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"admin_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"admin_certificate": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *ibmContainerClusterConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientSession, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected conns.ClientSession, got: %T", req.ProviderData))
+		return
+	}
+	r.clientSession = clientSession
+}
+
+func (r *ibmContainerClusterConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ibmContainerClusterConfigEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.clientSession == nil {
+		resp.Diagnostics.AddError("Error reading ibm_container_cluster_config", "The provider has not been configured.")
+		return
+	}
+
+	csClient, err := r.clientSession.VpcContainerAPI()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_container_cluster_config", err.Error())
+		return
+	}
+	name := model.ClusterNameID.ValueString()
+	targetEnv := v2.ClusterTargetHeader{ResourceGroup: model.ResourceGroupID.ValueString()}
+
+	// The config is only written to a scratch directory for as long as it
+	// takes to read it back.
+	configDir, err := os.MkdirTemp("", "ibm-cluster-config-")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_container_cluster_config", fmt.Sprintf("Error creating temporary directory: %s", err))
+		return
+	}
+	defer os.RemoveAll(configDir)
+
+	clusterKeyDetails, err := getClusterConfigDetail(csClient.Clusters(), name, configDir, model.Admin.ValueBool(), targetEnv, model.EndpointType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_container_cluster_config", fmt.Sprintf("Error downloading the cluster config [%s]: %s", name, err))
+		return
+	}
+	kubeconfig, err := os.ReadFile(clusterKeyDetails.FilePath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ibm_container_cluster_config", fmt.Sprintf("Error reading the cluster config [%s]: %s", name, err))
+		return
+	}
+
+	model.Kubeconfig = types.StringValue(string(kubeconfig))
+	model.Host = types.StringValue(clusterKeyDetails.Host)
+	model.Token = types.StringValue(clusterKeyDetails.Token)
+	model.CACertificate = types.StringValue(clusterKeyDetails.ClusterCACertificate)
+	model.AdminKey = types.StringValue(clusterKeyDetails.AdminKey)
+	model.AdminCertificate = types.StringValue(clusterKeyDetails.Admin)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+)
+
+func TestAccIBMContainer_ClusterConfigEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralConfig(),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterConfigEphemeralConfig() string {
+	return fmt.Sprintf(`
+	ephemeral "ibm_container_cluster_config" "testacc_ephemeral_cluster" {
+		cluster_name_id = "%s"
+	}
+`, acc.ClusterName)
+}
+
+// stubClusterConfigSession downloads a fixed cluster config.
+type stubClusterConfigSession struct {
+	conns.ClientSession
+	clusters *stubClusters
+}
+
+func (s stubClusterConfigSession) VpcContainerAPI() (v2.ContainerServiceAPI, error) {
+	return stubContainerServiceAPI{clusters: s.clusters}, nil
+}
+
+type stubContainerServiceAPI struct {
+	v2.ContainerServiceAPI
+	clusters *stubClusters
+}
+
+func (s stubContainerServiceAPI) Clusters() v2.Clusters {
+	return s.clusters
+}
+
+type stubClusters struct {
+	v2.Clusters
+	// dir is the directory the config was written to.
+	dir    string
+	target v2.ClusterTargetHeader
+}
+
+func (c *stubClusters) GetClusterConfigDetail(name, dir string, admin bool, target v2.ClusterTargetHeader, endpointType string) (v1.ClusterKeyInfo, error) {
+	c.dir, c.target = dir, target
+	filePath := filepath.Join(dir, "config.yml")
+	kubeconfig := fmt.Sprintf("clusters:\n- name: %s\n  admin: %t\n  endpoint_type: %s\n", name, admin, endpointType)
+	if err := os.WriteFile(filePath, []byte(kubeconfig), 0600); err != nil {
+		return v1.ClusterKeyInfo{}, err
+	}
+	return v1.ClusterKeyInfo{
+		AdminKey:             "admin-key",
+		Admin:                "admin-certificate",
+		ClusterCACertificate: "ca-certificate",
+		Host:                 "https://c100.us-south.containers.cloud.ibm.com:30000",
+		Token:                "token",
+		FilePath:             filePath,
+	}, nil
+}
+
+func TestIBMContainerClusterConfigEphemeralOpen(t *testing.T) {
+	clusters := &stubClusters{}
+	resp := acc.OpenEphemeralResource(t, kubernetes.NewIBMContainerClusterConfigEphemeralResource(), stubClusterConfigSession{clusters: clusters}, map[string]interface{}{
+		"cluster_name_id":   "mycluster",
+		"resource_group_id": "rg",
+		"admin":             true,
+		"endpoint_type":     "private",
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, "rg", clusters.target.ResourceGroup)
+
+	var kubeconfig, host, token, caCertificate, adminKey, adminCertificate types.String
+	ctx := context.Background()
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("kubeconfig"), &kubeconfig).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("host"), &host).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("token"), &token).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("ca_certificate"), &caCertificate).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("admin_key"), &adminKey).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("admin_certificate"), &adminCertificate).HasError())
+	assert.Equal(t, "clusters:\n- name: mycluster\n  admin: true\n  endpoint_type: private\n", kubeconfig.ValueString())
+	assert.Equal(t, "https://c100.us-south.containers.cloud.ibm.com:30000", host.ValueString())
+	assert.Equal(t, "token", token.ValueString())
+	assert.Equal(t, "ca-certificate", caCertificate.ValueString())
+	assert.Equal(t, "admin-key", adminKey.ValueString())
+	assert.Equal(t, "admin-certificate", adminCertificate.ValueString())
+
+	// The config is not left on disk.
+	_, err := os.Stat(clusters.dir)
+	assert.True(t, os.IsNotExist(err), "The config directory %s was not removed", clusters.dir)
+}
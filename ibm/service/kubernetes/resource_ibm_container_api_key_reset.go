@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -17,6 +18,9 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 		Read:   resourceIBMContainerAPIKeyResetRead,
 		Update: resourceIBMContainerAPIKeyResetUpdate,
 		Delete: resourceIBMContainerAPIKeyResetdelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMContainerAPIKeyResetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -29,6 +33,7 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 				Type:        schema.TypeString,
 				ForceNew:    true,
 				Optional:    true,
+				Computed:    true,
 				Description: "ID of Resource Group",
 			},
 			"reset_api_key": {
@@ -73,8 +78,41 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 	return nil
 }
 func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] The ibm_container_api_key_reset id must be of the form <region>/<resource_group_id> but it is %s", d.Id())
+	}
+	d.Set("region", parts[0])
+	d.Set("resource_group_id", parts[1])
 	return nil
 }
+
+func resourceIBMContainerAPIKeyResetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Importing records a reset that already happened, so reset_api_key has to
+	// match the configuration or the next apply resets the key again. It is
+	// taken from the import ID, <region>/<resource_group_id>/<reset_api_key>,
+	// and defaults to 1 like the argument.
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, fmt.Errorf("[ERROR] Unexpected format of ID (%s), expected <region>/<resource_group_id> or <region>/<resource_group_id>/<reset_api_key>", d.Id())
+	}
+	resetAPIKey := 1
+	if len(parts) == 3 {
+		resetAPIKey, err = strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Invalid reset_api_key %q in ID (%s): %s", parts[2], d.Id(), err)
+		}
+	}
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+	d.Set("reset_api_key", resetAPIKey)
+	return []*schema.ResourceData{d}, nil
+}
 func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
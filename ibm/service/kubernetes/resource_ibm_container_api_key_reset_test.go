@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+)
+
+func TestIBMContainerAPIKeyResetImport(t *testing.T) {
+	r := kubernetes.ResourceIBMContainerAPIKeyReset()
+	for id, want := range map[string]int{
+		"us-east/c2d1e4ed0e5d4d1e8a3e0e7a63e1ec5b":   1,
+		"us-east/c2d1e4ed0e5d4d1e8a3e0e7a63e1ec5b/2": 2,
+	} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		states, err := r.Importer.State(d, nil)
+		require.NoError(t, err, id)
+		require.Len(t, states, 1)
+		assert.Equal(t, "us-east/c2d1e4ed0e5d4d1e8a3e0e7a63e1ec5b", states[0].Id(), id)
+		assert.Equal(t, want, states[0].Get("reset_api_key"), id)
+	}
+
+	for _, id := range []string{"us-east", "us-east/rg/two/many", "us-east/rg/yes"} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		_, err := r.Importer.State(d, nil)
+		assert.Error(t, err, id)
+	}
+}
@@ -119,7 +119,16 @@ func ResourceIBMContainerBindServiceValidator() *validate.ResourceValidator {
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
 
-	iBMContainerBindServiceValidator := validate.ResourceValidator{ResourceName: "ibm_container_bind_service", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "service_instance_name", Not: true}},
+			Targets: []string{"service_instance_id"},
+			Message: "one of service_instance_name or service_instance_id must be set",
+		},
+	}
+
+	iBMContainerBindServiceValidator := validate.ResourceValidator{ResourceName: "ibm_container_bind_service", Schema: validateSchema, Rules: rules}
 	return &iBMContainerBindServiceValidator
 }
 
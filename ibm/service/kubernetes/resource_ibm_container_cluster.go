@@ -597,7 +597,28 @@ func ResourceIBMContainerClusterValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              tainteffects})
 
-	ibmContainerClusterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "gateway_enabled", AllowedValues: "true"}, {Identifier: "private_service_endpoint", AllowedValues: "true", Not: true}},
+			Targets: []string{"gateway_enabled"},
+			Message: "gateway-enabled clusters have a private service endpoint",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "public_vlan_id"}},
+			Targets: []string{"private_vlan_id"},
+		},
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: "kube_version", Regexp: "(?i)openshift$"}},
+			Identifier: "default_pool_size",
+			MinValue:   "2",
+			Message:    "OpenShift clusters have at least 2 worker nodes",
+		},
+	}
+
+	ibmContainerClusterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster", Schema: validateSchema, Rules: rules}
 	return &ibmContainerClusterResourceValidator
 }
 
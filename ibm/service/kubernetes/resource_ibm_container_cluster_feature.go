@@ -107,7 +107,24 @@ func ResourceIBMContainerClusterFeatureValidator() *validate.ResourceValidator {
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
 
-	iBMContainerClusterFeatureValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_feature", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: "public_service_endpoint", Not: true}},
+			Targets:      []string{"private_service_endpoint"},
+			Message:      "one of public_service_endpoint or private_service_endpoint must be set",
+			ZeroValueSet: true,
+		},
+		{
+			Type:         validate.Conflicts,
+			When:         []validate.CrossFieldCondition{{Identifier: "private_service_endpoint", AllowedValues: "false"}},
+			Targets:      []string{"private_service_endpoint"},
+			Message:      "the private service endpoint cannot be disabled",
+			ZeroValueSet: true,
+		},
+	}
+
+	iBMContainerClusterFeatureValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_feature", Schema: validateSchema, Rules: rules}
 	return &iBMContainerClusterFeatureValidator
 }
 
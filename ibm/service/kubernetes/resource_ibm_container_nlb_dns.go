@@ -22,6 +22,9 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 		ReadContext:   resourceIbmContainerNlbDnsRead,
 		UpdateContext: resourceIbmContainerNlbDnsUpdate,
 		DeleteContext: resourceIbmContainerNlbDnsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIbmContainerNlbDnsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -139,35 +142,81 @@ func resourceIbmContainerNlbDnsRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Listing NLB DNS (%s): %s", d.Id(), err))
 	}
 
-	if nlbData != nil {
-		for _, nlbConfig := range nlbData {
-			if err = d.Set("cluster", d.Id()); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
-			}
-			if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
-			}
-			if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
-			}
-			if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
-			}
-			if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
+	// A cluster can have several NLB subdomains, so pick the one that this
+	// resource manages. Older states without nlb_host keep using the last one.
+	nlbHost := d.Get("nlb_host").(string)
+	nlbConfig := nlbData[len(nlbData)-1]
+	if nlbHost != "" {
+		found := false
+		for _, config := range nlbData {
+			if config.Nlb.NlbSubdomain == nlbHost {
+				nlbConfig, found = config, true
+				break
 			}
 		}
+		if !found {
+			log.Printf("[WARN] NLB subdomain %s not found in cluster %s, removing from state", nlbHost, d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if err = d.Set("cluster", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
+	}
+	if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
+	}
+	if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
+	}
+	if err = d.Set("nlb_ips", flattenNlbIPs(nlbConfig.Nlb.NlbIPArray)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ips: %s", err))
+	}
+	if err = d.Set("nlb_monitor_state", nlbConfig.Nlb.NlbMonitorState); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_monitor_state: %s", err))
+	}
+	if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
+	}
+	if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
+	}
+	if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
+	}
+	if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
 	}
 
 	return nil
 }
 
+func flattenNlbIPs(ips []interface{}) []string {
+	result := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if v, ok := ip.(string); ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// resourceIbmContainerNlbDnsImport takes an ID of the form <cluster>/<nlb_host>,
+// since the resource ID alone does not say which NLB subdomain is managed.
+func resourceIbmContainerNlbDnsImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("[ERROR] The ibm_container_nlb_dns id must be of the form <cluster>/<nlb_host> but it is %s", d.Id())
+	}
+	d.SetId(parts[0])
+	d.Set("nlb_host", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
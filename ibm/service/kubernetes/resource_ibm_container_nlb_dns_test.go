@@ -31,6 +31,13 @@ func TestAccIbmContainerNlbDnsBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_container_nlb_dns.container_nlb_dns", "nlb_ips.#", "3"),
 				),
 			},
+			{
+				ResourceName:            "ibm_container_nlb_dns.container_nlb_dns",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccIbmContainerNlbDnsImportStateIdFunc("ibm_container_nlb_dns.container_nlb_dns"),
+				ImportStateVerifyIgnore: []string{"resource_group_id"},
+			},
 		},
 	})
 }
@@ -75,3 +82,13 @@ func testAccCheckIbmContainerNlbDnsExists(n string, obj kubernetesserviceapiv1.N
 		return nil
 	}
 }
+
+func testAccIbmContainerNlbDnsImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["nlb_host"]), nil
+	}
+}
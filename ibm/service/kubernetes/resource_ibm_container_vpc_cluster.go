@@ -539,7 +539,30 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              tainteffects})
 
-	ibmContainerVpcClusteresourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: "kube_version", Regexp: "(?i)openshift$"}},
+			Identifier: "worker_count",
+			Scale:      "zones.#",
+			MinValue:   "2",
+			Message:    "OpenShift clusters have at least 2 worker nodes",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "kube_version", Regexp: "(?i)openshift$"}},
+			Targets: []string{"cos_instance_crn"},
+			Message: "the internal registry of OpenShift clusters is backed up to Object Storage",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "kube_version", Regexp: "(?i)openshift$", Not: true}},
+			Targets: []string{"entitlement"},
+			Message: "entitlements only apply to OpenShift clusters",
+		},
+	}
+
+	ibmContainerVpcClusteresourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema, Rules: rules}
 	return &ibmContainerVpcClusteresourceValidator
 }
 
@@ -53,11 +53,13 @@ const (
 func ResourceIBMContainerVpcWorker() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerCreate,
-		Read:     resourceIBMContainerVpcWorkerRead,
-		Delete:   resourceIBMContainerVpcWorkerDelete,
-		Exists:   resourceIBMContainerVpcWorkerExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMContainerVpcWorkerCreate,
+		Read:   resourceIBMContainerVpcWorkerRead,
+		Delete: resourceIBMContainerVpcWorkerDelete,
+		Exists: resourceIBMContainerVpcWorkerExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMContainerVpcWorkerImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
@@ -355,6 +357,21 @@ func resourceIBMContainerVpcWorkerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceIBMContainerVpcWorkerImport takes the cluster along with the worker
+// in <cluster_name>/<worker_id> format, as read does not look the worker up.
+func resourceIBMContainerVpcWorkerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be in <cluster_name>/<worker_id> format", d.Id())
+	}
+	d.Set("cluster_name", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMContainerVpcWorkerDelete(d *schema.ResourceData, meta interface{}) error {
 	// Delete operation clears only the entries from the statefiles as
 	// the replace operation involves both deletion & creation of the
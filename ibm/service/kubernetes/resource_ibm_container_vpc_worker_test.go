@@ -36,6 +36,7 @@ func TestAccIBMContainerVpcClusterWorkerBasic(t *testing.T) {
 				ResourceName:      "ibm_container_vpc_worker.test_worker",
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIBMVpcContainerWorkerImportStateIdFunc("ibm_container_vpc_worker.test_worker"),
 			},
 		},
 	})
@@ -101,6 +102,16 @@ func testAccCheckIBMVpcContainerWorkerBasic(name string) string {
 		`, name)
 }
 
+func testAccIBMVpcContainerWorkerImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_name"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIBMVpcContainerExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -111,7 +111,15 @@ func ResourceIBMContainerWorkerPoolZoneAttachmentValidator() *validate.ResourceV
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
 
-	iBMContainerWorkerPoolZoneAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_container_nlb_dns", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "public_vlan_id"}},
+			Targets: []string{"private_vlan_id"},
+		},
+	}
+
+	iBMContainerWorkerPoolZoneAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_container_worker_pool_zone_attachment", Schema: validateSchema, Rules: rules}
 	return &iBMContainerWorkerPoolZoneAttachmentValidator
 }
 
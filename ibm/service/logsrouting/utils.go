@@ -15,12 +15,11 @@ func updateClientURLWithEndpoint(logsRoutingClient *ibmcloudlogsroutingv0.IBMClo
 
 	var newServiceURL string
 	originalConfigServiceURL := logsRoutingClient.GetServiceURL()
-	endpointsFile := sess.Config.EndpointsFile
 	visibility := sess.Config.Visibility
 	region := d.Get("region").(string)
 
-	if endpointsFile != "" && visibility != "public-and-private" {
-		newServiceURL = conns.FileFallBack(endpointsFile, visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", region, originalConfigServiceURL)
+	if url := conns.ServiceEndpoint(sess, visibility, "IBMCLOUD_LOGS_ROUTING_API_ENDPOINT", region, ""); url != "" {
+		newServiceURL = url
 	} else {
 		newServiceURL = replaceRegion(originalConfigServiceURL, region)
 	}
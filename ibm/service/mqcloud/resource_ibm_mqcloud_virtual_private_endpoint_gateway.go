@@ -26,7 +26,9 @@ func ResourceIbmMqcloudVirtualPrivateEndpointGateway() *schema.Resource {
 		CreateContext: resourceIbmMqcloudVirtualPrivateEndpointGatewayCreate,
 		ReadContext:   resourceIbmMqcloudVirtualPrivateEndpointGatewayRead,
 		DeleteContext: resourceIbmMqcloudVirtualPrivateEndpointGatewayDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "service_instance_guid", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_instance_guid": {
@@ -173,6 +173,8 @@ const (
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
+	Arg_UserDataWO                           = "pi_user_data_wo"
+	Arg_UserDataWOVersion                    = "pi_user_data_wo_version"
 	Arg_UserTags                             = "pi_user_tags"
 	Arg_VirtualCoresAssigned                 = "pi_virtual_cores_assigned"
 	Arg_VirtualOpticalDevice                 = "pi_virtual_optical_device"
@@ -29,7 +29,9 @@ func ResourceIBMPICapture() *schema.Resource {
 		ReadContext:   resourceIBMPICaptureRead,
 		DeleteContext: resourceIBMPICaptureDelete,
 		UpdateContext: resourceIBMPICaptureUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "", Arg_CaptureName, Arg_CaptureDestination),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -130,6 +132,20 @@ func ResourceIBMPICapture() *schema.Resource {
 	}
 }
 
+func ResourceIBMPICaptureValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_CaptureDestination, AllowedValues: ImageCatalog, Not: true}},
+			Targets: []string{Arg_CaptureCloudStorageRegion, Arg_CaptureCloudStorageAccessKey, Arg_CaptureCloudStorageSecretKey, Arg_CaptureStorageImagePath},
+		},
+	}
+	ibmPICaptureResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_capture",
+		Rules:        rules}
+	return &ibmPICaptureResourceValidator
+}
+
 func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
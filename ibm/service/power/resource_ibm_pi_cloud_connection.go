@@ -27,7 +27,7 @@ var (
 )
 
 func ResourceIBMPICloudConnection() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPICloudConnectionCreate,
 		ReadContext:   resourceIBMPICloudConnectionRead,
 		UpdateContext: resourceIBMPICloudConnectionUpdate,
@@ -156,7 +156,9 @@ func ResourceIBMPICloudConnection() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_CloudConnectionID},
+	})
 }
 
 func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
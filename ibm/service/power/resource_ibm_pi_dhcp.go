@@ -15,6 +15,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_service_d_h_c_p"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,7 @@ import (
 )
 
 func ResourceIBMPIDhcp() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIDhcpCreate,
 		ReadContext:   resourceIBMPIDhcpRead,
 		DeleteContext: resourceIBMPIDhcpDelete,
@@ -114,7 +115,9 @@ func ResourceIBMPIDhcp() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_DhcpID},
+	})
 }
 
 func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
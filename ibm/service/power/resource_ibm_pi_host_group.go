@@ -29,7 +29,9 @@ func ResourceIBMPIHostGroup() *schema.Resource {
 		ReadContext:   resourceIBMPIHostGroupRead,
 		DeleteContext: resourceIBMPIHostGroupDelete,
 		UpdateContext: resourceIBMPIHostGroupUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIHostGroupImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -215,6 +217,28 @@ func resourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// resourceIBMPIHostGroupImport sets the workspace and the name of the host
+// group, which read leaves alone as they are only sent on create.
+func resourceIBMPIHostGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	cloudInstanceID, hostGroupID, err := splitID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
+	hostGroup, err := client.GetHostGroup(hostGroupID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_Name, hostGroup.Name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIHostGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -29,7 +30,9 @@ func ResourceIBMPIIKEPolicy() *schema.Resource {
 		ReadContext:   resourceIBMPIIKEPolicyRead,
 		UpdateContext: resourceIBMPIIKEPolicyUpdate,
 		DeleteContext: resourceIBMPIIKEPolicyDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -26,7 +26,7 @@ import (
 )
 
 func ResourceIBMPIImage() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIImageCreate,
 		ReadContext:   resourceIBMPIImageRead,
 		DeleteContext: resourceIBMPIImageDelete,
@@ -218,7 +218,43 @@ func ResourceIBMPIImage() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_ImageID},
+	})
+}
+
+func ResourceIBMPIImageValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_ImageBucketAccess, AllowedValues: Private}},
+			Targets: []string{Arg_ImageAccessKey},
+		},
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: Arg_AffinityPolicy, AllowedValues: Affinity},
+				{Identifier: Arg_ImageStoragePool, Not: true},
+				{Identifier: Arg_AffinityInstance, Not: true},
+			},
+			Targets: []string{Arg_AffinityVolume},
+			Message: fmt.Sprintf("the affinity policy requires one of %s or %s", Arg_AffinityInstance, Arg_AffinityVolume),
+		},
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: Arg_AffinityPolicy, AllowedValues: AntiAffinity},
+				{Identifier: Arg_ImageStoragePool, Not: true},
+				{Identifier: Arg_AntiAffinityInstances, Not: true},
+			},
+			Targets: []string{Arg_AntiAffinityVolumes},
+			Message: fmt.Sprintf("the anti-affinity policy requires one of %s or %s", Arg_AntiAffinityInstances, Arg_AntiAffinityVolumes),
+		},
 	}
+	ibmPIImageResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_image",
+		Rules:        rules}
+	return &ibmPIImageResourceValidator
 }
 
 func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -23,7 +23,9 @@ func ResourceIBMPIImageExport() *schema.Resource {
 		CreateContext: resourceIBMPIImageExportCreate,
 		ReadContext:   resourceIBMPIImageExportRead,
 		DeleteContext: resourceIBMPIImageExportDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_ImageID, Arg_ImageBucketName, Arg_ImageBucketRegion),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -26,7 +26,7 @@ import (
 )
 
 func ResourceIBMPIInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIInstanceCreate,
 		ReadContext:   resourceIBMPIInstanceRead,
 		UpdateContext: resourceIBMPIInstanceUpdate,
@@ -345,10 +345,27 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			Arg_UserData: {
-				Description: "Base64 encoded data to be passed in for invoking a cloud init script",
-				ForceNew:    true,
-				Optional:    true,
-				Type:        schema.TypeString,
+				ConflictsWith: []string{Arg_UserDataWO},
+				Description:   "Base64 encoded data to be passed in for invoking a cloud init script",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_UserDataWO: {
+				ConflictsWith: []string{Arg_UserData},
+				Description:   "Write-only variant of pi_user_data. The value is not persisted in the state; change pi_user_data_wo_version to recreate the instance with it",
+				Optional:      true,
+				RequiredWith:  []string{Arg_UserDataWOVersion},
+				Type:          schema.TypeString,
+				WriteOnly:     true,
+			},
+			Arg_UserDataWOVersion: {
+				Description:  "The version of pi_user_data_wo. Change it to recreate the instance with the current value of pi_user_data_wo",
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{Arg_UserDataWO},
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			Arg_UserTags: {
 				Computed:    true,
@@ -526,7 +543,52 @@ func ResourceIBMPIInstance() *schema.Resource {
 			},
 			Attr_VPMEMVolumes: vpmemVolumeSchema(),
 		},
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_InstanceID},
+	})
+}
+
+func ResourceIBMPIInstanceValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: Arg_ProcType, AllowedValues: Dedicated}},
+			Identifier: Arg_Processors,
+			MinValue:   "1",
+			MultipleOf: "1",
+			Message:    "dedicated processors are assigned in whole cores",
+		},
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: Arg_ProcType, AllowedValues: Shared + ", " + Capped}},
+			Identifier: Arg_Processors,
+			MinValue:   "0.25",
+			MultipleOf: "0.25",
+			Message:    "shared processors are assigned in quarters of a core",
+		},
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: Arg_SysType, AllowedValues: "s922"}},
+			Identifier: Arg_Processors,
+			MaxValue:   "15",
+		},
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: Arg_SysType, AllowedValues: "e980"}},
+			Identifier: Arg_Processors,
+			MaxValue:   "143",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_BootVolumeReplicationEnabled, AllowedValues: "true", Not: true}},
+			Targets: []string{Arg_ReplicationSites},
+			Message: fmt.Sprintf("replication sites require %s", Arg_BootVolumeReplicationEnabled),
+		},
 	}
+	ibmPIInstanceResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_instance",
+		Rules:        rules}
+	return &ibmPIInstanceResourceValidator
 }
 
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1491,6 +1553,15 @@ func isPIInstanceShutoffRefreshFunc(client *instance.IBMPIInstanceClient, id, in
 	}
 }
 
+// getUserData returns the user data of pi_user_data or, when
+// pi_user_data_wo_version is set, of the write-only pi_user_data_wo.
+func getUserData(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk(Arg_UserDataWOVersion); ok {
+		return flex.GetWriteOnlyString(d, Arg_UserDataWO)
+	}
+	return d.Get(Arg_UserData).(string), nil
+}
+
 // This function takes the input string and encodes into base64 if isn't already encoded
 func encodeBase64(userData string) string {
 	_, err := base64.StdEncoding.DecodeString(userData)
@@ -1726,8 +1797,11 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 		sshkey := v.(string)
 		body.SSHKeyName = sshkey
 	}
-	if u, ok := d.GetOk(Arg_UserData); ok {
-		userData := u.(string)
+	userData, err := getUserData(d)
+	if err != nil {
+		return nil, err
+	}
+	if userData != "" {
 		body.UserData = encodeBase64(userData)
 	}
 	if sys, ok := d.GetOk(Arg_SysType); ok {
@@ -1863,9 +1937,9 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 		}
 	}
 
-	var userData string
-	if u, ok := d.GetOk(Arg_UserData); ok {
-		userData = u.(string)
+	userData, err := getUserData(d)
+	if err != nil {
+		return nil, err
 	}
 
 	body := &models.PVMInstanceCreate{
@@ -26,7 +26,9 @@ func ResourceIBMPIInstanceAction() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceActionRead,
 		UpdateContext: resourceIBMPIInstanceActionUpdate,
 		DeleteContext: resourceIBMPIInstanceActionDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, Arg_InstanceID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -23,6 +24,9 @@ func ResourceIBMPIInstanceConsoleLanguage() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceConsoleLanguageRead,
 		UpdateContext: resourceIBMPIInstanceConsoleLanguageUpdate,
 		DeleteContext: resourceIBMPIInstanceConsoleLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIInstanceConsoleLanguageImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -86,6 +90,22 @@ func resourceIBMPIInstanceConsoleLanguageRead(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// The console language cannot be read back, so it may be given as a third
+// part of the import ID: <pi_cloud_instance_id>/<pi_instance_name>/<pi_language_code>.
+func resourceIBMPIInstanceConsoleLanguageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, fmt.Errorf("[ERROR] The ibm_pi_console_language id must be of the form <pi_cloud_instance_id>/<pi_instance_name>[/<pi_language_code>] but it is %s", d.Id())
+	}
+	d.Set(Arg_CloudInstanceID, parts[0])
+	d.Set(Arg_InstanceName, parts[1])
+	if len(parts) == 3 {
+		d.Set(Arg_LanguageCode, parts[2])
+	}
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIInstanceConsoleLanguageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
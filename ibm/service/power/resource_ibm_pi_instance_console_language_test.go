@@ -31,6 +31,12 @@ func TestAccIBMPIInstanceConsoleLanguage(t *testing.T) {
 						"ibm_pi_console_language.example", "pi_language_code", "e1399"),
 				),
 			},
+			{
+				ResourceName:      "ibm_pi_console_language.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s/e1399", acc.Pi_cloud_instance_id, acc.Pi_instance_name),
+			},
 		},
 	})
 }
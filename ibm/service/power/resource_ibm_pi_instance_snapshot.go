@@ -25,7 +25,9 @@ func ResourceIBMPIInstanceSnapshot() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceSnapshotRead,
 		UpdateContext: resourceIBMPIInstanceSnapshotUpdate,
 		DeleteContext: resourceIBMPIInstanceSnapshotDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIInstanceSnapshotImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -208,6 +210,31 @@ func resourceIBMPIInstanceSnapshotRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// resourceIBMPIInstanceSnapshotImport sets the workspace and the instance of
+// the snapshot, which read leaves alone as they are only sent on create. The
+// API only returns the instance ID, which pi_instance_name also accepts.
+func resourceIBMPIInstanceSnapshotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	cloudInstanceID, snapshotID, err := splitID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	snapshot := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshotdata, err := snapshot.Get(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	if snapshotdata.PvmInstanceID != nil {
+		d.Set(Arg_InstanceName, *snapshotdata.PvmInstanceID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
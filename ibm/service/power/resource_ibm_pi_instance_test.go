@@ -215,6 +215,47 @@ func testAccCheckIBMPIInstanceDeplomentTargetConfig(name string) string {
 	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name)
 }
 
+func TestAccIBMPIInstanceUserDataWO(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceUserDataWOConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_instance_name", name),
+					resource.TestCheckResourceAttr(instanceRes, "pi_user_data_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(instanceRes, "pi_user_data_wo"),
+					resource.TestCheckNoResourceAttr(instanceRes, "pi_user_data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceUserDataWOConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_instance" "power_instance" {
+		pi_cloud_instance_id    = "%[1]s"
+		pi_memory               = "2"
+		pi_processors           = "1"
+		pi_instance_name        = "%[2]s"
+		pi_proc_type            = "shared"
+		pi_image_id             = "%[3]s"
+		pi_sys_type             = "s922"
+		pi_user_data_wo         = "#cloud-config\nruncmd:\n  - echo %[2]s"
+		pi_user_data_wo_version = 1
+		pi_network {
+		  network_id = "%[4]s"
+		}
+	  }
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name)
+}
+
 func TestAccIBMPIInstanceDeploymentType(t *testing.T) {
 	instanceRes := "ibm_pi_instance.power_instance"
 	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
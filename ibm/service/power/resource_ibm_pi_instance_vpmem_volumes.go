@@ -28,7 +28,9 @@ func ResourceIBMPIInstanceVpmemVolumes() *schema.Resource {
 		CreateContext: resourceIBMPIInstanceVpmemVolumesCreate,
 		ReadContext:   resourceIBMPIInstanceVpmemVolumesRead,
 		DeleteContext: resourceIBMPIInstanceVpmemVolumesDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdPrefix("/", Arg_CloudInstanceID, Arg_PVMInstanceID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
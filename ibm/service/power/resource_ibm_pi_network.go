@@ -29,7 +29,7 @@ import (
 )
 
 func ResourceIBMPINetwork() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPINetworkCreate,
 		ReadContext:   resourceIBMPINetworkRead,
 		UpdateContext: resourceIBMPINetworkUpdate,
@@ -219,7 +219,28 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:        schema.TypeFloat,
 			},
 		},
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_NetworkID},
+	})
+}
+
+func ResourceIBMPINetworkValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_NetworkType, AllowedValues: Vlan}},
+			Targets: []string{Arg_Cidr},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_NetworkType, AllowedValues: PubVlan}},
+			Targets: []string{Arg_Cidr},
+		},
 	}
+	ibmPINetworkResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_network",
+		Rules:        rules}
+	return &ibmPINetworkResourceValidator
 }
 
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
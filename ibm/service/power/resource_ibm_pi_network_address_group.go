@@ -28,7 +28,9 @@ func ResourceIBMPINetworkAddressGroup() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkAddressGroupRead,
 		UpdateContext: resourceIBMPINetworkAddressGroupUpdate,
 		DeleteContext: resourceIBMPINetworkAddressGroupDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -26,7 +26,9 @@ func ResourceIBMPINetworkAddressGroupMember() *schema.Resource {
 		CreateContext: resourceIBMPINetworkAddressGroupMemberCreate,
 		ReadContext:   resourceIBMPINetworkAddressGroupMemberRead,
 		DeleteContext: resourceIBMPINetworkAddressGroupMemberDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdPrefix("/", Arg_CloudInstanceID, Arg_NetworkAddressGroupID),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
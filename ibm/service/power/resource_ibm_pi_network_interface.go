@@ -29,7 +29,9 @@ func ResourceIBMPINetworkInterface() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkInterfaceRead,
 		UpdateContext: resourceIBMPINetworkInterfaceUpdate,
 		DeleteContext: resourceIBMPINetworkInterfaceDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, Arg_NetworkID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
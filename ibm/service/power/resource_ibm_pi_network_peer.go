@@ -28,7 +28,9 @@ func ResourceIBMPINetworkPeer() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkPeerRead,
 		UpdateContext: resourceIBMPINetworkPeerUpdate,
 		DeleteContext: resourceIBMPINetworkPeerDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -26,7 +26,9 @@ func ResourceIBMPINetworkPeerRouteFilter() *schema.Resource {
 		CreateContext: resourceIBMPINetworkPeerRouteFilterCreate,
 		ReadContext:   resourceIBMPINetworkPeerRouteFilterRead,
 		DeleteContext: resourceIBMPINetworkPeerRouteFilterDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, Arg_NetworkPeerID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
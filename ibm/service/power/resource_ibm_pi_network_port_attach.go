@@ -25,7 +25,9 @@ func ResourceIBMPINetworkPortAttach() *schema.Resource {
 		CreateContext: resourceIBMPINetworkPortAttachCreate,
 		ReadContext:   resourceIBMPINetworkPortAttachRead,
 		DeleteContext: resourceIBMPINetworkPortAttachDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, Arg_NetworkName, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -29,7 +29,9 @@ func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkSecurityGroupRead,
 		UpdateContext: resourceIBMPINetworkSecurityGroupUpdate,
 		DeleteContext: resourceIBMPINetworkSecurityGroupDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
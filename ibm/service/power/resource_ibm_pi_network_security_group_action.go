@@ -25,7 +25,9 @@ func ResourceIBMPINetworkSecurityGroupAction() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkSecurityGroupActionRead,
 		UpdateContext: resourceIBMPINetworkSecurityGroupActionUpdate,
 		DeleteContext: resourceIBMPINetworkSecurityGroupActionDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPINetworkSecurityGroupActionImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(50 * time.Minute),
@@ -104,6 +106,33 @@ func resourceIBMPINetworkSecurityGroupActionRead(ctx context.Context, d *schema.
 
 	return nil
 }
+
+// resourceIBMPINetworkSecurityGroupActionImport sets the workspace and the
+// action matching the state of network security groups in it, which read
+// leaves alone as the action is only sent on create and update.
+func resourceIBMPINetworkSecurityGroupActionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	wsclient := instance.NewIBMPIWorkspacesClient(ctx, sess, d.Id())
+	ws, err := wsclient.Get(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set(Arg_CloudInstanceID, d.Id())
+	if ws.Details.NetworkSecurityGroups != nil && ws.Details.NetworkSecurityGroups.State != nil {
+		switch *ws.Details.NetworkSecurityGroups.State {
+		case State_Active:
+			d.Set(Arg_Action, Enable)
+		case State_Inactive:
+			d.Set(Arg_Action, Disable)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPINetworkSecurityGroupActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -29,7 +29,9 @@ func ResourceIBMPINetworkSecurityGroupMember() *schema.Resource {
 		CreateContext: resourceIBMPINetworkSecurityGroupMemberCreate,
 		ReadContext:   resourceIBMPINetworkSecurityGroupMemberRead,
 		DeleteContext: resourceIBMPINetworkSecurityGroupMemberDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdPrefix("/", Arg_CloudInstanceID, Arg_NetworkSecurityGroupID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
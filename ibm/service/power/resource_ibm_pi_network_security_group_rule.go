@@ -26,7 +26,9 @@ func ResourceIBMPINetworkSecurityGroupRule() *schema.Resource {
 		CreateContext: resourceIBMPINetworkSecurityGroupRuleCreate,
 		ReadContext:   resourceIBMPINetworkSecurityGroupRuleRead,
 		DeleteContext: resourceIBMPINetworkSecurityGroupRuleDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdPrefix("/", Arg_CloudInstanceID, Arg_NetworkSecurityGroupID),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -397,6 +399,21 @@ func ResourceIBMPINetworkSecurityGroupRule() *schema.Resource {
 	}
 }
 
+func ResourceIBMPINetworkSecurityGroupRuleValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_Protocol + ".0." + Attr_Type, AllowedValues: All + ", " + ICMP}},
+			Targets: []string{Arg_DestinationPorts, Arg_DestinationPort, Arg_SourcePorts, Arg_SourcePort},
+			Message: "ports only apply to the tcp and udp protocols",
+		},
+	}
+	ibmPINetworkSecurityGroupRuleResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_network_security_group_rule",
+		Rules:        rules}
+	return &ibmPINetworkSecurityGroupRuleResourceValidator
+}
+
 func resourceIBMPINetworkSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
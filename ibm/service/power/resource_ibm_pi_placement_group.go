@@ -23,7 +23,7 @@ import (
 )
 
 func ResourceIBMPIPlacementGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIPlacementGroupCreate,
 		ReadContext:   resourceIBMPIPlacementGroupRead,
 		UpdateContext: resourceIBMPIPlacementGroupUpdate,
@@ -91,7 +91,9 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_PlacementGroupID},
+	})
 }
 
 func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -20,7 +20,7 @@ import (
 )
 
 func ResourceIBMPISnapshot() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPISnapshotCreate,
 		ReadContext:   resourceIBMPISnapshotRead,
 		UpdateContext: resourceIBMPISnapshotUpdate,
@@ -115,7 +115,9 @@ func ResourceIBMPISnapshot() *schema.Resource {
 			},
 		},
 		DeprecationMessage: "Resource ibm_pi_snapshot is deprecated. Use `ibm_pi_instance_snapshot` resource instead.",
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_SnapshotID},
+	})
 }
 
 func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
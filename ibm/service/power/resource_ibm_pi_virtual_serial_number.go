@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,7 +25,9 @@ func ResourceIBMPIVirtualSerialNumber() *schema.Resource {
 		ReadContext:   resourceIBMPIVirtualSerialNumberRead,
 		UpdateContext: resourceIBMPIVirtualSerialNumberUpdate,
 		DeleteContext: resourceIBMPIVirtualSerialNumberDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -75,6 +78,25 @@ func ResourceIBMPIVirtualSerialNumber() *schema.Resource {
 	}
 }
 
+func ResourceIBMPIVirtualSerialNumberValidator() *validate.ResourceValidator {
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_Serial, AllowedValues: AutoAssign}},
+			Targets: []string{Arg_InstanceID},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_SoftwareTier}},
+			Targets: []string{Arg_InstanceID},
+		},
+	}
+	ibmPIVirtualSerialNumberResourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_pi_virtual_serial_number",
+		Rules:        rules}
+	return &ibmPIVirtualSerialNumberResourceValidator
+}
+
 func resourceIBMPIVirtualSerialNumberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -24,7 +24,7 @@ import (
 )
 
 func ResourceIBMPIVolume() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIVolumeCreate,
 		ReadContext:   resourceIBMPIVolumeRead,
 		UpdateContext: resourceIBMPIVolumeUpdate,
@@ -231,7 +231,9 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_VolumeID},
+	})
 }
 
 func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
@@ -243,9 +245,18 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "affinity, anti-affinity"})
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: Arg_ReplicationEnabled, AllowedValues: "true", Not: true}},
+			Targets: []string{Arg_ReplicationSites},
+			Message: fmt.Sprintf("replication sites require %s", Arg_ReplicationEnabled),
+		},
+	}
 	ibmPIVolumeResourceValidator := validate.ResourceValidator{
 		ResourceName: "ibm_pi_volume",
-		Schema:       validateSchema}
+		Schema:       validateSchema,
+		Rules:        rules}
 	return &ibmPIVolumeResourceValidator
 }
 
@@ -21,7 +21,7 @@ import (
 )
 
 func ResourceIBMPIVolumeAttach() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMPIVolumeAttachCreate,
 		ReadContext:   resourceIBMPIVolumeAttachRead,
 		DeleteContext: resourceIBMPIVolumeAttachDelete,
@@ -61,7 +61,9 @@ func ResourceIBMPIVolumeAttach() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{Arg_CloudInstanceID, Attr_InstanceID, Attr_VolumeID},
+	})
 }
 
 func resourceIBMPIVolumeAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
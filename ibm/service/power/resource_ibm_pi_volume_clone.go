@@ -24,7 +24,9 @@ func ResourceIBMPIVolumeClone() *schema.Resource {
 		CreateContext: resourceIBMPIVolumeCloneCreate,
 		ReadContext:   resourceIBMPIVolumeCloneRead,
 		DeleteContext: resourceIBMPIVolumeCloneDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIVolumeCloneImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
@@ -193,6 +195,34 @@ func resourceIBMPIVolumeCloneRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceIBMPIVolumeCloneImport sets the workspace and the source volumes of
+// the clone, which read leaves alone as they are only sent on create.
+func resourceIBMPIVolumeCloneImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	cloudInstanceID, vcTaskID, err := splitID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client := instance.NewIBMPICloneVolumeClient(ctx, sess, cloudInstanceID)
+	volCloneTask, err := client.Get(vcTaskID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	if volCloneTask.ClonedVolumes != nil {
+		volumeIDs := make([]string, 0, len(volCloneTask.ClonedVolumes))
+		for _, clonedVolume := range volCloneTask.ClonedVolumes {
+			volumeIDs = append(volumeIDs, clonedVolume.SourceVolumeID)
+		}
+		d.Set(Arg_VolumeIDs, volumeIDs)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIVolumeCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// There is no delete or unset concept for volume clone
 	d.SetId("")
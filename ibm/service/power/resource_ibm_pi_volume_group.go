@@ -27,7 +27,9 @@ func ResourceIBMPIVolumeGroup() *schema.Resource {
 		ReadContext:   resourceIBMPIVolumeGroupRead,
 		UpdateContext: resourceIBMPIVolumeGroupUpdate,
 		DeleteContext: resourceIBMPIVolumeGroupDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
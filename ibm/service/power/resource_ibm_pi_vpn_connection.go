@@ -29,7 +29,9 @@ func ResourceIBMPIVPNConnection() *schema.Resource {
 		ReadContext:   resourceIBMPIVPNConnectionRead,
 		UpdateContext: resourceIBMPIVPNConnectionUpdate,
 		DeleteContext: resourceIBMPIVPNConnectionDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", Arg_CloudInstanceID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -23,7 +23,9 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 		DeleteContext: resourceIBMPIWorkspaceDelete,
 		ReadContext:   resourceIBMPIWorkspaceRead,
 		UpdateContext: resourceIBMPIWorkspaceUpdate,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIWorkspaceImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -202,6 +204,25 @@ func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// resourceIBMPIWorkspaceImport sets the datacenter and the resource group of
+// the workspace, which read leaves alone as they are only sent on create.
+func resourceIBMPIWorkspaceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, err
+	}
+	cloudInstanceID := d.Id()
+	client := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	controller, _, err := client.GetRC(cloudInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set(Arg_Datacenter, controller.RegionID)
+	d.Set(Arg_ResourceGroupID, controller.ResourceGroupID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -28,7 +28,9 @@ func ResourceIbmProjectConfig() *schema.Resource {
 		ReadContext:   resourceIbmProjectConfigRead,
 		UpdateContext: resourceIbmProjectConfigUpdate,
 		DeleteContext: resourceIbmProjectConfigDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "project_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": &schema.Schema{
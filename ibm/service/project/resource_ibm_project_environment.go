@@ -28,7 +28,9 @@ func ResourceIbmProjectEnvironment() *schema.Resource {
 		ReadContext:   resourceIbmProjectEnvironmentRead,
 		UpdateContext: resourceIbmProjectEnvironmentUpdate,
 		DeleteContext: resourceIbmProjectEnvironmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "project_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": &schema.Schema{
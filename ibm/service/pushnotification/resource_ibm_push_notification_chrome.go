@@ -4,17 +4,20 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMPNApplicationChrome() *schema.Resource {
 	return &schema.Resource{
-		Read:     resourceApplicationChromeRead,
-		Create:   resourceApplicationChromeCreate,
-		Update:   resourceApplicationChromeUpdate,
-		Delete:   resourceApplicationChromeDelete,
-		Importer: &schema.ResourceImporter{},
+		Read:   resourceApplicationChromeRead,
+		Create: resourceApplicationChromeCreate,
+		Update: resourceApplicationChromeUpdate,
+		Delete: resourceApplicationChromeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "guid"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"guid": {
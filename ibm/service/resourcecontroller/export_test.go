@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"testing"
+	"time"
+)
+
+// SetResourceInstancePollInterval polls the resource instances every
+// interval for the duration of t.
+func SetResourceInstancePollInterval(t *testing.T, interval time.Duration) {
+	previous := resourceInstancePollInterval
+	resourceInstancePollInterval = interval
+	t.Cleanup(func() { resourceInstancePollInterval = previous })
+}
@@ -35,6 +35,11 @@ const (
 	RsInstanceUpdateSuccessStatus = "succeeded"
 )
 
+// resourceInstancePollInterval is how often a resource instance is polled
+// while it is created, updated or deleted. Tests against the mock cloud,
+// where it changes at once, shorten it.
+var resourceInstancePollInterval = 10 * time.Second
+
 func ResourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		Create:   ResourceIBMResourceInstanceCreate,
@@ -893,8 +898,8 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 
 		},
 		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      resourceInstancePollInterval,
+		MinTimeout: resourceInstancePollInterval,
 	}
 
 	return stateConf.WaitForStateContext(context.Background())
@@ -934,8 +939,8 @@ func WaitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 
 		},
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      resourceInstancePollInterval,
+		MinTimeout: resourceInstancePollInterval,
 	}
 
 	return stateConf.WaitForStateContext(context.Background())
@@ -967,8 +972,8 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 			return instance, *instance.State, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      resourceInstancePollInterval,
+		MinTimeout: resourceInstancePollInterval,
 	}
 
 	return stateConf.WaitForStateContext(context.Background())
@@ -8,14 +8,18 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMResourceInstanceBasic(t *testing.T) {
@@ -466,3 +470,76 @@ func testAccCheckIBMResourceInstanceOnetimeCredentals(serviceName string) string
             
     `, serviceName)
 }
+
+func TestIBMResourceInstanceMockCloud(t *testing.T) {
+	cloud := acc.UseMockCloud(t)
+	resourcecontroller.SetResourceInstancePollInterval(t, 10*time.Millisecond)
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: acc.TestAccProviderFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if rs.Type == "ibm_resource_instance" && cloud.Exists("resource_instances", rs.Primary.ID) {
+					return fmt.Errorf("Resource instance still exists: %s", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cloud.ProviderConfig() + testAccCheckIBMResourceInstanceBasic("tf-mock-cos"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-mock-cos"),
+					resource.TestCheckResourceAttr(resourceName, "plan", "standard"),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+				),
+			},
+			{
+				Config: cloud.ProviderConfig() + testAccCheckIBMResourceInstanceBasic("tf-mock-cos-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-mock-cos-renamed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_time_minutes", "parameters"},
+			},
+		},
+	})
+}
+
+func TestIBMResourceInstanceLifecycleMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	resourcecontroller.SetResourceInstancePollInterval(t, 10*time.Millisecond)
+	l := acc.NewLifecycle(t, cloud, "ibm_resource_instance")
+
+	state := l.Apply(nil, map[string]interface{}{
+		"name":     "tf-mock-cos",
+		"service":  "cloud-object-storage",
+		"plan":     "lite",
+		"location": "global",
+	})
+	assert.True(t, cloud.Exists("resource_instances", state.ID))
+	assert.Equal(t, "active", state.Attributes["status"])
+	assert.Equal(t, "lite", state.Attributes["plan"])
+	assert.Equal(t, mockcloud.DefaultResourceGroupID, state.Attributes["resource_group_id"])
+
+	state = l.Apply(state, map[string]interface{}{
+		"name":     "tf-mock-cos-renamed",
+		"service":  "cloud-object-storage",
+		"plan":     "standard",
+		"location": "global",
+	})
+	assert.Equal(t, "tf-mock-cos-renamed", state.Attributes["name"])
+	assert.Equal(t, "standard", state.Attributes["plan"])
+	assert.Equal(t, "2", state.Attributes["plan_history.#"])
+
+	acc.AssertImported(t, state, l.ImportState(state.ID), "parameters")
+
+	l.Destroy(state)
+	assert.False(t, cloud.Exists("resource_instances", state.ID))
+}
@@ -215,7 +215,16 @@ func ResourceIBMResourceKeyValidator() *validate.ResourceValidator {
 			CloudDataRange:             []string{"service:%s"},
 			Optional:                   true})
 
-	ibmResourceKeyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_key", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "resource_alias_id", Not: true}},
+			Targets: []string{"resource_instance_id"},
+			Message: "one of resource_instance_id or resource_alias_id must be set",
+		},
+	}
+
+	ibmResourceKeyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_key", Schema: validateSchema, Rules: rules}
 	return &ibmResourceKeyResourceValidator
 }
 
@@ -31,7 +31,10 @@ func ResourceIBMResourceReclamationDelete() *schema.Resource {
 		CreateContext: resourceIBMResourceReclamationDeleteCreate,
 		ReadContext:   resourceIBMResourceReclamationDeleteRead,
 		DeleteContext: resourceIBMResourceReclamationDeleteDelete,
-		Description:   "Permanently delete a reclaimed resource. This action is irreversible and equivalent to 'ibmcloud resource reclamation-delete'.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMResourceReclamationDeleteImport,
+		},
+		Description: "Permanently delete a reclaimed resource. This action is irreversible and equivalent to 'ibmcloud resource reclamation-delete'.",
 
 		Schema: map[string]*schema.Schema{
 			"reclamation_id": {
@@ -234,6 +237,16 @@ func resourceIBMResourceReclamationDeleteRead(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// resourceIBMResourceReclamationDeleteImport adopts a reclamation that has
+// already been deleted. The reclamation is usually gone by then, so the ID is
+// the only argument that can be restored.
+func resourceIBMResourceReclamationDeleteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("reclamation_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 // resourceIBMResourceReclamationDeleteDelete implements the Terraform Delete action.
 // Since this resource represents a deletion action, we just clear the state.
 func resourceIBMResourceReclamationDeleteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
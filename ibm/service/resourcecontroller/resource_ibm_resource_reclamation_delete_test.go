@@ -22,6 +22,12 @@ func TestAccIBMResourceReclamationDelete_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_resource_reclamation_delete.test", "comment", "Terraform test permanent deletion"),
 				),
 			},
+			{
+				ResourceName:            "ibm_resource_reclamation_delete.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"comment", "request_by"},
+			},
 		},
 	})
 }
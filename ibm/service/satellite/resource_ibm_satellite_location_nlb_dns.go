@@ -20,7 +20,9 @@ func ResourceIBMSatelliteLocationNlbDns() *schema.Resource {
 		CreateContext: resourceIbmSatelliteLocationNlbDnsCreate,
 		ReadContext:   resourceIbmSatelliteLocationNlbDnsRead,
 		DeleteContext: resourceIbmSatelliteLocationNlbDnsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIbmSatelliteLocationNlbDnsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"location": {
@@ -94,6 +96,33 @@ func resourceIbmSatelliteLocationNlbDnsRead(context context.Context, d *schema.R
 	return nil
 }
 
+// resourceIbmSatelliteLocationNlbDnsImport takes the location as ID. The IPs
+// registered for the location are only read here, since a location may gain
+// other NLB IPs that must not replace the resource on the next read.
+func resourceIbmSatelliteLocationNlbDnsImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	nlbClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	nlbList, err := nlbClient.NlbDns().GetLocationNLBDNSList(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] GetSatLocationNlbDNSListWithContext failed %s", err)
+	}
+
+	ips := []string{}
+	for _, nlb := range nlbList {
+		for _, ip := range nlb.Nlb.NlbIPArray {
+			if ip, ok := ip.(string); ok {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	d.Set("location", d.Id())
+	d.Set("ips", ips)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIbmSatelliteLocationNlbDnsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
@@ -5,6 +5,7 @@ package scc
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,5 +21,10 @@ func ResourceIBMSccRuleAttachment() *schema.Resource {
 		DeleteContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 			return diag.Errorf("resource ibm_scc_rule_attachment has been deprecated")
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return nil, fmt.Errorf("resource ibm_scc_rule_attachment has been deprecated")
+			},
+		},
 	}
 }
@@ -27,7 +27,9 @@ func ResourceIBMSdsVolumeMapping() *schema.Resource {
 		ReadContext:   resourceIBMSdsVolumeMappingRead,
 		UpdateContext: resourceIBMSdsVolumeMappingUpdate,
 		DeleteContext: resourceIBMSdsVolumeMappingDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "host_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"sds_endpoint": &schema.Schema{
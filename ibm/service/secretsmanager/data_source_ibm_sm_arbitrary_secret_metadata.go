@@ -122,7 +122,7 @@ func DataSourceIbmSmArbitrarySecretMetadata() *schema.Resource {
 }
 
 func dataSourceIbmSmArbitrarySecretMetadataRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s_metadata", ArbitrarySecretResourceName), "read")
 		return tfErr.GetDiag()
@@ -130,7 +130,7 @@ func dataSourceIbmSmArbitrarySecretMetadataRead(context context.Context, d *sche
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
 
@@ -200,7 +200,7 @@ func DataSourceIbmSmConfigurations() *schema.Resource {
 }
 
 func dataSourceIbmSmConfigurationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", ConfigurationsResourceName), "read")
 		return tfErr.GetDiag()
@@ -208,7 +208,7 @@ func dataSourceIbmSmConfigurationsRead(context context.Context, d *schema.Resour
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	listConfigurationsOptions := &secretsmanagerv2.ListConfigurationsOptions{}
 	sort, ok := d.GetOk("sort")
@@ -137,7 +137,7 @@ func DataSourceIbmSmCustomCredentialsConfiguration() *schema.Resource {
 }
 
 func dataSourceIbmSmCustomCredentialsConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", CustomCredentialsConfigResourceName), "read")
 		return tfErr.GetDiag()
@@ -145,7 +145,7 @@ func dataSourceIbmSmCustomCredentialsConfigurationRead(context context.Context,
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
 
@@ -202,7 +202,7 @@ func DataSourceIbmSmCustomCredentialsSecretMetadata() *schema.Resource {
 }
 
 func dataSourceIbmSmCustomCredentialsSecretMetadataRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s_metadata", CustomCredentialsSecretResourceName), "read")
 		return tfErr.GetDiag()
@@ -210,7 +210,7 @@ func dataSourceIbmSmCustomCredentialsSecretMetadataRead(context context.Context,
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
 
@@ -522,7 +522,7 @@ func DataSourceIbmSmSecrets() *schema.Resource {
 }
 
 func dataSourceIbmSmSecretsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretsResourceName), "read")
 		return tfErr.GetDiag()
@@ -530,7 +530,7 @@ func dataSourceIbmSmSecretsRead(context context.Context, d *schema.ResourceData,
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
 	sort, ok := d.GetOk("sort")
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ibmSmArbitrarySecretEphemeralModel struct {
+	smSecretEphemeralModel
+	Crn            types.String `tfsdk:"crn"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Payload        types.String `tfsdk:"payload"`
+}
+
+type ibmSmArbitrarySecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ibmSmArbitrarySecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &ibmSmArbitrarySecretEphemeralResource{}
+
+func NewIbmSmArbitrarySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &ibmSmArbitrarySecretEphemeralResource{}
+}
+
+func (r *ibmSmArbitrarySecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ArbitrarySecretResourceName
+}
+
+func (r *ibmSmArbitrarySecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an arbitrary secret without persisting its payload to the Terraform state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret's data payload.",
+			},
+		}),
+	}
+}
+
+func (r *ibmSmArbitrarySecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	r.validateLocator(ctx, req, resp)
+}
+
+func (r *ibmSmArbitrarySecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ibmSmArbitrarySecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.getSecret(ctx, &model.smSecretEphemeralModel, ArbitrarySecretType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading "+ArbitrarySecretResourceName, err.Error())
+		return
+	}
+	arbitrarySecret, ok := secret.(*secretsmanagerv2.ArbitrarySecret)
+	if !ok {
+		resp.Diagnostics.AddError("Error reading "+ArbitrarySecretResourceName, "Wrong secret type: The provided secret is not an Arbitrary secret.")
+		return
+	}
+
+	model.SecretID = types.StringPointerValue(arbitrarySecret.ID)
+	model.Name = types.StringPointerValue(arbitrarySecret.Name)
+	model.Crn = types.StringPointerValue(arbitrarySecret.Crn)
+	model.ExpirationDate = types.StringValue(DateTimeToRFC3339(arbitrarySecret.ExpirationDate))
+	model.Payload = types.StringPointerValue(arbitrarySecret.Payload)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+func TestAccIbmSmArbitrarySecretEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance", "secret_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_arbitrary_secret.sm_ephemeral_payload", "payload", "secret-credentials/secret-credentials"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmArbitrarySecretEphemeralConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_instance" {
+			name = "test_arbitrary_secret_ephemeral_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_arbitrary_secret.sm_arbitrary_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion) +
+		testAccCheckIbmSmEphemeralPayloadConfig("arbitrary-secret-ephemeral-payload-terraform-test", `"${ephemeral.ibm_sm_arbitrary_secret.sm_arbitrary_secret.payload}/${ephemeral.ibm_sm_arbitrary_secret.sm_arbitrary_secret_by_name.payload}"`)
+}
+
+func TestIbmSmArbitrarySecretEphemeralOpen(t *testing.T) {
+	session := newStubSecretsManagerSession(t, map[string]interface{}{
+		"id":              "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+		"name":            "arbitrary-secret",
+		"secret_type":     "arbitrary",
+		"payload":         "secret-payload",
+		"expiration_date": "2030-01-02T03:04:05.000Z",
+	})
+
+	resp := acc.OpenEphemeralResource(t, secretsmanager.NewIbmSmArbitrarySecretEphemeralResource(), session, map[string]interface{}{
+		"instance_id":   "instance",
+		"region":        "eu-de",
+		"endpoint_type": "private",
+		"secret_id":     "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, []string{"instance.private.eu-de.secrets-manager.appdomain.cloud/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46"}, session.requests)
+
+	var name, payload, expirationDate types.String
+	ctx := context.Background()
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("name"), &name).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("payload"), &payload).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("expiration_date"), &expirationDate).HasError())
+	assert.Equal(t, "arbitrary-secret", name.ValueString())
+	assert.Equal(t, "secret-payload", payload.ValueString())
+	assert.Equal(t, "2030-01-02T03:04:05Z", expirationDate.ValueString())
+}
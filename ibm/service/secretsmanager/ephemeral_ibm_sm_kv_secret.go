@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ibmSmKvSecretEphemeralModel struct {
+	smSecretEphemeralModel
+	Crn  types.String `tfsdk:"crn"`
+	Data types.Map    `tfsdk:"data"`
+}
+
+type ibmSmKvSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ibmSmKvSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &ibmSmKvSecretEphemeralResource{}
+
+func NewIbmSmKvSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &ibmSmKvSecretEphemeralResource{}
+}
+
+func (r *ibmSmKvSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = KvSecretResourceName
+}
+
+func (r *ibmSmKvSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a key-value secret without persisting its payload to the Terraform state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret.",
+			},
+		}),
+	}
+}
+
+func (r *ibmSmKvSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	r.validateLocator(ctx, req, resp)
+}
+
+func (r *ibmSmKvSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ibmSmKvSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.getSecret(ctx, &model.smSecretEphemeralModel, KvSecretType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading "+KvSecretResourceName, err.Error())
+		return
+	}
+	kvSecret, ok := secret.(*secretsmanagerv2.KVSecret)
+	if !ok {
+		resp.Diagnostics.AddError("Error reading "+KvSecretResourceName, "Wrong secret type: The provided secret is not a KV secret.")
+		return
+	}
+
+	model.SecretID = types.StringPointerValue(kvSecret.ID)
+	model.Name = types.StringPointerValue(kvSecret.Name)
+	model.Crn = types.StringPointerValue(kvSecret.Crn)
+	data, diags := types.MapValueFrom(ctx, types.StringType, map[string]string(flex.Flatten(kvSecret.Data)))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Data = data
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
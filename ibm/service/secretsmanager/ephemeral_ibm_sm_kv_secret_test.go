@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+func TestAccIbmSmKvSecretEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretEphemeralConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_kv_secret.sm_kv_secret_instance", "secret_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_arbitrary_secret.sm_ephemeral_payload", "payload", "value/value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmKvSecretEphemeralConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_kv_secret" "sm_kv_secret_instance" {
+			name = "kv-secret-ephemeral-terraform-test"
+			instance_id   = "%s"
+			region        = "%s"
+			data = {"key":"value"}
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_kv_secret" "sm_kv_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_kv_secret.sm_kv_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_kv_secret" "sm_kv_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_kv_secret.sm_kv_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion) +
+		testAccCheckIbmSmEphemeralPayloadConfig("kv-secret-ephemeral-payload-terraform-test", `"${ephemeral.ibm_sm_kv_secret.sm_kv_secret.data["key"]}/${ephemeral.ibm_sm_kv_secret.sm_kv_secret_by_name.data["key"]}"`)
+}
+
+func TestIbmSmKvSecretEphemeralOpen(t *testing.T) {
+	session := newStubSecretsManagerSession(t, map[string]interface{}{
+		"id":          "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+		"name":        "kv-secret",
+		"crn":         "crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+		"secret_type": "kv",
+		"data":        map[string]interface{}{"key": "value"},
+	})
+
+	resp := acc.OpenEphemeralResource(t, secretsmanager.NewIbmSmKvSecretEphemeralResource(), session, map[string]interface{}{
+		"instance_id":       "instance",
+		"region":            "us-south",
+		"name":              "kv-secret",
+		"secret_group_name": "default",
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, []string{"instance.us-south.secrets-manager.appdomain.cloud/api/v2/secret_groups/default/secret_types/kv/secrets/kv-secret"}, session.requests)
+
+	var secretID, crn types.String
+	var data map[string]string
+	ctx := context.Background()
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("secret_id"), &secretID).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("crn"), &crn).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("data"), &data).HasError())
+	assert.Equal(t, "0b5571f7-21e6-42b7-91c5-3f5ac9793a46", secretID.ValueString())
+	assert.Contains(t, crn.ValueString(), "secrets-manager")
+	assert.Equal(t, map[string]string{"key": "value"}, data)
+}
+
+func TestIbmSmKvSecretEphemeralOpenWrongType(t *testing.T) {
+	session := newStubSecretsManagerSession(t, map[string]interface{}{
+		"id":          "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+		"secret_type": "arbitrary",
+		"payload":     "secret",
+	})
+
+	resp := acc.OpenEphemeralResource(t, secretsmanager.NewIbmSmKvSecretEphemeralResource(), session, map[string]interface{}{
+		"instance_id": "instance",
+		"region":      "us-south",
+		"secret_id":   "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+	})
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "not a KV secret")
+	assert.Equal(t, []string{"instance.us-south.secrets-manager.appdomain.cloud/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46"}, session.requests)
+}
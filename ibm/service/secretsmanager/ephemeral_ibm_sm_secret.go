@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// smSecretEphemeralModel holds the arguments used by the Secrets Manager
+// ephemeral resources to locate a secret.
+type smSecretEphemeralModel struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	Region          types.String `tfsdk:"region"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	Name            types.String `tfsdk:"name"`
+	SecretGroupName types.String `tfsdk:"secret_group_name"`
+}
+
+// smSecretEphemeralAttributes returns the attributes for locating a secret,
+// merged with the payload attributes of a given secret type.
+func smSecretEphemeralAttributes(payload map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group.",
+		},
+	}
+	for k, v := range payload {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+// smSecretEphemeralResource implements the parts shared by the Secrets
+// Manager ephemeral resources.
+type smSecretEphemeralResource struct {
+	clientSession conns.ClientSession
+}
+
+func (r *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientSession, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected conns.ClientSession, got: %T", req.ProviderData))
+		return
+	}
+	r.clientSession = clientSession
+}
+
+// validateLocator checks that the secret is located either by its ID or by
+// its name and secret group.
+func (r *smSecretEphemeralResource) validateLocator(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var secretID, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() || secretID.IsUnknown() || name.IsUnknown() || groupName.IsUnknown() {
+		return
+	}
+
+	if secretID.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_id"), "Invalid Attribute Combination",
+			"Exactly one of \"secret_id\" or \"name\" must be specified.")
+	}
+	if name.IsNull() != groupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_group_name"), "Invalid Attribute Combination",
+			"\"name\" and \"secret_group_name\" must be specified together.")
+	}
+}
+
+// getSecret fetches the secret of the given type located by the model and
+// fills in the computed location attributes.
+func (r *smSecretEphemeralResource) getSecret(ctx context.Context, model *smSecretEphemeralModel, secretType string) (secretsmanagerv2.SecretIntf, error) {
+	if r.clientSession == nil {
+		return nil, fmt.Errorf("the provider has not been configured")
+	}
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(r.clientSession)
+	if err != nil {
+		return nil, err
+	}
+	region := regionOrDefault(secretsManagerClient, model.Region.ValueString())
+	endpointType := endpointTypeOrDefault(secretsManagerClient, model.EndpointType.ValueString())
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, model.InstanceID.ValueString(), region, endpointType, bxSession)
+	model.Region = types.StringValue(region)
+
+	secretId := model.SecretID.ValueString()
+	secretName := model.Name.ValueString()
+	groupName := model.SecretGroupName.ValueString()
+
+	log.Printf("[DEBUG] getSecret %q %q %q %q\n", secretId, secretName, groupName, secretType)
+
+	var secretIntf secretsmanagerv2.SecretIntf
+	if secretId != "" {
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+		getSecretOptions.SetID(secretId)
+
+		secret, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
+		if err != nil {
+			return nil, fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response)
+		}
+		secretIntf = secret
+	} else {
+		getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
+		getSecretByNameOptions.SetName(secretName)
+		getSecretByNameOptions.SetSecretType(secretType)
+		getSecretByNameOptions.SetSecretGroupName(groupName)
+
+		secret, response, err := secretsManagerClient.GetSecretByNameTypeWithContext(ctx, getSecretByNameOptions)
+		if err != nil {
+			return nil, fmt.Errorf("GetSecretByNameTypeWithContext failed %s\n%s", err, response)
+		}
+		secretIntf = secret
+	}
+	return secretIntf, nil
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// testAccCheckIbmSmEphemeralPayloadConfig copies payload, an expression of
+// the values of ephemeral resources, to the write-only payload of the
+// arbitrary secret name, and reads it back with the data source
+// data.ibm_sm_arbitrary_secret.sm_ephemeral_payload, so that the values can
+// be checked. Ephemeral values cannot be stored in the state otherwise.
+func testAccCheckIbmSmEphemeralPayloadConfig(name, payload string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_ephemeral_payload" {
+			name = "%s"
+			instance_id   = "%s"
+			region        = "%s"
+			payload_wo = %s
+			payload_wo_version = 1
+			secret_group_id = "default"
+		}
+
+		data "ibm_sm_arbitrary_secret" "sm_ephemeral_payload" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_arbitrary_secret.sm_ephemeral_payload.secret_id
+		}
+	`, name, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, payload, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+// stubSecretsManagerSession serves secrets from memory to the Secrets Manager
+// ephemeral resources, whatever the instance endpoint they call.
+type stubSecretsManagerSession struct {
+	conns.ClientSession
+	client *secretsmanagerv2.SecretsManagerV2
+	// requests holds the paths that were requested.
+	requests []string
+}
+
+// newStubSecretsManagerSession returns a session that answers every request
+// with secret, which is encoded as JSON.
+func newStubSecretsManagerSession(t *testing.T, secret map[string]interface{}) *stubSecretsManagerSession {
+	client, err := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+		URL:           "https://secrets-manager.us-south.appdomain.cloud",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the Secrets Manager client: %s", err)
+	}
+	body, err := json.Marshal(secret)
+	if err != nil {
+		t.Fatalf("Error encoding the secret: %s", err)
+	}
+
+	s := &stubSecretsManagerSession{client: client}
+	client.Service.Client = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		s.requests = append(s.requests, r.URL.Host+r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    r,
+		}, nil
+	})}
+	return s
+}
+
+func (s *stubSecretsManagerSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	return s.client, nil
+}
+
+func (s *stubSecretsManagerSession) BluemixSession() (*bxsession.Session, error) {
+	return &bxsession.Session{Config: &bluemix.Config{}}, nil
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ibmSmUsernamePasswordSecretEphemeralModel struct {
+	smSecretEphemeralModel
+	Crn            types.String `tfsdk:"crn"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+}
+
+type ibmSmUsernamePasswordSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ibmSmUsernamePasswordSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &ibmSmUsernamePasswordSecretEphemeralResource{}
+
+func NewIbmSmUsernamePasswordSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &ibmSmUsernamePasswordSecretEphemeralResource{}
+}
+
+func (r *ibmSmUsernamePasswordSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = UsernamePasswordSecretResourceName
+}
+
+func (r *ibmSmUsernamePasswordSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a user credentials secret without persisting its password to the Terraform state.",
+		Attributes: smSecretEphemeralAttributes(map[string]schema.Attribute{
+			"crn": schema.StringAttribute{
+				Computed:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username that is assigned to the secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password that is assigned to the secret.",
+			},
+		}),
+	}
+}
+
+func (r *ibmSmUsernamePasswordSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	r.validateLocator(ctx, req, resp)
+}
+
+func (r *ibmSmUsernamePasswordSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ibmSmUsernamePasswordSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.getSecret(ctx, &model.smSecretEphemeralModel, UsernamePasswordSecretType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading "+UsernamePasswordSecretResourceName, err.Error())
+		return
+	}
+	usernamePasswordSecret, ok := secret.(*secretsmanagerv2.UsernamePasswordSecret)
+	if !ok {
+		resp.Diagnostics.AddError("Error reading "+UsernamePasswordSecretResourceName, "Wrong secret type: The provided secret is not a User Credentials secret.")
+		return
+	}
+
+	model.SecretID = types.StringPointerValue(usernamePasswordSecret.ID)
+	model.Name = types.StringPointerValue(usernamePasswordSecret.Name)
+	model.Crn = types.StringPointerValue(usernamePasswordSecret.Crn)
+	model.ExpirationDate = types.StringValue(DateTimeToRFC3339(usernamePasswordSecret.ExpirationDate))
+	model.Username = types.StringPointerValue(usernamePasswordSecret.Username)
+	model.Password = types.StringPointerValue(usernamePasswordSecret.Password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
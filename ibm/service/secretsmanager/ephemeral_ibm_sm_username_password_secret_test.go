@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+func TestAccIbmSmUsernamePasswordSecretEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretEphemeralConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret_instance", "secret_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_arbitrary_secret.sm_ephemeral_payload", "payload", "username:password/username:password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretEphemeralConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_instance" {
+			name = "username-password-secret-ephemeral-terraform-test"
+			instance_id   = "%s"
+			region        = "%s"
+			username = "username"
+			password = "password"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_username_password_secret.sm_username_password_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion) +
+		testAccCheckIbmSmEphemeralPayloadConfig("username-password-secret-ephemeral-payload-terraform-test", `"${ephemeral.ibm_sm_username_password_secret.sm_username_password_secret.username}:${ephemeral.ibm_sm_username_password_secret.sm_username_password_secret.password}/${ephemeral.ibm_sm_username_password_secret.sm_username_password_secret_by_name.username}:${ephemeral.ibm_sm_username_password_secret.sm_username_password_secret_by_name.password}"`)
+}
+
+func TestIbmSmUsernamePasswordSecretEphemeralOpen(t *testing.T) {
+	session := newStubSecretsManagerSession(t, map[string]interface{}{
+		"id":          "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+		"name":        "username-password-secret",
+		"secret_type": "username_password",
+		"username":    "admin",
+		"password":    "secret-password",
+	})
+
+	resp := acc.OpenEphemeralResource(t, secretsmanager.NewIbmSmUsernamePasswordSecretEphemeralResource(), session, map[string]interface{}{
+		"instance_id": "instance",
+		"region":      "us-south",
+		"secret_id":   "0b5571f7-21e6-42b7-91c5-3f5ac9793a46",
+	})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var region, username, password types.String
+	ctx := context.Background()
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("region"), &region).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("username"), &username).HasError())
+	require.False(t, resp.Result.GetAttribute(ctx, path.Root("password"), &password).HasError())
+	assert.Equal(t, "us-south", region.ValueString())
+	assert.Equal(t, "admin", username.ValueString())
+	assert.Equal(t, "secret-password", password.ValueString())
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -41,10 +42,26 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				RequiredWith: []string{"payload_wo_version"},
+				Description:  "The arbitrary secret data payload. The value is not persisted in the state; change payload_wo_version to create a new version of the secret.",
+			},
+			"payload_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"payload_wo"},
+				Description:  "The version of payload_wo. Change it to create a new version of the secret with the current payload_wo.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -145,7 +162,7 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 }
 
 func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -153,7 +170,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
@@ -179,7 +196,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 }
 
 func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
@@ -193,7 +210,7 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
@@ -293,9 +310,11 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting expiration_date"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
-		return tfErr.GetDiag()
+	if _, ok := d.GetOk("payload_wo_version"); !ok {
+		if err = d.Set("payload", secret.Payload); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -322,7 +341,7 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 }
 
 func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "update")
 		return tfErr.GetDiag()
@@ -332,7 +351,7 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
 
@@ -393,9 +412,14 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	if d.HasChange("payload") || d.HasChange("payload_wo_version") {
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
-		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		payload, err := getArbitrarySecretPayload(d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "update")
+			return tfErr.GetDiag()
+		}
+		versionModel.Payload = core.StringPtr(payload)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -443,7 +467,7 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 }
 
 func resourceIbmSmArbitrarySecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "delete")
 		return tfErr.GetDiag()
@@ -453,7 +477,7 @@ func resourceIbmSmArbitrarySecretDelete(context context.Context, d *schema.Resou
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
@@ -478,8 +502,12 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("name"); ok {
 		model.Name = core.StringPtr(d.Get("name").(string))
 	}
-	if _, ok := d.GetOk("payload"); ok {
-		model.Payload = core.StringPtr(d.Get("payload").(string))
+	payload, err := getArbitrarySecretPayload(d)
+	if err != nil {
+		return nil, err
+	}
+	if payload != "" {
+		model.Payload = core.StringPtr(payload)
 	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
@@ -512,3 +540,11 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	}
 	return model, nil
 }
+
+// getArbitrarySecretPayload returns the payload configured in either payload or payload_wo
+func getArbitrarySecretPayload(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("payload_wo_version"); ok {
+		return flex.GetWriteOnlyString(d, "payload_wo")
+	}
+	return d.Get("payload").(string), nil
+}
@@ -245,7 +245,7 @@ func ResourceIbmSmCustomCredentialsSecret() *schema.Resource {
 }
 
 func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", CustomCredentialsSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -253,7 +253,7 @@ func resourceIbmSmCustomCredentialsSecretCreate(context context.Context, d *sche
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
@@ -315,7 +315,7 @@ func waitForIbmSmCustomCredentialsSecretCreate(secretsManagerClient *secretsmana
 }
 
 func resourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", CustomCredentialsSecretResourceName, "read")
 		return tfErr.GetDiag()
@@ -329,7 +329,7 @@ func resourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
@@ -505,7 +505,7 @@ func resourceIbmSmCustomCredentialsSecretRead(context context.Context, d *schema
 }
 
 func resourceIbmSmCustomCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", CustomCredentialsSecretResourceName, "update")
 		return tfErr.GetDiag()
@@ -515,7 +515,7 @@ func resourceIbmSmCustomCredentialsSecretUpdate(context context.Context, d *sche
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
 
@@ -606,7 +606,7 @@ func resourceIbmSmCustomCredentialsSecretUpdate(context context.Context, d *sche
 }
 
 func resourceIbmSmCustomCredentialsSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", CustomCredentialsSecretResourceName, "delete")
 		return tfErr.GetDiag()
@@ -616,7 +616,7 @@ func resourceIbmSmCustomCredentialsSecretDelete(context context.Context, d *sche
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	// Clear the data from versions. Start by getting the versions
 	listVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
@@ -218,7 +218,7 @@ func ResourceIbmSmIamCredentialsSecret() *schema.Resource {
 }
 
 func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", IAMCredentialsSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -226,7 +226,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
@@ -256,7 +256,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 }
 
 func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", IAMCredentialsSecretResourceName, "read")
 		return tfErr.GetDiag()
@@ -270,7 +270,7 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
@@ -456,7 +456,7 @@ func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.Re
 }
 
 func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", IAMCredentialsSecretResourceName, "update")
 		return tfErr.GetDiag()
@@ -466,7 +466,7 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
 
@@ -548,7 +548,7 @@ func resourceIbmSmIamCredentialsSecretUpdate(context context.Context, d *schema.
 }
 
 func resourceIbmSmIamCredentialsSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", IAMCredentialsSecretResourceName, "delete")
 		return tfErr.GetDiag()
@@ -558,7 +558,7 @@ func resourceIbmSmIamCredentialsSecretDelete(context context.Context, d *schema.
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
 
@@ -126,7 +127,23 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 					}
 					return false
 				},
-				Description: "(Optional for non managed CSR secrets) The PEM-encoded private key to associate with the certificate.",
+				ConflictsWith: []string{"private_key_wo"},
+				Description:   "(Optional for non managed CSR secrets) The PEM-encoded private key to associate with the certificate.",
+			},
+			"private_key_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+				RequiredWith:  []string{"private_key_wo_version"},
+				Description:   "(Optional for non managed CSR secrets) The PEM-encoded private key to associate with the certificate. The value is not persisted in the state; change private_key_wo_version to create a new version of the secret.",
+			},
+			"private_key_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"private_key_wo"},
+				Description:  "The version of private_key_wo. Change it to create a new version of the secret with the current private_key_wo.",
 			},
 			"managed_csr": &schema.Schema{
 				Type:        schema.TypeList,
@@ -420,7 +437,7 @@ func ResourceIbmSmImportedCertificate() *schema.Resource {
 }
 
 func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ImportedCertSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -428,7 +445,7 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
@@ -454,7 +471,7 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 }
 
 func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ImportedCertSecretResourceName, "read")
 		return tfErr.GetDiag()
@@ -468,7 +485,7 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
@@ -623,9 +640,11 @@ func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.Res
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting intermediate"), ImportedCertSecretResourceName, "read")
 			return tfErr.GetDiag()
 		}
-		if err = d.Set("private_key", secret.PrivateKey); err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting private_key"), ImportedCertSecretResourceName, "read")
-			return tfErr.GetDiag()
+		if _, ok := d.GetOk("private_key_wo_version"); !ok {
+			if err = d.Set("private_key", secret.PrivateKey); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting private_key"), ImportedCertSecretResourceName, "read")
+				return tfErr.GetDiag()
+			}
 		}
 		if secret.Csr != nil {
 			if err = d.Set("csr", secret.Csr); err != nil {
@@ -752,7 +771,7 @@ func managedCsrToMap(managedCsr *secretsmanagerv2.ImportedCertificateManagedCsrR
 }
 
 func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ImportedCertSecretResourceName, "update")
 		return tfErr.GetDiag()
@@ -762,7 +781,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
 
@@ -805,14 +824,19 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 	}
 
 	// Apply change in secret data (if changed)
-	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") {
+	if d.HasChange("certificate") || d.HasChange("intermediate") || d.HasChange("private_key") || d.HasChange("private_key_wo_version") {
 		versionModel := &secretsmanagerv2.ImportedCertificateVersionPrototype{}
 		versionModel.Certificate = core.StringPtr(d.Get("certificate").(string))
 		if _, ok := d.GetOk("intermediate"); ok {
 			versionModel.Intermediate = core.StringPtr(formatCertificate(d.Get("intermediate").(string)))
 		}
-		if _, ok := d.GetOk("private_key"); ok {
-			versionModel.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
+		privateKey, err := getImportedCertificatePrivateKey(d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", ImportedCertSecretResourceName, "update")
+			return tfErr.GetDiag()
+		}
+		if privateKey != "" {
+			versionModel.PrivateKey = core.StringPtr(formatCertificate(privateKey))
 		}
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
@@ -873,7 +897,7 @@ func resourceIbmSmImportedCertificateUpdate(context context.Context, d *schema.R
 }
 
 func resourceIbmSmImportedCertificateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", ImportedCertSecretResourceName, "delete")
 		return tfErr.GetDiag()
@@ -883,7 +907,7 @@ func resourceIbmSmImportedCertificateDelete(context context.Context, d *schema.R
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
@@ -936,8 +960,12 @@ func resourceIbmSmImportedCertificateMapToSecretPrototype(d *schema.ResourceData
 		model.Intermediate = core.StringPtr(formatCertificate(d.Get("intermediate").(string)))
 	}
 
-	if _, ok := d.GetOk("private_key"); ok {
-		model.PrivateKey = core.StringPtr(formatCertificate(d.Get("private_key").(string)))
+	privateKey, err := getImportedCertificatePrivateKey(d)
+	if err != nil {
+		return nil, err
+	}
+	if privateKey != "" {
+		model.PrivateKey = core.StringPtr(formatCertificate(privateKey))
 	}
 
 	if _, ok := d.GetOkExists("managed_csr"); ok {
@@ -1153,3 +1181,11 @@ func formatCertificate(originalCert string) string {
 	}
 	return certParsed
 }
+
+// getImportedCertificatePrivateKey returns the private key configured in either private_key or private_key_wo
+func getImportedCertificatePrivateKey(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("private_key_wo_version"); ok {
+		return flex.GetWriteOnlyString(d, "private_key_wo")
+	}
+	return d.Get("private_key").(string), nil
+}
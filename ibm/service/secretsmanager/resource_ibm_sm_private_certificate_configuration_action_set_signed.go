@@ -19,7 +19,9 @@ func ResourceIbmSmPrivateCertificateConfigurationActionSetSigned() *schema.Resou
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationActionSetSignedRead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreateOrUpdate,
 		DeleteContext: resourceIbmSmPrivateCertificateConfigurationActionSetSignedDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "region", "instance_id", "name", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -46,7 +48,7 @@ func ResourceIbmSmPrivateCertificateConfigurationActionSetSigned() *schema.Resou
 }
 
 func resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreateOrUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", PrivateCertConfigActionSetSigned, "create/update")
 		return tfErr.GetDiag()
@@ -54,7 +56,7 @@ func resourceIbmSmPrivateCertificateConfigurationActionSetSignedCreateOrUpdate(c
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createConfigurationActionOptions := &secretsmanagerv2.CreateConfigurationActionOptions{}
 
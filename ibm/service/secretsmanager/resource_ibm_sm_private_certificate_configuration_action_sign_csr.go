@@ -19,7 +19,9 @@ func ResourceIbmSmPrivateCertificateConfigurationActionSignCsr() *schema.Resourc
 		ReadContext:   resourceIbmSmPrivateCertificateConfigurationActionSignCsrRead,
 		UpdateContext: resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreateOrUpdate,
 		DeleteContext: resourceIbmSmPrivateCertificateConfigurationActionSignCsrDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "region", "instance_id", "name", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -210,7 +212,7 @@ func ResourceIbmSmPrivateCertificateConfigurationActionSignCsr() *schema.Resourc
 }
 
 func resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreateOrUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", PrivateCertConfigActionSignCsr, "create/update")
 		return tfErr.GetDiag()
@@ -218,7 +220,7 @@ func resourceIbmSmPrivateCertificateConfigurationActionSignCsrCreateOrUpdate(con
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createConfigurationActionOptions := &secretsmanagerv2.CreateConfigurationActionOptions{}
 
@@ -22,7 +22,9 @@ func ResourceIbmSmPublicCertificateActionValidateManualDns() *schema.Resource {
 		ReadContext:   resourceIbmSmPublicCertificateActionValidateManualDnsRead,
 		UpdateContext: resourceIbmSmPublicCertificateActionValidateManualDnsCreateOrUpdate,
 		DeleteContext: resourceIbmSmPublicCertificateActionValidateManualDnsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "region", "instance_id", "secret_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"secret_id": &schema.Schema{
@@ -39,7 +41,7 @@ func ResourceIbmSmPublicCertificateActionValidateManualDns() *schema.Resource {
 }
 
 func resourceIbmSmPublicCertificateActionValidateManualDnsCreateOrUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", PublicCertConfigActionValidateManualDNSResourceName, "create/update")
 		return tfErr.GetDiag()
@@ -47,7 +49,7 @@ func resourceIbmSmPublicCertificateActionValidateManualDnsCreateOrUpdate(context
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/validate_manual_dns", region, instanceId, d.Get("secret_id").(string)))
 
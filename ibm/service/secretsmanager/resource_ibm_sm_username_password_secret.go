@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -123,11 +124,27 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 				Description: "The username that is assigned to the secret.",
 			},
 			"password": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The password that is assigned to the secret.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "The password that is assigned to the secret.",
+			},
+			"password_wo": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description:   "The password that is assigned to the secret. The value is not persisted in the state; change password_wo_version to create a new version of the secret.",
+			},
+			"password_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"password_wo"},
+				Description:  "The version of password_wo. Change it to create a new version of the secret with the current password_wo.",
 			},
 			"password_generation_policy": &schema.Schema{
 				Type:        schema.TypeList,
@@ -219,7 +236,7 @@ func ResourceIbmSmUsernamePasswordSecret() *schema.Resource {
 }
 
 func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", UsernamePasswordSecretResourceName, "create")
 		return tfErr.GetDiag()
@@ -227,7 +244,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 
 	region := getRegion(secretsManagerClient, d)
 	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
 
@@ -252,7 +269,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 }
 
 func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
@@ -266,7 +283,7 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
@@ -382,9 +399,11 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting username"), UsernamePasswordSecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("password", secret.Password); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
-		return tfErr.GetDiag()
+	if _, ok := d.GetOk("password_wo_version"); !ok {
+		if err = d.Set("password", secret.Password); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting password"), UsernamePasswordSecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	passwordPolicyMap, err := passwordGenerationPolicyToMap(secret.PasswordGenerationPolicy)
@@ -421,7 +440,7 @@ func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.
 }
 
 func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", UsernamePasswordSecretResourceName, "update")
 		return tfErr.GetDiag()
@@ -431,7 +450,7 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
 
@@ -512,9 +531,14 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("password_wo_version") {
 		versionModel := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
-		versionModel.Password = core.StringPtr(d.Get("password").(string))
+		password, err := getUsernamePasswordSecretPassword(d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", UsernamePasswordSecretResourceName, "update")
+			return tfErr.GetDiag()
+		}
+		versionModel.Password = core.StringPtr(password)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -562,7 +586,7 @@ func resourceIbmSmUsernamePasswordSecretUpdate(context context.Context, d *schem
 }
 
 func resourceIbmSmUsernamePasswordSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	secretsManagerClient, bxSession, err := getSecretsManagerSession(meta.(conns.ClientSession))
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, "", UsernamePasswordSecretResourceName, "delete")
 		return tfErr.GetDiag()
@@ -572,7 +596,7 @@ func resourceIbmSmUsernamePasswordSecretDelete(context context.Context, d *schem
 	region := id[0]
 	instanceId := id[1]
 	secretId := id[2]
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), bxSession)
 
 	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
 
@@ -629,8 +653,12 @@ func resourceIbmSmUsernamePasswordSecretMapToSecretPrototype(d *schema.ResourceD
 	if _, ok := d.GetOk("username"); ok {
 		model.Username = core.StringPtr(d.Get("username").(string))
 	}
-	if _, ok := d.GetOk("password"); ok {
-		model.Password = core.StringPtr(d.Get("password").(string))
+	password, err := getUsernamePasswordSecretPassword(d)
+	if err != nil {
+		return model, err
+	}
+	if password != "" {
+		model.Password = core.StringPtr(password)
 	}
 	if _, ok := d.GetOk("rotation"); ok {
 		RotationModel, err := resourceIbmSmUsernamePasswordSecretMapToRotationPolicy(d.Get("rotation").([]interface{})[0].(map[string]interface{}))
@@ -762,3 +790,11 @@ func mapToPasswordGenerationPolicyPatch(modelMap map[string]interface{}) (*secre
 	}
 	return model, nil
 }
+
+// getUsernamePasswordSecretPassword returns the password configured in either password or password_wo
+func getUsernamePasswordSecretPassword(d *schema.ResourceData) (string, error) {
+	if _, ok := d.GetOk("password_wo_version"); ok {
+		return flex.GetWriteOnlyString(d, "password_wo")
+	}
+	return d.Get("password").(string), nil
+}
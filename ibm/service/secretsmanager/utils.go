@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -59,59 +60,65 @@ const (
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
-	_, ok := d.GetOk("region")
-	if ok {
-		return d.Get("region").(string)
-	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+	region, _ := d.Get("region").(string)
+	return regionOrDefault(originalClient, region)
+}
+
+// Return the given region, or the one of the provider's Secrets Manager endpoint when it is empty
+func regionOrDefault(originalClient *secretsmanagerv2.SecretsManagerV2, region string) string {
+	if region != "" {
+		return region
 	}
+	// extract region from base URL (provider config)
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
 }
 
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
-	_, ok := d.GetOk("endpoint_type")
-	if ok {
-		return d.Get("endpoint_type").(string)
-	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+	endpointType, _ := d.Get("endpoint_type").(string)
+	return endpointTypeOrDefault(originalClient, endpointType)
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Return the given endpoint type, or the one of the provider's Secrets Manager endpoint when it is empty
+func endpointTypeOrDefault(originalClient *secretsmanagerv2.SecretsManagerV2, endpointType string) string {
+	if endpointType != "" {
+		return endpointType
+	}
+	baseUrl := originalClient.Service.GetServiceURL()
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
 	}
+	return "public"
 }
 
-// Get the Secrets Manager session and the endpoints file from the provider's configuration
-func getSecretsManagerSession(clientSession conns.ClientSession) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+// Get the Secrets Manager session and the Bluemix session, which resolves the endpoints, from the provider's configuration
+func getSecretsManagerSession(clientSession conns.ClientSession) (*secretsmanagerv2.SecretsManagerV2, *bxsession.Session, error) {
 	secretsManagerClient, err := clientSession.SecretsManagerV2()
 	if err != nil {
-		return secretsManagerClient, "", err
+		return secretsManagerClient, nil, err
 	}
 
 	bmxsession, err := clientSession.BluemixSession()
 	if err != nil {
-		return secretsManagerClient, "", err
+		return secretsManagerClient, nil, err
 	}
 
-	return secretsManagerClient, bmxsession.Config.EndpointsFile, nil
+	return secretsManagerClient, bmxsession, nil
 }
 
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string,
-	endpointType string, endpointsFile string) *secretsmanagerv2.SecretsManagerV2 {
+	endpointType string, bxSession *bxsession.Session) *secretsmanagerv2.SecretsManagerV2 {
 	// build the api endpoint
 	domain := "appdomain.cloud"
 
 	// Check if we're running in the staging environment based on the configuration of the IAM API endpoint
 	iamUrl := os.Getenv("IBMCLOUD_IAM_API_ENDPOINT")
 	if iamUrl == "" {
-		iamUrl = conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
+		iamUrl = conns.ServiceEndpoint(bxSession, endpointType, "IBMCLOUD_IAM_API_ENDPOINT", region, "https://iam.cloud.ibm.com")
 	}
 
 	if strings.Contains(iamUrl, "test") {
@@ -300,7 +300,46 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 			MinValueLength:             1,
 			MaxValueLength:             63})
 
-	ibmTransitGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "vpc, directlink"}},
+			Targets: []string{tgNetworkId},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "classic"}},
+			Targets: []string{tgNetworkId},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "gre_tunnel"}},
+			Targets: []string{tgLocalGatewayIp, tgLocalTunnelIp},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "unbound_gre_tunnel"}},
+			Targets: []string{tgBaseNetworkType},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "gre_tunnel, unbound_gre_tunnel", Not: true}},
+			Targets: []string{tgBaseConnectionId, tgRemoteBgpAsn, tgRemoteGatewayIp, tgRemoteTunnelIp},
+			Message: "these only apply to GRE tunnel connections",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "vpn_gateway"}},
+			Targets: []string{tgCidr},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: tgNetworkType, AllowedValues: "redundant_gre"}},
+			Targets: []string{tgDefaultPrefixFilter},
+		},
+	}
+
+	ibmTransitGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema, Rules: rules}
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
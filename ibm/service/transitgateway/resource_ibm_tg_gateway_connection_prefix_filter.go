@@ -15,11 +15,13 @@ import (
 
 func ResourceIBMTransitGatewayConnectionPrefixFilter() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionPrefixFilterCreate,
-		Read:     resourceIBMTransitGatewayConnectionPrefixFilterRead,
-		Delete:   resourceIBMTransitGatewayConnectionPrefixFilterDelete,
-		Update:   resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMTransitGatewayConnectionPrefixFilterCreate,
+		Read:   resourceIBMTransitGatewayConnectionPrefixFilterRead,
+		Delete: resourceIBMTransitGatewayConnectionPrefixFilterDelete,
+		Update: resourceIBMTransitGatewayConnectionPrefixFilterUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMTransitGatewayConnectionPrefixFilterImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -188,6 +190,39 @@ func resourceIBMTransitGatewayConnectionPrefixFilterRead(d *schema.ResourceData,
 	return nil
 }
 
+// resourceIBMTransitGatewayConnectionPrefixFilterImport sets the gateway, the
+// connection and the action of the filter, which read leaves alone as they
+// are only sent on create and update.
+func resourceIBMTransitGatewayConnectionPrefixFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 3 {
+		return nil, flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be in <gateway_id>/<connection_id>/<filter_id> format", d.Id())
+	}
+
+	getTransitGatewayConnectionPrefixFilterOptionsModel := &transitgatewayapisv1.GetTransitGatewayConnectionPrefixFilterOptions{}
+	getTransitGatewayConnectionPrefixFilterOptionsModel.SetTransitGatewayID(parts[0])
+	getTransitGatewayConnectionPrefixFilterOptionsModel.SetID(parts[1])
+	getTransitGatewayConnectionPrefixFilterOptionsModel.SetFilterID(parts[2])
+	prefixFilter, response, err := client.GetTransitGatewayConnectionPrefixFilter(getTransitGatewayConnectionPrefixFilterOptionsModel)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error while retrieving transit gateway connection prefix filter (%s): %s\n%s", parts[2], err, response)
+	}
+
+	d.Set(tgGatewayId, parts[0])
+	d.Set(tgConnectionId, parts[1])
+	d.Set(tgAction, prefixFilter.Action)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMTransitGatewayConnectionPrefixFilterUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	client, err := transitgatewayClient(meta)
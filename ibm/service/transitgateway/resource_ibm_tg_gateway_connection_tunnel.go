@@ -26,12 +26,14 @@ const (
 
 func ResourceIBMTransitGatewayConnectionRgreTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionRgreTunnelCreate,
-		Read:     resourceIBMTransitGatewayConnectionRgreTunnelRead,
-		Delete:   resourceIBMTransitGatewayConnectionRgreTunnelDelete,
-		Exists:   resourceIBMTransitGatewayConnectionRgreTunnelExists,
-		Update:   resourceIBMTransitGatewayConnectionRgreTunnelUpdate,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMTransitGatewayConnectionRgreTunnelCreate,
+		Read:   resourceIBMTransitGatewayConnectionRgreTunnelRead,
+		Delete: resourceIBMTransitGatewayConnectionRgreTunnelDelete,
+		Exists: resourceIBMTransitGatewayConnectionRgreTunnelExists,
+		Update: resourceIBMTransitGatewayConnectionRgreTunnelUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMTransitGatewayConnectionRgreTunnelImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -346,6 +348,51 @@ func resourceIBMTransitGatewayConnectionRgreTunnelRead(d *schema.ResourceData, m
 	return nil
 }
 
+// resourceIBMTransitGatewayConnectionRgreTunnelImport sets the addresses and
+// the zone of the tunnel, which read leaves alone as they are only sent on
+// create.
+func resourceIBMTransitGatewayConnectionRgreTunnelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	client, err := transitgatewayClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 3 {
+		return nil, flex.FmtErrorf("[ERROR] Incorrect ID %s: Id should be in <gateway_id>/<connection_id>/<tunnel_id> format", d.Id())
+	}
+
+	getTransitGatewayConnectionrGRETunnelOptions := &transitgatewayapisv1.GetTransitGatewayConnectionTunnelsOptions{}
+	getTransitGatewayConnectionrGRETunnelOptions.SetTransitGatewayID(parts[0])
+	getTransitGatewayConnectionrGRETunnelOptions.SetID(parts[1])
+	getTransitGatewayConnectionrGRETunnelOptions.SetGreTunnelID(parts[2])
+	instance, response, err := client.GetTransitGatewayConnectionTunnels(getTransitGatewayConnectionrGRETunnelOptions)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error Getting Transit Gateway Connection  Redundant GRE Tunnel (%s): %s\n%s", parts[2], err, response)
+	}
+
+	if instance.LocalGatewayIp != nil {
+		d.Set(tgLocalGatewayIp, *instance.LocalGatewayIp)
+	}
+	if instance.LocalTunnelIp != nil {
+		d.Set(tgLocalTunnelIp, *instance.LocalTunnelIp)
+	}
+	if instance.RemoteGatewayIp != nil {
+		d.Set(tgRemoteGatewayIp, *instance.RemoteGatewayIp)
+	}
+	if instance.RemoteTunnelIp != nil {
+		d.Set(tgRemoteTunnelIp, *instance.RemoteTunnelIp)
+	}
+	if instance.Zone != nil && instance.Zone.Name != nil {
+		d.Set(tgZone, *instance.Zone.Name)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMTransitGatewayConnectionRgreTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	client, err := transitgatewayClient(meta)
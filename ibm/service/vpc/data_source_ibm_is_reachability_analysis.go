@@ -0,0 +1,510 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/reachability"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsReachabilityAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsReachabilityAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"source":      dataSourceIBMIsReachabilityAnalysisEndpointSchema("The source of the traffic."),
+			"destination": dataSourceIBMIsReachabilityAnalysisEndpointSchema("The destination of the traffic."),
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_reachability_analysis", "protocol"),
+				Description:  "The protocol of the traffic, one of `all`, `icmp`, `tcp` or `udp`.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_reachability_analysis", "port"),
+				Description:  "The destination port of the traffic, required for `tcp` and `udp`.",
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_reachability_analysis", "source_port"),
+				Description:  "The source port of `tcp` and `udp` traffic. When it is not set, the traffic is from any ephemeral port, 32768 to 60999, and a network ACL rule applies to it when it covers them all.",
+			},
+			"icmp_type": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_reachability_analysis", "icmp_type"),
+				Description:  "The type of `icmp` traffic. When it is not set, the type is not checked.",
+			},
+			"icmp_code": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_reachability_analysis", "icmp_code"),
+				Description:  "The code of `icmp` traffic. When it is not set, the code is not checked.",
+			},
+			"reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the traffic reaches the destination, and its replies the source.",
+			},
+			"decided_by": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The step that denies the traffic, empty when it is reachable.",
+				Elem:        dataSourceIBMIsReachabilityAnalysisStep(),
+			},
+			"steps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The security groups, network ACLs, routes and gateways that the traffic and its replies go through, in order.",
+				Elem:        dataSourceIBMIsReachabilityAnalysisStep(),
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsReachabilityAnalysisEndpointSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The instance identifier. The endpoint is the primary IP of its primary network attachment or interface.",
+				},
+				"virtual_network_interface": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The virtual network interface identifier. The endpoint is its primary IP.",
+				},
+				"reserved_ip": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The reserved IP identifier, with the identifier of its subnet in `subnet`.",
+				},
+				"subnet": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The subnet identifier of `reserved_ip`.",
+				},
+				"cidr": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ValidateRemoteIP,
+					Description:  "An address or CIDR block, in or out of the VPC. No security group applies to it, and rules apply to a CIDR block when they cover all of it.",
+				},
+				"vpc": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The VPC identifier of `cidr`, required when neither endpoint is an instance, a virtual network interface or a reserved IP.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsReachabilityAnalysisStep() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The step, e.g. `source_security_groups`, `source_network_acl`, `routing`, `gateway`, `destination_network_acl`, `destination_security_groups`, `reply_destination_network_acl` or `reply_source_network_acl`.",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the step allows the traffic.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the resource of the step, e.g. `security_group`, `network_acl`, `routing_table`, `public_gateway` or `floating_ip`.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the resource, when the step has one.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the rule or route that decided the step, when one did.",
+			},
+			"rule_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the rule or route that decided the step.",
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the step allows or denies the traffic.",
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsReachabilityAnalysisValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "protocol",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "all, icmp, tcp, udp"})
+	for _, port := range []string{"port", "source_port"} {
+		validateSchema = append(validateSchema,
+			validate.ValidateSchema{
+				Identifier:                 port,
+				ValidateFunctionIdentifier: validate.IntBetween,
+				Type:                       validate.TypeInt,
+				Optional:                   true,
+				MinValue:                   "1",
+				MaxValue:                   "65535"})
+	}
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "icmp_type",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "254"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "icmp_code",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "255"})
+
+	ibmISReachabilityAnalysisDataSourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_reachability_analysis", Schema: validateSchema}
+	return &ibmISReachabilityAnalysisDataSourceValidator
+}
+
+func dataSourceIBMIsReachabilityAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reachability_analysis", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	source, sourceVPC, err := resolveIBMIsReachabilityAnalysisEndpoint(context, vpcClient, d.Get("source.0").(map[string]interface{}))
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error resolving the source: %s", err), "(Data) ibm_is_reachability_analysis", "read", "resolve-source")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	destination, destinationVPC, err := resolveIBMIsReachabilityAnalysisEndpoint(context, vpcClient, d.Get("destination.0").(map[string]interface{}))
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error resolving the destination: %s", err), "(Data) ibm_is_reachability_analysis", "read", "resolve-destination")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpcID := sourceVPC
+	if vpcID == "" {
+		vpcID = destinationVPC
+	}
+	if vpcID == "" || (destinationVPC != "" && destinationVPC != vpcID) {
+		err = fmt.Errorf("The source and the destination must be in the same VPC, set the vpc of a cidr endpoint when neither is a resource of a VPC")
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_reachability_analysis", "read", "vpc")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	network, err := fetchIBMIsReachabilityAnalysisNetwork(context, vpcClient, vpcID, source, destination)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error fetching the network of VPC %s: %s", vpcID, err), "(Data) ibm_is_reachability_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	flow := reachability.Flow{
+		Protocol:   d.Get("protocol").(string),
+		Port:       int64(d.Get("port").(int)),
+		SourcePort: int64(d.Get("source_port").(int)),
+	}
+	if icmpType, ok := d.GetOkExists("icmp_type"); ok {
+		flow.Type = core.Int64Ptr(int64(icmpType.(int)))
+	}
+	if icmpCode, ok := d.GetOkExists("icmp_code"); ok {
+		flow.Code = core.Int64Ptr(int64(icmpCode.(int)))
+	}
+	result, err := reachability.Analyze(network, source, destination, flow)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error analyzing the reachability: %s", err), "(Data) ibm_is_reachability_analysis", "read", "analyze")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(dataSourceIBMIsReachabilityAnalysisID(d))
+	if err = d.Set("reachable", result.Reachable); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reachable: %s", err), "(Data) ibm_is_reachability_analysis", "read", "set-reachable").GetDiag()
+	}
+	decidedBy := []map[string]interface{}{}
+	if step := result.DecidedBy(); step != nil {
+		decidedBy = append(decidedBy, dataSourceIBMIsReachabilityAnalysisStepToMap(*step))
+	}
+	if err = d.Set("decided_by", decidedBy); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting decided_by: %s", err), "(Data) ibm_is_reachability_analysis", "read", "set-decided_by").GetDiag()
+	}
+	steps := []map[string]interface{}{}
+	for _, step := range result.Steps {
+		steps = append(steps, dataSourceIBMIsReachabilityAnalysisStepToMap(step))
+	}
+	if err = d.Set("steps", steps); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting steps: %s", err), "(Data) ibm_is_reachability_analysis", "read", "set-steps").GetDiag()
+	}
+	return nil
+}
+
+// dataSourceIBMIsReachabilityAnalysisID returns a reasonable ID for the analysis.
+func dataSourceIBMIsReachabilityAnalysisID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceIBMIsReachabilityAnalysisStepToMap(step reachability.Step) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          step.Name,
+		"allowed":       step.Allowed,
+		"resource_type": step.ResourceType,
+		"resource_id":   step.ResourceID,
+		"rule_id":       step.RuleID,
+		"rule_name":     step.RuleName,
+		"reason":        step.Reason,
+	}
+}
+
+// resolveIBMIsReachabilityAnalysisEndpoint returns the endpoint of a source or
+// destination block, and the VPC that it is in, if it is a resource of one.
+func resolveIBMIsReachabilityAnalysisEndpoint(context context.Context, vpcClient *vpcv1.VpcV1, block map[string]interface{}) (reachability.Endpoint, string, error) {
+	set := []string{}
+	for _, key := range []string{"instance", "virtual_network_interface", "reserved_ip", "cidr"} {
+		if block[key].(string) != "" {
+			set = append(set, key)
+		}
+	}
+	if len(set) != 1 {
+		return reachability.Endpoint{}, "", fmt.Errorf("Exactly one of instance, virtual_network_interface, reserved_ip or cidr must be set, got %d", len(set))
+	}
+
+	switch set[0] {
+	case "instance":
+		instance, _, err := vpcClient.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: flex.PtrToString(block["instance"].(string))})
+		if err != nil {
+			return reachability.Endpoint{}, "", fmt.Errorf("GetInstanceWithContext failed: %s", err)
+		}
+		var endpoint reachability.Endpoint
+		if instance.PrimaryNetworkAttachment != nil {
+			endpoint, _, err = resolveIBMIsReachabilityAnalysisVirtualNetworkInterface(context, vpcClient, *instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID)
+		} else {
+			endpoint, err = resolveIBMIsReachabilityAnalysisNetworkInterface(context, vpcClient, *instance.ID, *instance.PrimaryNetworkInterface.ID)
+		}
+		if err != nil {
+			return reachability.Endpoint{}, "", err
+		}
+		endpoint.Name = fmt.Sprintf("instance %s", *instance.Name)
+		return endpoint, *instance.VPC.ID, nil
+	case "virtual_network_interface":
+		return resolveIBMIsReachabilityAnalysisVirtualNetworkInterface(context, vpcClient, block["virtual_network_interface"].(string))
+	case "reserved_ip":
+		return resolveIBMIsReachabilityAnalysisReservedIP(context, vpcClient, block["subnet"].(string), block["reserved_ip"].(string))
+	}
+	return reachability.Endpoint{CIDR: block["cidr"].(string)}, block["vpc"].(string), nil
+}
+
+func resolveIBMIsReachabilityAnalysisVirtualNetworkInterface(context context.Context, vpcClient *vpcv1.VpcV1, id string) (reachability.Endpoint, string, error) {
+	vni, _, err := vpcClient.GetVirtualNetworkInterfaceWithContext(context, &vpcv1.GetVirtualNetworkInterfaceOptions{ID: &id})
+	if err != nil {
+		return reachability.Endpoint{}, "", fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed: %s", err)
+	}
+	floatingIPs, _, err := vpcClient.ListNetworkInterfaceFloatingIpsWithContext(context, &vpcv1.ListNetworkInterfaceFloatingIpsOptions{VirtualNetworkInterfaceID: &id})
+	if err != nil {
+		return reachability.Endpoint{}, "", fmt.Errorf("ListNetworkInterfaceFloatingIpsWithContext failed: %s", err)
+	}
+	endpoint := reachability.Endpoint{
+		Name:       fmt.Sprintf("virtual network interface %s", *vni.Name),
+		CIDR:       *vni.PrimaryIP.Address,
+		Subnet:     *vni.Subnet.ID,
+		FloatingIP: len(floatingIPs.FloatingIps) > 0,
+	}
+	for _, sg := range vni.SecurityGroups {
+		endpoint.SecurityGroups = append(endpoint.SecurityGroups, *sg.ID)
+	}
+	return endpoint, *vni.VPC.ID, nil
+}
+
+func resolveIBMIsReachabilityAnalysisNetworkInterface(context context.Context, vpcClient *vpcv1.VpcV1, instanceID, id string) (reachability.Endpoint, error) {
+	nic, _, err := vpcClient.GetInstanceNetworkInterfaceWithContext(context, &vpcv1.GetInstanceNetworkInterfaceOptions{InstanceID: &instanceID, ID: &id})
+	if err != nil {
+		return reachability.Endpoint{}, fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed: %s", err)
+	}
+	endpoint := reachability.Endpoint{
+		Name:       fmt.Sprintf("network interface %s", *nic.Name),
+		CIDR:       *nic.PrimaryIP.Address,
+		Subnet:     *nic.Subnet.ID,
+		FloatingIP: len(nic.FloatingIps) > 0,
+	}
+	for _, sg := range nic.SecurityGroups {
+		endpoint.SecurityGroups = append(endpoint.SecurityGroups, *sg.ID)
+	}
+	return endpoint, nil
+}
+
+// resolveIBMIsReachabilityAnalysisReservedIP resolves a reserved IP with the
+// security groups and floating IPs of the network interface that it is bound
+// to, if any.
+func resolveIBMIsReachabilityAnalysisReservedIP(context context.Context, vpcClient *vpcv1.VpcV1, subnetID, id string) (reachability.Endpoint, string, error) {
+	if subnetID == "" {
+		return reachability.Endpoint{}, "", fmt.Errorf("The subnet of reserved IP %s is required", id)
+	}
+	subnet, _, err := vpcClient.GetSubnetWithContext(context, &vpcv1.GetSubnetOptions{ID: &subnetID})
+	if err != nil {
+		return reachability.Endpoint{}, "", fmt.Errorf("GetSubnetWithContext failed: %s", err)
+	}
+	reservedIP, _, err := vpcClient.GetSubnetReservedIPWithContext(context, &vpcv1.GetSubnetReservedIPOptions{SubnetID: &subnetID, ID: &id})
+	if err != nil {
+		return reachability.Endpoint{}, "", fmt.Errorf("GetSubnetReservedIPWithContext failed: %s", err)
+	}
+
+	var endpoint reachability.Endpoint
+	if reservedIP.Target != nil {
+		// The target is one of many types, all of which have a resource type
+		target := &vpcv1.ReservedIPTarget{}
+		data, err := json.Marshal(reservedIP.Target)
+		if err == nil {
+			err = json.Unmarshal(data, target)
+		}
+		if err != nil {
+			return reachability.Endpoint{}, "", fmt.Errorf("Error reading the target of reserved IP %s: %s", id, err)
+		}
+		switch *target.ResourceType {
+		case "virtual_network_interface":
+			endpoint, _, err = resolveIBMIsReachabilityAnalysisVirtualNetworkInterface(context, vpcClient, *target.ID)
+		case "network_interface":
+			// The instance of the network interface is only in its href,
+			// .../instances/{instance_id}/network_interfaces/{id}
+			parts := strings.Split(*target.Href, "/")
+			if len(parts) < 4 || parts[len(parts)-4] != "instances" {
+				return reachability.Endpoint{}, "", fmt.Errorf("Error reading the instance of network interface %s", *target.Href)
+			}
+			endpoint, err = resolveIBMIsReachabilityAnalysisNetworkInterface(context, vpcClient, parts[len(parts)-3], *target.ID)
+		default:
+			return reachability.Endpoint{}, "", fmt.Errorf("Reserved IP %s is bound to a %s, whose security groups are not analyzed, use its address as a cidr endpoint instead", id, *target.ResourceType)
+		}
+		if err != nil {
+			return reachability.Endpoint{}, "", err
+		}
+	}
+	endpoint.Name = fmt.Sprintf("reserved IP %s", *reservedIP.Name)
+	endpoint.CIDR, endpoint.Subnet = *reservedIP.Address, subnetID
+	return endpoint, *subnet.VPC.ID, nil
+}
+
+// fetchIBMIsReachabilityAnalysisNetwork fetches the subnets of a VPC, with
+// their network ACLs and routing tables, and the security groups of the
+// endpoints.
+func fetchIBMIsReachabilityAnalysisNetwork(context context.Context, vpcClient *vpcv1.VpcV1, vpcID string, endpoints ...reachability.Endpoint) (*reachability.Network, error) {
+	subnets := []vpcv1.Subnet{}
+	listSubnetsOptions := &vpcv1.ListSubnetsOptions{VPCID: &vpcID}
+	for {
+		result, _, err := vpcClient.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListSubnetsWithContext failed: %s", err)
+		}
+		subnets = append(subnets, result.Subnets...)
+		start := flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+		listSubnetsOptions.Start = &start
+	}
+
+	networkACLs := []*vpcv1.NetworkACL{}
+	routingTables := []map[string]interface{}{}
+	seen := map[string]bool{}
+	for _, subnet := range subnets {
+		if id := *subnet.NetworkACL.ID; !seen[id] {
+			seen[id] = true
+			acl, _, err := vpcClient.GetNetworkACLWithContext(context, &vpcv1.GetNetworkACLOptions{ID: &id})
+			if err != nil {
+				return nil, fmt.Errorf("GetNetworkACLWithContext failed: %s", err)
+			}
+			networkACLs = append(networkACLs, acl)
+		}
+		if id := *subnet.RoutingTable.ID; !seen[id] {
+			seen[id] = true
+			routes := []vpcv1.Route{}
+			listRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{VPCID: &vpcID, RoutingTableID: &id}
+			for {
+				result, _, err := vpcClient.ListVPCRoutingTableRoutesWithContext(context, listRoutesOptions)
+				if err != nil {
+					return nil, fmt.Errorf("ListVPCRoutingTableRoutesWithContext failed: %s", err)
+				}
+				routes = append(routes, result.Routes...)
+				start := flex.GetNext(result.Next)
+				if start == "" {
+					break
+				}
+				listRoutesOptions.Start = &start
+			}
+			routingTables = append(routingTables, map[string]interface{}{
+				"id":     id,
+				"name":   *subnet.RoutingTable.Name,
+				"routes": routes,
+			})
+		}
+	}
+
+	securityGroups := []*vpcv1.SecurityGroup{}
+	for _, endpoint := range endpoints {
+		for _, id := range endpoint.SecurityGroups {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			sg, _, err := vpcClient.GetSecurityGroupWithContext(context, &vpcv1.GetSecurityGroupOptions{ID: &id})
+			if err != nil {
+				return nil, fmt.Errorf("GetSecurityGroupWithContext failed: %s", err)
+			}
+			securityGroups = append(securityGroups, sg)
+		}
+	}
+
+	// The objects of the SDK marshal to the JSON of the API, which is what
+	// the analysis reads
+	data, err := json.Marshal(map[string]interface{}{
+		"subnets":         subnets,
+		"security_groups": securityGroups,
+		"network_acls":    networkACLs,
+		"routing_tables":  routingTables,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reachability.ParseNetwork(data)
+}
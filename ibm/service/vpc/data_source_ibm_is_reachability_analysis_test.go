@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISReachabilityAnalysisDataSource_basic(t *testing.T) {
+	node := "data.ibm_is_reachability_analysis.example"
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISReachabilityAnalysisDataSourceConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "reachable", "false"),
+					resource.TestCheckResourceAttr(node, "steps.0.name", "source_network_acl"),
+					resource.TestCheckResourceAttr(node, "steps.0.allowed", "true"),
+					resource.TestCheckResourceAttr(node, "steps.1.name", "routing"),
+					resource.TestCheckResourceAttr(node, "decided_by.#", "1"),
+					resource.TestCheckResourceAttr(node, "decided_by.0.name", "gateway"),
+					resource.TestCheckResourceAttr(node, "decided_by.0.resource_type", "public_gateway"),
+					resource.TestCheckResourceAttrSet(node, "decided_by.0.reason"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISReachabilityAnalysisDataSourceConfig(vpcname, subnetname, zone, cidr string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	data "ibm_is_reachability_analysis" "example" {
+		source {
+			cidr = cidrhost(ibm_is_subnet.testacc_subnet.ipv4_cidr_block, 4)
+			vpc  = ibm_is_vpc.testacc_vpc.id
+		}
+		destination {
+			cidr = "0.0.0.0/0"
+		}
+		protocol = "tcp"
+		port     = 443
+	}
+	`, vpcname, subnetname, zone, cidr)
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rulecheck"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isRuleConflictsSecurityGroupRules = "security_group_rules"
+	isRuleConflictsNetworkACLRules    = "network_acl_rules"
+)
+
+func DataSourceIBMIsRuleConflicts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsRuleConflictsRead,
+
+		Schema: map[string]*schema.Schema{
+			isRuleConflictsSecurityGroupRules: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{isRuleConflictsSecurityGroupRules, isRuleConflictsNetworkACLRules},
+				Description:  "The rules of a security group, with the arguments of the rules of ibm_is_security_group.",
+				Elem: &schema.Resource{
+					Schema: makeIBMISSecurityRuleSchema(),
+				},
+			},
+			isRuleConflictsNetworkACLRules: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{isRuleConflictsSecurityGroupRules, isRuleConflictsNetworkACLRules},
+				Description:  "The rules of a network ACL in the order in which they are evaluated, with the arguments of the rules of ibm_is_network_acl.",
+				Elem: &schema.Resource{
+					Schema: makeIBMISNetworkACLRuleSchema(),
+				},
+			},
+			"quota": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_rule_conflicts", "quota"),
+				Description:  "The quota of rules, 250 per security group and 200 per network ACL by default.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rules have no conflict.",
+			},
+			"conflicts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The conflicts of the rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The kind of conflict: `duplicate`, `shadowed` or `quota`.",
+						},
+						"index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The index of the rule that conflicts, -1 for the quota.",
+						},
+						"rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule that conflicts.",
+						},
+						"conflicting_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The index of the rule that it duplicates or that shadows it, -1 for the quota.",
+						},
+						"conflicting_rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule that it duplicates or that shadows it.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the conflict.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsRuleConflictsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "quota",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1"})
+
+	ibmISRuleConflictsDataSourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_rule_conflicts", Schema: validateSchema}
+	return &ibmISRuleConflictsDataSourceValidator
+}
+
+func dataSourceIBMIsRuleConflictsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The rules are read from the configuration, so that the arguments that
+	// are not set are told apart from zeros, e.g. an ICMP type of 0.
+	config := d.GetRawConfig()
+	quota := d.Get("quota").(int)
+	var rules []rulecheck.Rule
+	var conflicts []rulecheck.Conflict
+	if blocks := config.GetAttr(isRuleConflictsSecurityGroupRules); !blocks.IsNull() && blocks.LengthInt() > 0 {
+		for i, r := range configBlockMaps(blocks) {
+			rules = append(rules, securityGroupConflictRule(fmt.Sprintf("%s.%d", isRuleConflictsSecurityGroupRules, i), r.(map[string]interface{})))
+		}
+		if quota == 0 {
+			quota = rulecheck.SecurityGroupRuleQuota
+		}
+		conflicts = rulecheck.SecurityGroupRules(rules, quota)
+	} else {
+		for i, r := range configBlockMaps(config.GetAttr(isRuleConflictsNetworkACLRules)) {
+			rules = append(rules, networkACLConflictRule(fmt.Sprintf("%s.%d", isRuleConflictsNetworkACLRules, i), r.(map[string]interface{})))
+		}
+		if quota == 0 {
+			quota = rulecheck.NetworkACLRuleQuota
+		}
+		conflicts = rulecheck.NetworkACLRules(rules, quota)
+	}
+
+	d.SetId(dataSourceIBMIsRuleConflictsID(d))
+	if err := d.Set("valid", len(conflicts) == 0); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting valid: %s", err), "(Data) ibm_is_rule_conflicts", "read", "set-valid").GetDiag()
+	}
+	conflictList := []map[string]interface{}{}
+	for _, c := range conflicts {
+		conflict := map[string]interface{}{
+			"kind":              c.Kind,
+			"index":             c.Index,
+			"conflicting_index": c.With,
+			"message":           c.Message,
+		}
+		if c.Index >= 0 {
+			conflict["rule"] = rules[c.Index].Name
+		}
+		if c.With >= 0 {
+			conflict["conflicting_rule"] = rules[c.With].Name
+		}
+		conflictList = append(conflictList, conflict)
+	}
+	if err := d.Set("conflicts", conflictList); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting conflicts: %s", err), "(Data) ibm_is_rule_conflicts", "read", "set-conflicts").GetDiag()
+	}
+	return nil
+}
+
+// dataSourceIBMIsRuleConflictsID returns a reasonable ID for the check.
+func dataSourceIBMIsRuleConflictsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
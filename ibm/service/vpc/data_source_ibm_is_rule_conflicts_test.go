@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISRuleConflictsDataSource_basic(t *testing.T) {
+	sg := "data.ibm_is_rule_conflicts.security_group"
+	acl := "data.ibm_is_rule_conflicts.network_acl"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISRuleConflictsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sg, "valid", "false"),
+					resource.TestCheckResourceAttr(sg, "conflicts.#", "1"),
+					resource.TestCheckResourceAttr(sg, "conflicts.0.kind", "duplicate"),
+					resource.TestCheckResourceAttr(sg, "conflicts.0.index", "2"),
+					resource.TestCheckResourceAttr(sg, "conflicts.0.rule", "security_group_rules.2"),
+					resource.TestCheckResourceAttr(sg, "conflicts.0.conflicting_rule", "ssh"),
+					resource.TestCheckResourceAttr(acl, "valid", "false"),
+					resource.TestCheckResourceAttr(acl, "conflicts.#", "2"),
+					resource.TestCheckResourceAttr(acl, "conflicts.0.kind", "shadowed"),
+					resource.TestCheckResourceAttr(acl, "conflicts.0.rule", "allow-ssh"),
+					resource.TestCheckResourceAttr(acl, "conflicts.0.conflicting_rule", "deny-all"),
+					resource.TestCheckResourceAttr(acl, "conflicts.1.kind", "quota"),
+					resource.TestCheckResourceAttr(acl, "conflicts.1.index", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISRuleConflictsDataSourceConfig() string {
+	return `
+	data "ibm_is_rule_conflicts" "security_group" {
+		security_group_rules {
+			name      = "ssh"
+			direction = "inbound"
+			protocol  = "tcp"
+			port_min  = 22
+			port_max  = 22
+		}
+		security_group_rules {
+			direction = "outbound"
+		}
+		security_group_rules {
+			direction = "inbound"
+			protocol  = "tcp"
+			remote    = "0.0.0.0/0"
+			port_min  = 22
+			port_max  = 22
+		}
+	}
+
+	data "ibm_is_rule_conflicts" "network_acl" {
+		network_acl_rules {
+			name        = "deny-all"
+			action      = "deny"
+			direction   = "inbound"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+		}
+		network_acl_rules {
+			name        = "allow-ssh"
+			action      = "allow"
+			direction   = "inbound"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			protocol    = "tcp"
+			port_min    = 22
+			port_max    = 22
+		}
+		quota = 1
+	}
+	`
+}
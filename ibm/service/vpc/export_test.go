@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+	"time"
+)
+
+// SetInstanceGroupPollInterval polls the instance groups and their
+// memberships every interval for the duration of t.
+func SetInstanceGroupPollInterval(t *testing.T, interval time.Duration) {
+	previous := instanceGroupPollInterval
+	instanceGroupPollInterval = interval
+	t.Cleanup(func() { instanceGroupPollInterval = previous })
+}
+
+// SetVPCPollInterval polls the VPCs and subnets every interval for the
+// duration of t.
+func SetVPCPollInterval(t *testing.T, interval time.Duration) {
+	previous := vpcPollInterval
+	vpcPollInterval = interval
+	t.Cleanup(func() { vpcPollInterval = previous })
+}
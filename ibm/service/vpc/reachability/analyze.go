@@ -0,0 +1,463 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package reachability
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// The steps of the path from the source to the destination, and of the
+// replies back, in the order in which the traffic goes through them. A step
+// is left out when the traffic does not go through it, e.g. the network ACL
+// of an endpoint outside of the VPC, or between two endpoints of a subnet.
+const (
+	StepSourceSecurityGroups       = "source_security_groups"
+	StepSourceNetworkACL           = "source_network_acl"
+	StepRouting                    = "routing"
+	StepGateway                    = "gateway"
+	StepDestinationNetworkACL      = "destination_network_acl"
+	StepDestinationSecurityGroups  = "destination_security_groups"
+	StepReplyDestinationNetworkACL = "reply_destination_network_acl"
+	StepReplySourceNetworkACL      = "reply_source_network_acl"
+)
+
+// Endpoint is the source or the destination of the traffic.
+type Endpoint struct {
+	// Name describes the endpoint in the reasons of the steps, e.g.
+	// instance web-1.
+	Name string `json:"name"`
+	// CIDR is the address of the endpoint, or the range of addresses of a
+	// network outside of the VPC. A rule applies to a range when it covers
+	// all of it.
+	CIDR string `json:"cidr"`
+	// Subnet is the subnet of the endpoint. When it is not set, it is the
+	// subnet that contains CIDR, if any.
+	Subnet         string   `json:"subnet"`
+	SecurityGroups []string `json:"security_groups"`
+	FloatingIP     bool     `json:"floating_ip"`
+}
+
+// Flow is the traffic from the source to the destination.
+type Flow struct {
+	// Protocol is tcp, udp, icmp or another IP protocol, e.g. gre.
+	Protocol string `json:"protocol"`
+	// Port is the destination port of tcp and udp traffic, it is required.
+	Port int64 `json:"port"`
+	// SourcePort is the source port of tcp and udp traffic. When it is not
+	// set, it is any ephemeral port, and a rule applies to it when it covers
+	// them all.
+	SourcePort int64 `json:"source_port"`
+	// Type and Code are the type and code of icmp traffic. When they are
+	// not set, they are not checked.
+	Type *int64 `json:"type"`
+	Code *int64 `json:"code"`
+}
+
+// The ephemeral ports that connections are made from, as chosen by Linux.
+const (
+	ephemeralPortMin = 32768
+	ephemeralPortMax = 60999
+)
+
+// Step is the verdict of a security group, network ACL, route or gateway on
+// the traffic, with the rule or route that decided it.
+type Step struct {
+	Name         string `json:"name"`
+	Allowed      bool   `json:"allowed"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	RuleID       string `json:"rule_id"`
+	RuleName     string `json:"rule_name"`
+	Reason       string `json:"reason"`
+}
+
+type Result struct {
+	Reachable bool   `json:"reachable"`
+	Steps     []Step `json:"steps"`
+}
+
+// DecidedBy returns the first step that denies the traffic, or nil when the
+// destination is reachable.
+func (r *Result) DecidedBy() *Step {
+	for i := range r.Steps {
+		if !r.Steps[i].Allowed {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// endpoint is an endpoint resolved in a network.
+type endpoint struct {
+	Endpoint
+	prefix netip.Prefix
+	subnet *Subnet
+}
+
+func (e *endpoint) String() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return e.CIDR
+}
+
+// Analyze evaluates the traffic of flow from source to destination in
+// network.
+func Analyze(network *Network, source, destination Endpoint, flow Flow) (*Result, error) {
+	if flow.Protocol == "" {
+		return nil, fmt.Errorf("The protocol of the traffic is required")
+	}
+	if (flow.Protocol == "tcp" || flow.Protocol == "udp") && flow.Port == 0 {
+		return nil, fmt.Errorf("The port of %s traffic is required", flow.Protocol)
+	}
+	src, err := network.resolve(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error resolving the source: %s", err)
+	}
+	dst, err := network.resolve(destination)
+	if err != nil {
+		return nil, fmt.Errorf("Error resolving the destination: %s", err)
+	}
+	if src.subnet == nil && dst.subnet == nil {
+		return nil, fmt.Errorf("Neither the source %s nor the destination %s is in a subnet of the VPC", src, dst)
+	}
+
+	// Network ACLs filter the traffic that enters or leaves a subnet, and
+	// do not keep track of connections, so that the replies must be allowed
+	// too. Security groups do, they only filter the first packet.
+	crossesSubnets := src.subnet == nil || dst.subnet == nil || src.subnet.ID != dst.subnet.ID
+	reply := flow.reply()
+	var steps []Step
+	if len(src.SecurityGroups) > 0 {
+		steps = append(steps, network.evaluateSecurityGroups(StepSourceSecurityGroups, "outbound", src, dst, flow))
+	}
+	if crossesSubnets {
+		if src.subnet != nil {
+			steps = append(steps, network.evaluateNetworkACL(StepSourceNetworkACL, "outbound", src.subnet, src, dst, flow))
+		}
+		steps = append(steps, network.evaluateRoute(src, dst)...)
+		if dst.subnet != nil {
+			steps = append(steps, network.evaluateNetworkACL(StepDestinationNetworkACL, "inbound", dst.subnet, src, dst, flow))
+		}
+	}
+	if len(dst.SecurityGroups) > 0 {
+		steps = append(steps, network.evaluateSecurityGroups(StepDestinationSecurityGroups, "inbound", dst, src, flow))
+	}
+	if crossesSubnets {
+		if dst.subnet != nil {
+			steps = append(steps, network.evaluateNetworkACL(StepReplyDestinationNetworkACL, "outbound", dst.subnet, dst, src, reply))
+		}
+		if src.subnet != nil {
+			steps = append(steps, network.evaluateNetworkACL(StepReplySourceNetworkACL, "inbound", src.subnet, dst, src, reply))
+		}
+	}
+
+	result := &Result{Reachable: true, Steps: steps}
+	for _, step := range steps {
+		result.Reachable = result.Reachable && step.Allowed
+	}
+	return result, nil
+}
+
+// ports returns the range of the destination ports of f.
+func (f Flow) ports() (int64, int64) {
+	if f.Port == 0 {
+		return ephemeralPortMin, ephemeralPortMax
+	}
+	return f.Port, f.Port
+}
+
+// sourcePorts returns the range of the source ports of f.
+func (f Flow) sourcePorts() (int64, int64) {
+	return Flow{Port: f.SourcePort}.ports()
+}
+
+// reply returns the traffic that replies to f. The destination port of the
+// reply is the source port of f, which may be any ephemeral port.
+func (f Flow) reply() Flow {
+	reply := Flow{Protocol: f.Protocol, Port: f.SourcePort, SourcePort: f.Port}
+	if f.Type != nil && *f.Type == 8 {
+		// The reply to an echo request is an echo reply
+		echoReply := int64(0)
+		reply.Type, reply.Code = &echoReply, &echoReply
+	}
+	return reply
+}
+
+// resolve finds the subnet of e, and checks that the network has the objects
+// that the traffic of e goes through.
+func (n *Network) resolve(e Endpoint) (*endpoint, error) {
+	prefix, err := parsePrefix(e.CIDR)
+	if err != nil {
+		return nil, err
+	}
+	resolved := &endpoint{Endpoint: e, prefix: prefix}
+	if e.Subnet != "" {
+		if resolved.subnet = n.subnet(e.Subnet); resolved.subnet == nil {
+			return nil, fmt.Errorf("Subnet %s not found", e.Subnet)
+		}
+	} else {
+		for i := range n.Subnets {
+			if covers(n.Subnets[i].CIDR, prefix) {
+				resolved.subnet = &n.Subnets[i]
+				break
+			}
+		}
+	}
+	if subnet := resolved.subnet; subnet != nil {
+		if n.networkACL(subnet.NetworkACL.ID) == nil {
+			return nil, fmt.Errorf("Network ACL %s of subnet %s not found", subnet.NetworkACL.ID, subnet.ID)
+		}
+		if n.routingTable(subnet.RoutingTable.ID) == nil {
+			return nil, fmt.Errorf("Routing table %s of subnet %s not found", subnet.RoutingTable.ID, subnet.ID)
+		}
+	}
+	for _, id := range e.SecurityGroups {
+		if n.securityGroup(id) == nil {
+			return nil, fmt.Errorf("Security group %s not found", id)
+		}
+	}
+	return resolved, nil
+}
+
+// parsePrefix parses an address or a CIDR block.
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("Error parsing address %q: %s", cidr, err)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("Error parsing CIDR block %q: %s", cidr, err)
+	}
+	return prefix.Masked(), nil
+}
+
+// covers reports whether the address or CIDR block cidr contains all of
+// prefix.
+func covers(cidr string, prefix netip.Prefix) bool {
+	p, err := parsePrefix(cidr)
+	return err == nil && p.Bits() <= prefix.Bits() && p.Contains(prefix.Addr())
+}
+
+// protocolMatches reports whether a rule for ruleProtocol applies to
+// protocol.
+func protocolMatches(ruleProtocol, protocol string) bool {
+	switch ruleProtocol {
+	case "all", "any":
+		return true
+	case "icmp_tcp_udp":
+		return protocol == "icmp" || protocol == "tcp" || protocol == "udp"
+	}
+	return ruleProtocol == protocol
+}
+
+// inRange reports whether the range of ports of a rule, from min to max,
+// covers the ports from low to high.
+func inRange(min, max *int64, low, high int64) bool {
+	return (min == nil || *min <= low) && (max == nil || high <= *max)
+}
+
+func valueMatches(rule, value *int64) bool {
+	return rule == nil || value == nil || *rule == *value
+}
+
+// evaluateSecurityGroups evaluates the rules of direction of the security
+// groups of self, for the traffic of flow with peer.
+func (n *Network) evaluateSecurityGroups(name, direction string, self, peer *endpoint, flow Flow) Step {
+	step := Step{Name: name, ResourceType: "security_group"}
+	for _, id := range self.SecurityGroups {
+		sg := n.securityGroup(id)
+		for _, rule := range sg.Rules {
+			if rule.Direction != direction || !protocolMatches(rule.Protocol, flow.Protocol) {
+				continue
+			}
+			switch rule.Protocol {
+			case "tcp", "udp":
+				if low, high := flow.ports(); !inRange(rule.PortMin, rule.PortMax, low, high) {
+					continue
+				}
+			case "icmp":
+				if !valueMatches(rule.Type, flow.Type) || !valueMatches(rule.Code, flow.Code) {
+					continue
+				}
+			}
+			if local := rule.Local.Address + rule.Local.CIDRBlock; local != "" && !covers(local, self.prefix) {
+				continue
+			}
+			if rule.Remote.ID != "" {
+				if !slices.Contains(peer.SecurityGroups, rule.Remote.ID) {
+					continue
+				}
+			} else if remote := rule.Remote.Address + rule.Remote.CIDRBlock; remote != "" && !covers(remote, peer.prefix) {
+				continue
+			}
+			step.Allowed = true
+			step.ResourceID, step.RuleID, step.RuleName = sg.ID, rule.ID, rule.Name
+			step.Reason = fmt.Sprintf("Rule %s of security group %s allows the %s traffic of %s with %s", ruleName(rule.Name, rule.ID), sg.Name, direction, self, peer)
+			return step
+		}
+	}
+	step.Reason = fmt.Sprintf("No %s rule of the security groups of %s allows its traffic with %s", direction, self, peer)
+	return step
+}
+
+// evaluateNetworkACL evaluates the rules of direction of the network ACL of
+// subnet, in order, for the traffic of flow from src to dst.
+func (n *Network) evaluateNetworkACL(name, direction string, subnet *Subnet, src, dst *endpoint, flow Flow) Step {
+	acl := n.networkACL(subnet.NetworkACL.ID)
+	step := Step{Name: name, ResourceType: "network_acl", ResourceID: acl.ID}
+	for _, rule := range acl.Rules {
+		if rule.Direction != direction || !protocolMatches(rule.Protocol, flow.Protocol) {
+			continue
+		}
+		if !covers(rule.Source, src.prefix) || !covers(rule.Destination, dst.prefix) {
+			continue
+		}
+		switch rule.Protocol {
+		case "tcp", "udp":
+			low, high := flow.ports()
+			sourceLow, sourceHigh := flow.sourcePorts()
+			if !inRange(rule.DestinationPortMin, rule.DestinationPortMax, low, high) || !inRange(rule.SourcePortMin, rule.SourcePortMax, sourceLow, sourceHigh) {
+				continue
+			}
+		case "icmp":
+			if !valueMatches(rule.Type, flow.Type) || !valueMatches(rule.Code, flow.Code) {
+				continue
+			}
+		}
+		step.Allowed = rule.Action == "allow"
+		step.RuleID, step.RuleName = rule.ID, rule.Name
+		step.Reason = fmt.Sprintf("Rule %s of network ACL %s %ss the %s traffic from %s to %s", ruleName(rule.Name, rule.ID), acl.Name, rule.Action, direction, src, dst)
+		return step
+	}
+	step.Reason = fmt.Sprintf("No %s rule of network ACL %s matches the traffic from %s to %s, it is denied", direction, acl.Name, src, dst)
+	return step
+}
+
+// evaluateRoute evaluates the routing of the traffic from src to dst, and
+// the gateway that it goes through when it enters or leaves the VPC.
+func (n *Network) evaluateRoute(src, dst *endpoint) []Step {
+	if src.subnet == nil {
+		if !isPublic(src.prefix) {
+			return []Step{{
+				Name:    StepRouting,
+				Allowed: true,
+				Reason:  fmt.Sprintf("The traffic from %s enters the VPC from a private network, e.g. through Direct Link, Transit Gateway or a VPN gateway, which is not analyzed", src),
+			}}
+		}
+		return []Step{floatingIP(dst, fmt.Sprintf("The traffic from %s enters the VPC through the floating IP of %s", src, dst),
+			fmt.Sprintf("%s has no floating IP, the traffic from %s cannot enter the VPC. Public gateways only allow outbound connections", dst, src))}
+	}
+
+	rt := n.routingTable(src.subnet.RoutingTable.ID)
+	step := Step{Name: StepRouting, ResourceType: "routing_table", ResourceID: rt.ID}
+	var best *Route
+	for i, r := range rt.Routes {
+		if r.Zone.Name != src.subnet.Zone.Name || !covers(r.Destination, dst.prefix) {
+			continue
+		}
+		if best == nil {
+			best = &rt.Routes[i]
+			continue
+		}
+		// The most specific route applies, then the one with the highest
+		// priority, i.e. the smallest value.
+		bits, bestBits := prefixBits(r.Destination), prefixBits(best.Destination)
+		if bits > bestBits || (bits == bestBits && r.Priority < best.Priority) {
+			best = &rt.Routes[i]
+		}
+	}
+	delegated := ""
+	if best != nil {
+		step.RuleID, step.RuleName = best.ID, best.Name
+		switch best.Action {
+		case "drop":
+			step.Reason = fmt.Sprintf("Route %s of routing table %s drops the traffic to %s", ruleName(best.Name, best.ID), rt.Name, dst)
+			return []Step{step}
+		case "deliver":
+			step.Allowed = true
+			step.Reason = fmt.Sprintf("Route %s of routing table %s delivers the traffic to %s to next hop %s, which is expected to forward it", ruleName(best.Name, best.ID), rt.Name, dst, best.NextHop.Address+best.NextHop.ID)
+			return []Step{step}
+		}
+		delegated = fmt.Sprintf("Route %s of routing table %s delegates the traffic to %s to the system routes. ", ruleName(best.Name, best.ID), rt.Name, dst)
+	}
+
+	switch {
+	case dst.subnet != nil:
+		step.Allowed = true
+		step.Reason = delegated + fmt.Sprintf("%s is in subnet %s of the VPC", dst, dst.subnet.Name)
+	case isServiceNetwork(dst.prefix):
+		step.Allowed = true
+		step.Reason = delegated + fmt.Sprintf("%s is in the IBM Cloud service network, which the VPC routes to", dst)
+	case isPublic(dst.prefix):
+		step.Allowed = true
+		step.Reason = delegated + fmt.Sprintf("The traffic to %s leaves the VPC for the internet", dst)
+		if src.FloatingIP {
+			return []Step{step, floatingIP(src, fmt.Sprintf("The traffic to %s leaves the VPC through the floating IP of %s", dst, src), "")}
+		}
+		gateway := Step{Name: StepGateway, ResourceType: "public_gateway"}
+		if src.subnet.PublicGateway != nil {
+			gateway.Allowed, gateway.ResourceID = true, src.subnet.PublicGateway.ID
+			gateway.Reason = fmt.Sprintf("The traffic to %s leaves the VPC through public gateway %s of subnet %s", dst, src.subnet.PublicGateway.Name, src.subnet.Name)
+		} else {
+			gateway.Reason = fmt.Sprintf("Subnet %s has no public gateway and %s has no floating IP, the traffic cannot reach %s on the internet", src.subnet.Name, src, dst)
+		}
+		return []Step{step, gateway}
+	default:
+		step.Reason = delegated + fmt.Sprintf("No route of routing table %s leads to %s. The routes learned from Direct Link and Transit Gateway are not analyzed", rt.Name, dst)
+	}
+	return []Step{step}
+}
+
+func floatingIP(e *endpoint, allowed, denied string) Step {
+	step := Step{Name: StepGateway, ResourceType: "floating_ip", Allowed: e.FloatingIP, Reason: denied}
+	if e.FloatingIP {
+		step.Reason = allowed
+	}
+	return step
+}
+
+func prefixBits(cidr string) int {
+	p, _ := parsePrefix(cidr)
+	return p.Bits()
+}
+
+var (
+	serviceNetworks = []netip.Prefix{
+		netip.MustParsePrefix("161.26.0.0/16"),
+		netip.MustParsePrefix("166.8.0.0/14"),
+	}
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+)
+
+// isServiceNetwork reports whether prefix is in the networks of the IBM Cloud
+// services that a VPC reaches without a public gateway.
+func isServiceNetwork(prefix netip.Prefix) bool {
+	for _, network := range serviceNetworks {
+		if network.Bits() <= prefix.Bits() && network.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPublic reports whether prefix is on the internet.
+func isPublic(prefix netip.Prefix) bool {
+	addr := prefix.Addr()
+	return !addr.IsPrivate() && !addr.IsLoopback() && !addr.IsLinkLocalUnicast() && !sharedAddressSpace.Contains(addr) && !isServiceNetwork(prefix)
+}
+
+func ruleName(name, id string) string {
+	if name != "" {
+		return name
+	}
+	return id
+}
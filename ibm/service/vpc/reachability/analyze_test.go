@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package reachability
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func loadNetwork(t *testing.T) *Network {
+	t.Helper()
+	data, err := os.ReadFile("testdata/network.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	network, err := ParseNetwork(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return network
+}
+
+func icmpType(value int64) *int64 {
+	return &value
+}
+
+func TestAnalyze(t *testing.T) {
+	network := loadNetwork(t)
+	web := Endpoint{Name: "web-1", CIDR: "10.240.0.4", SecurityGroups: []string{"r006-sg-web"}}
+	web2 := Endpoint{Name: "web-2", CIDR: "10.240.0.5", SecurityGroups: []string{"r006-sg-web"}}
+	db := Endpoint{Name: "db-1", CIDR: "10.240.1.4", Subnet: "0717-db", SecurityGroups: []string{"r006-sg-db"}}
+	dbWithFloatingIP := db
+	dbWithFloatingIP.FloatingIP = true
+	webWithFloatingIP := web
+	webWithFloatingIP.FloatingIP = true
+	edge := Endpoint{Name: "edge-1", CIDR: "10.240.64.8", SecurityGroups: []string{"r006-sg-web"}}
+	internet := Endpoint{CIDR: "198.51.100.10"}
+
+	for _, tc := range []struct {
+		name                string
+		source, destination Endpoint
+		flow                Flow
+		// The step that denies the traffic and its rule, when it is not
+		// reachable.
+		deniedBy, rule string
+		// The steps, when they are checked.
+		steps []string
+	}{
+		{
+			name: "web to db", source: web, destination: db, flow: Flow{Protocol: "tcp", Port: 5432},
+			steps: []string{
+				"source_security_groups:r006-sg-web-egress",
+				"source_network_acl:r006-acl-web-out",
+				"routing:",
+				"destination_network_acl:r006-acl-db-postgres",
+				"destination_security_groups:r006-sg-db-postgres",
+				"reply_destination_network_acl:r006-acl-db-replies-out",
+				"reply_source_network_acl:r006-acl-web-in",
+			},
+		},
+		{
+			name: "port denied by a network ACL", source: web, destination: db, flow: Flow{Protocol: "tcp", Port: 22},
+			deniedBy: StepDestinationNetworkACL, rule: "r006-acl-db-deny-in",
+		},
+		{
+			name: "source outside of the remote security group", source: Endpoint{CIDR: "10.240.0.9"}, destination: db, flow: Flow{Protocol: "tcp", Port: 5432},
+			deniedBy: StepDestinationSecurityGroups,
+		},
+		{
+			name: "port denied before a network ACL rule that allows it", source: web, destination: internet, flow: Flow{Protocol: "tcp", Port: 25},
+			deniedBy: StepSourceNetworkACL, rule: "r006-acl-web-smtp",
+		},
+		{
+			name: "internet through the public gateway", source: web, destination: internet, flow: Flow{Protocol: "tcp", Port: 443},
+			steps: []string{
+				"source_security_groups:r006-sg-web-egress",
+				"source_network_acl:r006-acl-web-out",
+				"routing:",
+				"gateway:",
+				"reply_source_network_acl:r006-acl-web-in",
+			},
+		},
+		{
+			name: "internet without a public gateway", source: db, destination: internet, flow: Flow{Protocol: "tcp", Port: 443},
+			deniedBy: StepGateway,
+		},
+		{
+			name: "internet through a floating IP", source: dbWithFloatingIP, destination: internet, flow: Flow{Protocol: "tcp", Port: 443},
+		},
+		{
+			name: "reply to a source port denied by a network ACL", source: db, destination: web, flow: Flow{Protocol: "tcp", Port: 443, SourcePort: 80},
+			deniedBy: StepReplySourceNetworkACL, rule: "r006-acl-db-deny-in",
+		},
+		{
+			name: "route that drops the traffic", source: web, destination: Endpoint{CIDR: "203.0.113.5"}, flow: Flow{Protocol: "tcp", Port: 443},
+			deniedBy: StepRouting, rule: "r006-route-blackhole",
+		},
+		{
+			name: "route to a next hop", source: edge, destination: internet, flow: Flow{Protocol: "tcp", Port: 443},
+			steps: []string{
+				"source_security_groups:r006-sg-web-egress",
+				"source_network_acl:r006-acl-web-out",
+				"routing:r006-route-firewall",
+				"reply_source_network_acl:r006-acl-web-in",
+			},
+		},
+		{
+			name: "route delegated to the system routes", source: edge, destination: db, flow: Flow{Protocol: "tcp", Port: 5432},
+			deniedBy: StepDestinationNetworkACL, rule: "r006-acl-db-deny-in",
+			steps: []string{
+				"source_security_groups:r006-sg-web-egress",
+				"source_network_acl:r006-acl-web-out",
+				"routing:r006-route-local",
+				"destination_network_acl:r006-acl-db-deny-in",
+				"destination_security_groups:r006-sg-db-postgres",
+				"reply_destination_network_acl:r006-acl-db-deny-out",
+				"reply_source_network_acl:r006-acl-web-in",
+			},
+		},
+		{
+			name: "internet to an instance without a floating IP", source: internet, destination: web, flow: Flow{Protocol: "tcp", Port: 443},
+			deniedBy: StepGateway,
+		},
+		{
+			name: "internet to an instance with a floating IP", source: internet, destination: webWithFloatingIP, flow: Flow{Protocol: "tcp", Port: 443},
+			steps: []string{
+				"gateway:",
+				"destination_network_acl:r006-acl-web-in",
+				"destination_security_groups:r006-sg-web-https",
+				"reply_destination_network_acl:r006-acl-web-out",
+			},
+		},
+		{
+			name: "same subnet", source: web2, destination: web, flow: Flow{Protocol: "tcp", Port: 22},
+			steps: []string{"source_security_groups:r006-sg-web-egress", "destination_security_groups:r006-sg-web-ssh"},
+		},
+		{
+			name: "icmp type", source: web2, destination: web, flow: Flow{Protocol: "icmp", Type: icmpType(8)},
+		},
+		{
+			name: "icmp type denied", source: web2, destination: web, flow: Flow{Protocol: "icmp", Type: icmpType(13)},
+			deniedBy: StepDestinationSecurityGroups,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Analyze(network, tc.source, tc.destination, tc.flow)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, step := range result.Steps {
+				if step.Reason == "" {
+					t.Errorf("Step %s has no reason", step.Name)
+				}
+			}
+			if tc.steps != nil {
+				var steps []string
+				for _, step := range result.Steps {
+					steps = append(steps, step.Name+":"+step.RuleID)
+				}
+				if strings.Join(steps, "\n") != strings.Join(tc.steps, "\n") {
+					t.Errorf("Unexpected steps\n%s\nwant\n%s", strings.Join(steps, "\n"), strings.Join(tc.steps, "\n"))
+				}
+			}
+
+			decidedBy := result.DecidedBy()
+			if tc.deniedBy == "" {
+				if !result.Reachable || decidedBy != nil {
+					t.Fatalf("Expected the destination to be reachable, denied by %+v", decidedBy)
+				}
+				return
+			}
+			if result.Reachable || decidedBy == nil {
+				t.Fatal("Expected the destination to be unreachable")
+			}
+			if decidedBy.Name != tc.deniedBy || decidedBy.RuleID != tc.rule {
+				t.Errorf("Expected the traffic to be denied by %s %s, got %+v", tc.deniedBy, tc.rule, decidedBy)
+			}
+		})
+	}
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	network := loadNetwork(t)
+	web := Endpoint{CIDR: "10.240.0.4"}
+
+	for _, tc := range []struct {
+		source, destination Endpoint
+		flow                Flow
+		want                string
+	}{
+		{web, Endpoint{CIDR: "10.240.1.4"}, Flow{Protocol: "tcp"}, "The port of tcp traffic is required"},
+		{web, Endpoint{CIDR: "10.240.1.4"}, Flow{}, "The protocol of the traffic is required"},
+		{Endpoint{CIDR: "192.0.2.1"}, Endpoint{CIDR: "198.51.100.0/24"}, Flow{Protocol: "udp", Port: 53}, "Neither the source 192.0.2.1 nor the destination 198.51.100.0/24"},
+		{web, Endpoint{CIDR: "10.240.1.4", Subnet: "0717-gone"}, Flow{Protocol: "all"}, "Subnet 0717-gone not found"},
+		{Endpoint{CIDR: "10.240.0.4", SecurityGroups: []string{"r006-sg-gone"}}, web, Flow{Protocol: "all"}, "Security group r006-sg-gone not found"},
+		{Endpoint{CIDR: "10.240.0.300"}, web, Flow{Protocol: "all"}, "Error parsing address"},
+	} {
+		_, err := Analyze(network, tc.source, tc.destination, tc.flow)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("Expected an error %q, got %v", tc.want, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package reachability evaluates whether traffic between two endpoints of a
+// VPC is allowed, from the security groups, network ACLs, routing tables and
+// public gateways that it passes through. The evaluation is offline: it
+// reads the objects of the VPC API as JSON, whether they were just fetched
+// or saved as fixtures.
+package reachability
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+)
+
+// Network is the part of a VPC that traffic between two endpoints passes
+// through. Its JSON is the JSON of the VPC API, with the routes of the
+// routing tables embedded rather than referenced.
+type Network struct {
+	Subnets        []Subnet        `json:"subnets"`
+	SecurityGroups []SecurityGroup `json:"security_groups"`
+	NetworkACLs    []NetworkACL    `json:"network_acls"`
+	RoutingTables  []RoutingTable  `json:"routing_tables"`
+}
+
+// Reference is the reference to another object of the VPC API.
+type Reference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Subnet struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	CIDR          string     `json:"ipv4_cidr_block"`
+	Zone          Reference  `json:"zone"`
+	NetworkACL    Reference  `json:"network_acl"`
+	RoutingTable  Reference  `json:"routing_table"`
+	PublicGateway *Reference `json:"public_gateway"`
+}
+
+type SecurityGroup struct {
+	ID    string              `json:"id"`
+	Name  string              `json:"name"`
+	Rules []SecurityGroupRule `json:"rules"`
+}
+
+type SecurityGroupRule struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Direction string `json:"direction"`
+	Protocol  string `json:"protocol"`
+	// Remote and Local have one of an address, a CIDR block or, for Remote,
+	// a security group.
+	Remote struct {
+		Address   string `json:"address"`
+		CIDRBlock string `json:"cidr_block"`
+		ID        string `json:"id"`
+	} `json:"remote"`
+	Local struct {
+		Address   string `json:"address"`
+		CIDRBlock string `json:"cidr_block"`
+	} `json:"local"`
+	PortMin *int64 `json:"port_min"`
+	PortMax *int64 `json:"port_max"`
+	Type    *int64 `json:"type"`
+	Code    *int64 `json:"code"`
+}
+
+type NetworkACL struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Rules are in the order in which they are evaluated.
+	Rules []NetworkACLRule `json:"rules"`
+}
+
+type NetworkACLRule struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Action             string `json:"action"`
+	Direction          string `json:"direction"`
+	Protocol           string `json:"protocol"`
+	Source             string `json:"source"`
+	Destination        string `json:"destination"`
+	DestinationPortMin *int64 `json:"destination_port_min"`
+	DestinationPortMax *int64 `json:"destination_port_max"`
+	SourcePortMin      *int64 `json:"source_port_min"`
+	SourcePortMax      *int64 `json:"source_port_max"`
+	Type               *int64 `json:"type"`
+	Code               *int64 `json:"code"`
+}
+
+type RoutingTable struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Routes []Route `json:"routes"`
+}
+
+type Route struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Action      string    `json:"action"`
+	Destination string    `json:"destination"`
+	Zone        Reference `json:"zone"`
+	Priority    int64     `json:"priority"`
+	NextHop     struct {
+		Address string `json:"address"`
+		ID      string `json:"id"`
+	} `json:"next_hop"`
+}
+
+// ParseNetwork parses the JSON of a network. The objects of the VPC SDK can
+// be marshalled as is into it.
+func ParseNetwork(data []byte) (*Network, error) {
+	network := &Network{}
+	if err := json.Unmarshal(data, network); err != nil {
+		return nil, fmt.Errorf("Error parsing the network: %s", err)
+	}
+	for _, subnet := range network.Subnets {
+		if _, err := netip.ParsePrefix(subnet.CIDR); err != nil {
+			return nil, fmt.Errorf("Error parsing the CIDR block of subnet %s: %s", subnet.ID, err)
+		}
+	}
+	return network, nil
+}
+
+func (n *Network) subnet(id string) *Subnet {
+	for i := range n.Subnets {
+		if n.Subnets[i].ID == id {
+			return &n.Subnets[i]
+		}
+	}
+	return nil
+}
+
+func (n *Network) securityGroup(id string) *SecurityGroup {
+	for i := range n.SecurityGroups {
+		if n.SecurityGroups[i].ID == id {
+			return &n.SecurityGroups[i]
+		}
+	}
+	return nil
+}
+
+func (n *Network) networkACL(id string) *NetworkACL {
+	for i := range n.NetworkACLs {
+		if n.NetworkACLs[i].ID == id {
+			return &n.NetworkACLs[i]
+		}
+	}
+	return nil
+}
+
+func (n *Network) routingTable(id string) *RoutingTable {
+	for i := range n.RoutingTables {
+		if n.RoutingTables[i].ID == id {
+			return &n.RoutingTables[i]
+		}
+	}
+	return nil
+}
@@ -30,7 +30,9 @@ func ResourceIBMIsBareMetalServerAction() *schema.Resource {
 		ReadContext:   resourceIBMISBareMetalServerActionRead,
 		UpdateContext: resourceIBMISBareMetalServerActionUpdate,
 		DeleteContext: resourceIBMISBareMetalServerActionDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isBareMetalServerID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
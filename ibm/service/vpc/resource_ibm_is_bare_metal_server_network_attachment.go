@@ -25,7 +25,9 @@ func ResourceIBMIsBareMetalServerNetworkAttachment() *schema.Resource {
 		ReadContext:   resourceIBMIsBareMetalServerNetworkAttachmentRead,
 		UpdateContext: resourceIBMIsBareMetalServerNetworkAttachmentUpdate,
 		DeleteContext: resourceIBMIsBareMetalServerNetworkAttachmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "bare_metal_server", ""),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -36,7 +36,9 @@ func ResourceIBMIsBareMetalServerNetworkInterface() *schema.Resource {
 		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceRead,
 		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceUpdate,
 		DeleteContext: resourceIBMISBareMetalServerNetworkInterfaceDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isBareMetalServerID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
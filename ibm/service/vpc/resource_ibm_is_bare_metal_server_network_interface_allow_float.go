@@ -27,7 +27,9 @@ func ResourceIBMIsBareMetalServerNetworkInterfaceAllowFloat() *schema.Resource {
 		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceAllowFloatRead,
 		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceAllowFloatUpdate,
 		DeleteContext: resourceIBMISBareMetalServerNetworkInterfaceAllowFloatDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isBareMetalServerID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
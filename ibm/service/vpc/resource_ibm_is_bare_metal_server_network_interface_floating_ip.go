@@ -33,7 +33,9 @@ func ResourceIBMIsBareMetalServerNetworkInterfaceFloatingIp() *schema.Resource {
 		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceFloatingIpRead,
 		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceFloatingIpUpdate,
 		DeleteContext: resourceIBMISBareMetalServerNetworkInterfaceFloatingIpDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isBareMetalServerID, isBareMetalServerNetworkInterface, isBareMetalServerNetworkInterfaceFloatingIPID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -24,7 +24,9 @@ func ResourceIBMIsClusterNetworkInterface() *schema.Resource {
 		ReadContext:   resourceIBMIsClusterNetworkInterfaceRead,
 		UpdateContext: resourceIBMIsClusterNetworkInterfaceUpdate,
 		DeleteContext: resourceIBMIsClusterNetworkInterfaceDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "cluster_network_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_network_id": &schema.Schema{
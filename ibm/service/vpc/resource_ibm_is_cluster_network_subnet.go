@@ -24,7 +24,9 @@ func ResourceIBMIsClusterNetworkSubnet() *schema.Resource {
 		ReadContext:   resourceIBMIsClusterNetworkSubnetRead,
 		UpdateContext: resourceIBMIsClusterNetworkSubnetUpdate,
 		DeleteContext: resourceIBMIsClusterNetworkSubnetDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "cluster_network_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_network_id": &schema.Schema{
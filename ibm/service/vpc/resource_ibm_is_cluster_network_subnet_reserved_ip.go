@@ -24,7 +24,9 @@ func ResourceIBMIsClusterNetworkSubnetReservedIP() *schema.Resource {
 		ReadContext:   resourceIBMIsClusterNetworkSubnetReservedIPRead,
 		UpdateContext: resourceIBMIsClusterNetworkSubnetReservedIPUpdate,
 		DeleteContext: resourceIBMIsClusterNetworkSubnetReservedIPDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "cluster_network_id", "cluster_network_subnet_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_network_id": &schema.Schema{
@@ -40,7 +40,7 @@ const (
 )
 
 func ResourceIBMISFloatingIP() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISFloatingIPCreate,
 		ReadContext:   resourceIBMISFloatingIPRead,
 		UpdateContext: resourceIBMISFloatingIPUpdate,
@@ -272,7 +272,10 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
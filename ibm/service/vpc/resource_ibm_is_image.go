@@ -56,7 +56,7 @@ const (
 )
 
 func ResourceIBMISImage() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISImageCreate,
 		ReadContext:   resourceIBMISImageRead,
 		UpdateContext: resourceIBMISImageUpdate,
@@ -292,7 +292,10 @@ func ResourceIBMISImage() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISImageValidator() *validate.ResourceValidator {
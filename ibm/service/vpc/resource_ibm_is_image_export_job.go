@@ -27,7 +27,9 @@ func ResourceIBMIsImageExportJob() *schema.Resource {
 		ReadContext:   ResourceIBMIsImageExportRead,
 		UpdateContext: ResourceIBMIsImageExportUpdate,
 		DeleteContext: ResourceIBMIsImageExportDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "image", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"image": {
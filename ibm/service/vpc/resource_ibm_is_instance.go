@@ -134,7 +134,7 @@ const (
 )
 
 func ResourceIBMISInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMisInstanceCreate,
 		ReadContext:   resourceIBMisInstanceRead,
 		UpdateContext: resourceIBMisInstanceUpdate,
@@ -2181,7 +2181,10 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
@@ -2295,7 +2298,40 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 		MaxValueLength:             128,
 	})
 
-	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: "boot_volume.0.profile", AllowedValues: "sdp", Not: true}},
+			Identifier: "boot_volume.0.size",
+			MaxValue:   "250",
+			Message:    "boot volumes of tiered profiles hold at most 250 GB",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "boot_volume.0.profile", AllowedValues: "sdp", Not: true}},
+			Targets: []string{"boot_volume.0.bandwidth"},
+			Message: "only volumes of the sdp profile set their bandwidth",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "boot_volume.0.profile", AllowedValues: "general-purpose, 5iops-tier, 10iops-tier"}},
+			Targets: []string{"boot_volume.0.iops"},
+			Message: "the IOPS of tiered profiles are set by their tier",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "volume_prototypes.*.volume_profile", AllowedValues: "general-purpose, 5iops-tier, 10iops-tier"}},
+			Targets: []string{"volume_prototypes.*.volume_iops"},
+			Message: "the IOPS of tiered profiles are set by their tier",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "volume_prototypes.*.volume_profile", AllowedValues: "custom"}},
+			Targets: []string{"volume_prototypes.*.volume_iops"},
+		},
+	}
+
+	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema, Rules: rules}
 	return &ibmISInstanceValidator
 }
 
@@ -32,7 +32,9 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 		UpdateContext: resourceIBMISInstanceActionUpdate,
 		DeleteContext: resourceIBMISInstanceActionDelete,
 		Exists:        resourceIBMISInstanceActionExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isInstanceID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -24,7 +24,9 @@ func ResourceIBMIsInstanceClusterNetworkAttachment() *schema.Resource {
 		ReadContext:   resourceIBMIsInstanceClusterNetworkAttachmentRead,
 		UpdateContext: resourceIBMIsInstanceClusterNetworkAttachmentUpdate,
 		DeleteContext: resourceIBMIsInstanceClusterNetworkAttachmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
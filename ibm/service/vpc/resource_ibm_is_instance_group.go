@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rollingupdate"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -31,10 +32,20 @@ const (
 	isInstanceGroupAccessTags    = "access_tags"
 	isInstanceGroupUserTagType   = "user"
 	isInstanceGroupAccessTagType = "access"
+
+	isInstanceGroupRollingUpdate                   = "rolling_update"
+	isInstanceGroupRollingUpdateMaxUnavailable     = "max_unavailable"
+	isInstanceGroupRollingUpdateMaxSurge           = "max_surge"
+	isInstanceGroupRollingUpdateHealthCheckTimeout = "health_check_timeout"
 )
 
+// instanceGroupPollInterval is how often an instance group and its
+// memberships are polled while they change. Tests against the mock cloud,
+// where they change at once, shorten it.
+var instanceGroupPollInterval = 10 * time.Second
+
 func ResourceIBMISInstanceGroup() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISInstanceGroupCreate,
 		ReadContext:   resourceIBMISInstanceGroupRead,
 		UpdateContext: resourceIBMISInstanceGroupUpdate,
@@ -167,8 +178,43 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			isInstanceGroupRollingUpdate: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replaces the members of the instance group in batches when instance_template changes, instead of only creating the new members from it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceGroupRollingUpdateMaxUnavailable: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateMaxUnavailable),
+							Description:  "The number of members of a batch that are deleted before their replacements are created",
+						},
+						isInstanceGroupRollingUpdateMaxSurge: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateMaxSurge),
+							Description:  "The number of members of a batch that are created before the members that they replace are deleted",
+						},
+						isInstanceGroupRollingUpdateHealthCheckTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateHealthCheckTimeout),
+							Description:  "How long in seconds the new members of a batch, and their load balancer pool members, have to be healthy before the batch is rolled back",
+						},
+					},
+				},
+			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
@@ -216,10 +262,52 @@ func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISInstanceGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group", Schema: validateSchema}
+	for _, count := range []string{isInstanceGroupRollingUpdateMaxUnavailable, isInstanceGroupRollingUpdateMaxSurge} {
+		validateSchema = append(validateSchema,
+			validate.ValidateSchema{
+				Identifier:                 count,
+				ValidateFunctionIdentifier: validate.IntBetween,
+				Type:                       validate.TypeInt,
+				Optional:                   true,
+				MinValue:                   "0",
+				MaxValue:                   "1000"})
+	}
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceGroupRollingUpdateHealthCheckTimeout,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1"})
+
+	rules := []validate.CrossFieldRule{
+		{
+			Type:  validate.Custom,
+			Check: resourceIBMISInstanceGroupValidateRollingUpdate,
+		},
+	}
+
+	ibmISInstanceGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group", Schema: validateSchema, Rules: rules}
 	return &ibmISInstanceGroupResourceValidator
 }
 
+// resourceIBMISInstanceGroupValidateRollingUpdate checks that a batch of a
+// rolling update replaces at least one member.
+func resourceIBMISInstanceGroupValidateRollingUpdate(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(diff.Get(isInstanceGroupRollingUpdate).([]interface{})) == 0 {
+		return nil
+	}
+	maxUnavailable := fmt.Sprintf("%s.0.%s", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateMaxUnavailable)
+	maxSurge := fmt.Sprintf("%s.0.%s", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateMaxSurge)
+	if !diff.NewValueKnown(maxUnavailable) || !diff.NewValueKnown(maxSurge) {
+		return nil
+	}
+	if diff.Get(maxUnavailable).(int)+diff.Get(maxSurge).(int) < 1 {
+		return fmt.Errorf("[ERROR] %s: %s and %s cannot both be 0", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateMaxUnavailable, isInstanceGroupRollingUpdateMaxSurge)
+	}
+	return nil
+}
+
 func resourceIBMISInstanceGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get("name").(string)
@@ -321,6 +409,20 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 		return tfErr.GetDiag()
 	}
 
+	rollingUpdate := d.HasChange("instance_template") && len(d.Get(isInstanceGroupRollingUpdate).([]interface{})) > 0
+	if rollingUpdate {
+		// Check before the instance template changes, so that the update
+		// fails without changing the instance group.
+		err = resourceIBMISInstanceGroupCheckAutoscale(context, sess, d.Id())
+		if err != nil {
+			// Nothing changed, so the state is kept as it was
+			d.Partial(true)
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
 	var changed bool
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
@@ -409,9 +511,260 @@ func resourceIBMISInstanceGroupUpdate(context context.Context, d *schema.Resourc
 			return tfErr.GetDiag()
 		}
 	}
+
+	if rollingUpdate {
+		err = resourceIBMISInstanceGroupRollingUpdate(context, d, meta, sess)
+		if err != nil {
+			// The members that were not replaced, and the group after a
+			// rollback, are on the previous instance template. Keep it in
+			// the state, so that the next plan updates the template again
+			// and replaces them.
+			previous, _ := d.GetChange("instance_template")
+			d.Set("instance_template", previous)
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Rolling update of the members failed: %s", err.Error()), "ibm_is_instance_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISInstanceGroupRead(context, d, meta)
 }
 
+// resourceIBMISInstanceGroupCheckAutoscale returns an error when the instance
+// group has an enabled autoscale manager, which would resize the instance
+// group while a rolling update changes its membership count.
+func resourceIBMISInstanceGroupCheckAutoscale(context context.Context, sess *vpcv1.VpcV1, instanceGroupID string) error {
+	start := ""
+	for {
+		listInstanceGroupManagersOptions := vpcv1.ListInstanceGroupManagersOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupManagersOptions.Start = &start
+		}
+		instanceGroupManagerCollection, _, err := sess.ListInstanceGroupManagersWithContext(context, &listInstanceGroupManagersOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the managers of instance group %s: %s", instanceGroupID, err)
+		}
+		for _, instanceGroupManagerIntf := range instanceGroupManagerCollection.Managers {
+			instanceGroupManager, ok := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
+			if !ok || instanceGroupManager.ManagerType == nil || *instanceGroupManager.ManagerType != vpcv1.InstanceGroupManagerManagerTypeAutoscaleConst {
+				continue
+			}
+			if instanceGroupManager.ManagementEnabled != nil && *instanceGroupManager.ManagementEnabled {
+				return fmt.Errorf("[ERROR] The autoscale manager %s of instance group %s is enabled: set enable_manager to false on the manager, in an apply before the rolling update, and restore it after", *instanceGroupManager.ID, instanceGroupID)
+			}
+		}
+		start = flex.GetNext(instanceGroupManagerCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+// resourceIBMISInstanceGroupRollingUpdate replaces the members of the
+// instance group that were created from the previous instance template.
+func resourceIBMISInstanceGroupRollingUpdate(context context.Context, d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1) error {
+	instanceGroupID := d.Id()
+	instanceGroup, _, err := sess.GetInstanceGroupWithContext(context, &vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID})
+	if err != nil {
+		return err
+	}
+	previous, template := d.GetChange("instance_template")
+	group := &instanceGroupMembers{
+		sess:            sess,
+		meta:            meta,
+		instanceGroupID: instanceGroupID,
+		lbID:            d.Get("load_balancer").(string),
+		lbPoolID:        d.Get("load_balancer_pool").(string),
+		timeout:         d.Timeout(schema.TimeoutUpdate),
+		pollInterval:    instanceGroupPollInterval,
+	}
+	options := rollingupdate.Options{
+		MaxUnavailable:     d.Get(fmt.Sprintf("%s.0.%s", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateMaxUnavailable)).(int),
+		MaxSurge:           d.Get(fmt.Sprintf("%s.0.%s", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateMaxSurge)).(int),
+		HealthCheckTimeout: time.Duration(d.Get(fmt.Sprintf("%s.0.%s", isInstanceGroupRollingUpdate, isInstanceGroupRollingUpdateHealthCheckTimeout)).(int)) * time.Second,
+	}
+	return rollingupdate.Replace(context, group, int(*instanceGroup.MembershipCount), template.(string), previous.(string), options)
+}
+
+// instanceGroupMembers is the rollingupdate.Group of an instance group,
+// through the membership APIs.
+type instanceGroupMembers struct {
+	sess            *vpcv1.VpcV1
+	meta            interface{}
+	instanceGroupID string
+	// The load balancer pool of the members, if any
+	lbID, lbPoolID string
+	// timeout is how long the group has to scale
+	timeout time.Duration
+	// pollInterval is how often the memberships are polled while they are
+	// deleted or become healthy
+	pollInterval time.Duration
+}
+
+func (g *instanceGroupMembers) Members(context context.Context) ([]rollingupdate.Member, error) {
+	start := ""
+	members := []rollingupdate.Member{}
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &g.instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, _, err := g.sess.ListInstanceGroupMembershipsWithContext(context, &listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the memberships of instance group %s: %s", g.instanceGroupID, err)
+		}
+		for _, membership := range instanceGroupMembershipCollection.Memberships {
+			if *membership.Status == vpcv1.InstanceGroupMembershipStatusDeletingConst || membership.InstanceTemplate == nil {
+				continue
+			}
+			members = append(members, rollingupdate.Member{ID: *membership.ID, Template: *membership.InstanceTemplate.ID})
+		}
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return members, nil
+}
+
+func (g *instanceGroupMembers) update(context context.Context, instanceGroupPatchModel *vpcv1.InstanceGroupPatch) error {
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return err
+	}
+	_, _, err = g.sess.UpdateInstanceGroupWithContext(context, &vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &g.instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating instance group %s: %s", g.instanceGroupID, err)
+	}
+	_, err = waitForHealthyInstanceGroup(g.instanceGroupID, g.meta, g.timeout)
+	return err
+}
+
+func (g *instanceGroupMembers) SetTemplate(context context.Context, template string) error {
+	return g.update(context, &vpcv1.InstanceGroupPatch{
+		InstanceTemplate: &vpcv1.InstanceTemplateIdentity{ID: &template},
+	})
+}
+
+func (g *instanceGroupMembers) SetMembershipCount(context context.Context, count int) error {
+	return g.update(context, &vpcv1.InstanceGroupPatch{
+		MembershipCount: core.Int64Ptr(int64(count)),
+	})
+}
+
+func (g *instanceGroupMembers) DeleteMember(context context.Context, id string) error {
+	getInstanceGroupMembershipOptions := &vpcv1.GetInstanceGroupMembershipOptions{
+		InstanceGroupID: &g.instanceGroupID,
+		ID:              &id,
+	}
+	membership, _, err := g.sess.GetInstanceGroupMembershipWithContext(context, getInstanceGroupMembershipOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting instance group membership %s: %s", id, err)
+	}
+	// The instance of the member is deleted with it
+	if !*membership.DeleteInstanceOnMembershipDelete {
+		instanceGroupMembershipPatch, err := (&vpcv1.InstanceGroupMembershipPatch{
+			DeleteInstanceOnMembershipDelete: core.BoolPtr(true),
+		}).AsPatch()
+		if err != nil {
+			return err
+		}
+		_, _, err = g.sess.UpdateInstanceGroupMembershipWithContext(context, &vpcv1.UpdateInstanceGroupMembershipOptions{
+			InstanceGroupID:              &g.instanceGroupID,
+			ID:                           &id,
+			InstanceGroupMembershipPatch: instanceGroupMembershipPatch,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating instance group membership %s: %s", id, err)
+		}
+	}
+	_, err = g.sess.DeleteInstanceGroupMembershipWithContext(context, &vpcv1.DeleteInstanceGroupMembershipOptions{
+		InstanceGroupID: &g.instanceGroupID,
+		ID:              &id,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting instance group membership %s: %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.InstanceGroupMembershipStatusDeletingConst, vpcv1.InstanceGroupMembershipStatusHealthyConst, vpcv1.InstanceGroupMembershipStatusUnhealthyConst, vpcv1.InstanceGroupMembershipStatusPendingConst, vpcv1.InstanceGroupMembershipStatusFailedConst},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			membership, response, err := g.sess.GetInstanceGroupMembershipWithContext(context, getInstanceGroupMembershipOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return id, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting instance group membership %s: %s", id, err)
+			}
+			return membership, *membership.Status, nil
+		},
+		Timeout:    g.timeout,
+		Delay:      g.pollInterval,
+		MinTimeout: g.pollInterval,
+	}
+	_, err = stateConf.WaitForStateContext(context)
+	return err
+}
+
+// WaitHealthy waits for the memberships to be healthy and, when the instance
+// group has a load balancer pool, for the health of their pool members to be
+// ok, as ibm_is_lb_pool_member reports it.
+func (g *instanceGroupMembers) WaitHealthy(context context.Context, ids []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.InstanceGroupMembershipStatusPendingConst, vpcv1.InstanceGroupMembershipStatusUnhealthyConst},
+		Target:  []string{vpcv1.InstanceGroupMembershipStatusHealthyConst},
+		Refresh: func() (interface{}, string, error) {
+			for _, id := range ids {
+				membership, _, err := g.sess.GetInstanceGroupMembershipWithContext(context, &vpcv1.GetInstanceGroupMembershipOptions{
+					InstanceGroupID: &g.instanceGroupID,
+					ID:              &id,
+				})
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error getting instance group membership %s: %s", id, err)
+				}
+				switch *membership.Status {
+				case vpcv1.InstanceGroupMembershipStatusHealthyConst:
+				case vpcv1.InstanceGroupMembershipStatusFailedConst, vpcv1.InstanceGroupMembershipStatusDeletingConst:
+					return nil, "", fmt.Errorf("[ERROR] Instance group membership %s is %s", id, *membership.Status)
+				default:
+					return membership, *membership.Status, nil
+				}
+				if membership.PoolMember == nil || g.lbID == "" || g.lbPoolID == "" {
+					continue
+				}
+				poolMember, _, err := g.sess.GetLoadBalancerPoolMemberWithContext(context, &vpcv1.GetLoadBalancerPoolMemberOptions{
+					LoadBalancerID: &g.lbID,
+					PoolID:         &g.lbPoolID,
+					ID:             membership.PoolMember.ID,
+				})
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error getting load balancer pool member %s: %s", *membership.PoolMember.ID, err)
+				}
+				if *poolMember.Health != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+					return poolMember, vpcv1.InstanceGroupMembershipStatusUnhealthyConst, nil
+				}
+			}
+			return ids, vpcv1.InstanceGroupMembershipStatusHealthyConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      g.pollInterval,
+		MinTimeout: g.pollInterval,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return fmt.Errorf("members %s are not healthy: %s", strings.Join(ids, ", "), err)
+	}
+	return nil
+}
+
 func resourceIBMISInstanceGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -675,9 +1028,9 @@ func waitForHealthyInstanceGroup(instanceGroupID string, meta interface{}, timeo
 			return instanceGroup, *instanceGroup.Status, nil
 		},
 		Timeout:      timeout,
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Delay:        2 * instanceGroupPollInterval,
+		MinTimeout:   instanceGroupPollInterval / 2,
+		PollInterval: instanceGroupPollInterval,
 	}
 
 	return healthStateConf.WaitForState()
@@ -696,9 +1049,9 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 			return resp, DELETING, err
 		},
 		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        20 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 10 * time.Second,
+		Delay:        2 * instanceGroupPollInterval,
+		MinTimeout:   instanceGroupPollInterval / 2,
+		PollInterval: instanceGroupPollInterval,
 	}
 
 	return healthStateConf.WaitForState()
@@ -179,7 +179,22 @@ func ResourceIBMISInstanceGroupManagerValidator() *validate.ResourceValidator {
 			MinValue:                   "1",
 			MaxValue:                   "1000"})
 
-	ibmISInstanceGroupManagerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group_manager", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "manager_type", AllowedValues: "scheduled"}},
+			Targets: []string{"aggregation_window", "cooldown", "max_membership_count", "min_membership_count"},
+			Message: "a scheduled manager scales by the membership counts of its actions",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "manager_type", AllowedValues: "scheduled", Not: true}},
+			Targets: []string{"max_membership_count"},
+		},
+		{Type: validate.RangeDependsOn, Identifier: "min_membership_count", MaxValue: "max_membership_count"},
+	}
+
+	ibmISInstanceGroupManagerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group_manager", Schema: validateSchema, Rules: rules}
 	return &ibmISInstanceGroupManagerResourceValidator
 }
 
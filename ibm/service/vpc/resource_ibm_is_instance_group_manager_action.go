@@ -25,7 +25,9 @@ func ResourceIBMISInstanceGroupManagerAction() *schema.Resource {
 		UpdateContext: resourceIBMISInstanceGroupManagerActionUpdate,
 		DeleteContext: resourceIBMISInstanceGroupManagerActionDelete,
 		Exists:        resourceIBMISInstanceGroupManagerActionExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance_group", "instance_group_manager", ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -38,7 +38,9 @@ func ResourceIBMISInstanceGroupMembership() *schema.Resource {
 		ReadContext:   resourceIBMISInstanceGroupMembershipRead,
 		UpdateContext: resourceIBMISInstanceGroupMembershipUpdate,
 		DeleteContext: resourceIBMISInstanceGroupMembershipDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance_group", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -5,16 +5,22 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccIBMISInstanceGroup_basic(t *testing.T) {
@@ -109,6 +115,46 @@ func TestAccIBMISInstanceGroup_basic_loadbalancer(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroup_rollingUpdate(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	instanceGroupManager := fmt.Sprintf("testinstancegroupmanager%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "instancetemplate1", true),
+			},
+			{
+				// The autoscale manager would resize the group during the update
+				Config:      testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "instancetemplate2", true),
+				ExpectError: regexp.MustCompile("autoscale manager .* is enabled"),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "instancetemplate1", false),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "instancetemplate2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate2", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "instance_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -229,3 +275,124 @@ func testAccCheckIBMISInstanceGroupConfig(vpcName, subnetName, sshKeyName, publi
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName)
 
 }
+
+func testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, template string, enableManager bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%[5]s-1"
+	   image   = "%[6]s"
+	   profile = "bx2-8x32"
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate2" {
+	   name    = "%[5]s-2"
+	   image   = "%[6]s"
+	   profile = "bx2-2x8"
+
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	}
+
+	resource "ibm_is_instance_group" "instance_group" {
+		name              = "%[7]s"
+		instance_template = ibm_is_instance_template.%[9]s.id
+		instance_count    = 2
+		subnets           = [ibm_is_subnet.subnet2.id]
+
+		rolling_update {
+			max_surge       = 1
+			max_unavailable = 1
+		}
+	}
+
+	resource "ibm_is_instance_group_manager" "instance_group_manager" {
+		name                 = "%[8]s"
+		aggregation_window   = 120
+		instance_group       = ibm_is_instance_group.instance_group.id
+		cooldown             = 300
+		manager_type         = "autoscale"
+		enable_manager       = %[10]t
+		max_membership_count = 2
+		min_membership_count = 1
+	}
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName, instanceGroupManager, template, enableManager)
+}
+
+func TestIBMISInstanceGroupRollingUpdateMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpc.SetInstanceGroupPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-ig-vpc"})
+	subnet := acc.NewLifecycle(t, cloud, "ibm_is_subnet")
+	subnetState := subnet.Apply(nil, map[string]interface{}{"name": "tf-mock-ig-subnet", "vpc": vpcState.ID, "zone": mockcloud.Region + "-1", "total_ipv4_address_count": 16})
+	v1 := cloud.AddInstanceTemplate("tf-mock-template-1", true)
+	broken := cloud.AddInstanceTemplate("tf-mock-template-2", false)
+
+	l := acc.NewLifecycle(t, cloud, "ibm_is_instance_group")
+	config := map[string]interface{}{
+		"name":              "tf-mock-ig",
+		"instance_template": v1,
+		"instance_count":    1,
+		"subnets":           []interface{}{subnetState.ID},
+		"rolling_update":    []interface{}{map[string]interface{}{"max_surge": 1}},
+	}
+	state := l.Apply(nil, config)
+	assert.True(t, cloud.Exists("instance_groups", state.ID))
+	assert.Equal(t, v1, state.Attributes["instance_template"])
+
+	// The members of the broken template fail, the batch is rolled back
+	// and the state keeps the previous template
+	config["instance_template"] = broken
+	diff, err := l.Plan(state, config)
+	require.NoError(t, err)
+	failed, diags := l.ApplyDiff(state, diff)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "the batch was rolled back to instance template "+v1)
+	assert.Equal(t, v1, failed.Attributes["instance_template"])
+	assert.Equal(t, "1", failed.Attributes["instance_count"])
+
+	// The next plan updates the template again
+	for _, state := range []*terraform.InstanceState{failed, l.Refresh(failed)} {
+		diff, err = l.Plan(state, config)
+		require.NoError(t, err)
+		require.NotNil(t, diff)
+		require.Contains(t, diff.Attributes, "instance_template")
+		assert.Equal(t, v1, diff.Attributes["instance_template"].Old)
+		assert.Equal(t, broken, diff.Attributes["instance_template"].New)
+	}
+
+	l.Destroy(failed)
+	assert.False(t, cloud.Exists("instance_groups", state.ID))
+	subnet.Destroy(subnetState)
+	vpcLifecycle.Destroy(vpcState)
+}
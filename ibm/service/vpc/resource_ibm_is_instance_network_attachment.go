@@ -25,7 +25,9 @@ func ResourceIBMIsInstanceNetworkAttachment() *schema.Resource {
 		ReadContext:   resourceIBMIsInstanceNetworkAttachmentRead,
 		UpdateContext: resourceIBMIsInstanceNetworkAttachmentUpdate,
 		DeleteContext: resourceIBMIsInstanceNetworkAttachmentDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "instance", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance": &schema.Schema{
@@ -33,7 +33,9 @@ func ResourceIBMIsInstanceNetworkInterfaceFloatingIp() *schema.Resource {
 		ReadContext:   resourceIBMISInstanceNetworkInterfaceFloatingIpRead,
 		UpdateContext: resourceIBMISInstanceNetworkInterfaceFloatingIpUpdate,
 		DeleteContext: resourceIBMISInstanceNetworkInterfaceFloatingIpDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isInstanceID, isInstanceNetworkInterface, isInstanceNetworkInterfaceFloatingIPID),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -1443,7 +1443,23 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 		MinValueLength:             1,
 		MaxValueLength:             128,
 	})
-	ibmISInstanceTemplateValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_template", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:       validate.RangeDependsOn,
+			When:       []validate.CrossFieldCondition{{Identifier: "boot_volume.0.profile", AllowedValues: "sdp", Not: true}},
+			Identifier: "boot_volume.0.size",
+			MaxValue:   "250",
+			Message:    "boot volumes of tiered profiles hold at most 250 GB",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "boot_volume.0.profile", AllowedValues: "sdp", Not: true}},
+			Targets: []string{"boot_volume.0.bandwidth"},
+			Message: "only volumes of the sdp profile set their bandwidth",
+		},
+	}
+
+	ibmISInstanceTemplateValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_template", Schema: validateSchema, Rules: rules}
 	return &ibmISInstanceTemplateValidator
 }
 
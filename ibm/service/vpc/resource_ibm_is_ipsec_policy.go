@@ -189,7 +189,28 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              pfs})
 
-	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isIpSecEncryptionAlg, AllowedValues: "aes128gcm16, aes192gcm16, aes256gcm16"},
+				{Identifier: isIpSecAuthenticationAlg, AllowedValues: "disabled", Not: true},
+			},
+			Targets: []string{isIpSecAuthenticationAlg},
+			Message: "authentication is disabled with GCM encryption algorithms",
+		},
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isIpSecAuthenticationAlg, AllowedValues: "disabled"},
+				{Identifier: isIpSecEncryptionAlg, AllowedValues: "aes128gcm16, aes192gcm16, aes256gcm16", Not: true},
+			},
+			Targets: []string{isIpSecAuthenticationAlg},
+			Message: "authentication is only disabled with GCM encryption algorithms",
+		},
+	}
+
+	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema, Rules: rules}
 	return &ibmISIPSECResourceValidator
 }
 
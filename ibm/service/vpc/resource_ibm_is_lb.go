@@ -55,7 +55,7 @@ const (
 )
 
 func ResourceIBMISLB() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBCreate,
 		ReadContext:   resourceIBMISLBRead,
 		UpdateContext: resourceIBMISLBUpdate,
@@ -353,7 +353,10 @@ func ResourceIBMISLB() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISLBValidator() *validate.ResourceValidator {
@@ -405,7 +408,28 @@ func ResourceIBMISLBValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISLBResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isLBRouteMode, AllowedValues: "true"},
+				{Identifier: isLBProfile, AllowedValues: "network-fixed", Not: true},
+			},
+			Targets: []string{isLBRouteMode},
+			Message: "route mode is only supported by network-fixed load balancers",
+		},
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isLBRouteMode, AllowedValues: "true"},
+				{Identifier: isLBType, AllowedValues: "private", Not: true},
+			},
+			Targets: []string{isLBRouteMode},
+			Message: "route mode is only supported by private load balancers",
+		},
+	}
+
+	ibmISLBResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb", Schema: validateSchema, Rules: rules}
 	return &ibmISLBResourceValidator
 }
 
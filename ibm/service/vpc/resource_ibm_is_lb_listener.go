@@ -43,7 +43,7 @@ const (
 )
 
 func ResourceIBMISLBListener() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBListenerCreate,
 		ReadContext:   resourceIBMISLBListenerRead,
 		UpdateContext: resourceIBMISLBListenerUpdate,
@@ -247,7 +247,10 @@ func ResourceIBMISLBListener() *schema.Resource {
 				Description: "The crn of the LB resource",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"lb_id", "listener_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISLBListenerValidator() *validate.ResourceValidator {
@@ -268,7 +271,27 @@ func ResourceIBMISLBListenerValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "50",
 			MaxValue:                   "7200"})
-	ibmISLBListenerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBListenerProtocol, AllowedValues: "https"}},
+			Targets: []string{isLBListenerCertificateInstance},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBListenerProtocol, AllowedValues: "https", Not: true}},
+			Targets: []string{isLBListenerCertificateInstance},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBListenerProtocol, AllowedValues: "http", Not: true}},
+			Targets: []string{"https_redirect", isLBListenerHTTPSRedirectListener, isLBListenerHTTPSRedirectStatusCode, isLBListenerHTTPSRedirectURI},
+			Message: "only http listeners redirect to https",
+		},
+		{Type: validate.RangeDependsOn, Identifier: isLBListenerPortMin, MaxValue: isLBListenerPortMax},
+	}
+
+	ibmISLBListenerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener", Schema: validateSchema, Rules: rules}
 	return &ibmISLBListenerResourceValidator
 }
 
@@ -390,7 +390,34 @@ func ResourceIBMISLBListenerPolicyValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              action})
 
-	ibmISLBListenerPolicyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener_policy", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isLBListenerPolicyAction, AllowedValues: "forward, forward_to_pool, forward_to_listener"},
+				{Identifier: "target", Not: true},
+			},
+			Targets: []string{isLBListenerPolicyTargetID},
+		},
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isLBListenerPolicyAction, AllowedValues: "redirect"},
+				{Identifier: "target", Not: true},
+			},
+			Targets: []string{isLBListenerPolicyTargetHTTPStatusCode, isLBListenerPolicyTargetURL},
+		},
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isLBListenerPolicyAction, AllowedValues: "https_redirect"},
+				{Identifier: "target", Not: true},
+			},
+			Targets: []string{isLBListenerPolicyHTTPSRedirectListener, isLBListenerPolicyHTTPSRedirectStatusCode},
+		},
+	}
+
+	ibmISLBListenerPolicyResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_listener_policy", Schema: validateSchema, Rules: rules}
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
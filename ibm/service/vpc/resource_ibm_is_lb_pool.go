@@ -47,7 +47,7 @@ const (
 )
 
 func ResourceIBMISLBPool() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBPoolCreate,
 		ReadContext:   resourceIBMISLBPoolRead,
 		UpdateContext: resourceIBMISLBPoolUpdate,
@@ -245,7 +245,10 @@ func ResourceIBMISLBPool() *schema.Resource {
 				Description: "The crn of the LB resource",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"lb_id", "pool_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
@@ -309,7 +312,26 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			AllowedValues:              persistanceType})
 
-	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBPoolSessPersistenceType, AllowedValues: "app_cookie"}},
+			Targets: []string{isLBPoolSessPersistenceAppCookieName},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBPoolSessPersistenceType, AllowedValues: "app_cookie", Not: true}},
+			Targets: []string{isLBPoolSessPersistenceAppCookieName},
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isLBPoolHealthType, AllowedValues: "http, https", Not: true}},
+			Targets: []string{isLBPoolHealthMonitorURL},
+			Message: "only http and https health checks request a URL",
+		},
+	}
+
+	ibmISLBPoolResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_pool", Schema: validateSchema, Rules: rules}
 	return &ibmISLBPoolResourceValidator
 }
 
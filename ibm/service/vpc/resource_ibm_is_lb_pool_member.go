@@ -36,7 +36,7 @@ const (
 )
 
 func ResourceIBMISLBPoolMember() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISLBPoolMemberCreate,
 		ReadContext:   resourceIBMISLBPoolMemberRead,
 		UpdateContext: resourceIBMISLBPoolMemberUpdate,
@@ -137,7 +137,10 @@ func ResourceIBMISLBPoolMember() *schema.Resource {
 				Description: "The crn of the LB resource",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"lb_id", "pool_id", "member_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISLBPoolMemberValidator() *validate.ResourceValidator {
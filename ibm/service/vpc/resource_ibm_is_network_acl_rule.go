@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rulecheck"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -28,7 +29,7 @@ const (
 )
 
 func ResourceIBMISNetworkACLRule() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISNetworkACLRuleCreate,
 		ReadContext:   resourceIBMISNetworkACLRuleRead,
 		UpdateContext: resourceIBMISNetworkACLRuleUpdate,
@@ -44,6 +45,9 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISNetworkACLRuleConflictsCustomizeDiff(context, diff, v)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -280,7 +284,10 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"network_acl_id", "rule_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
@@ -392,7 +399,41 @@ func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			AllowedValues:              protocol})
 
-	ibmISNetworkACLRuleResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl_rule", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:         validate.Conflicts,
+			When:         []validate.CrossFieldCondition{{Identifier: isNetworkACLRuleProtocol}, {Identifier: isNetworkACLRuleProtocol, AllowedValues: "icmp", Not: true}},
+			Targets:      []string{isNetworkACLRuleICMPType, isNetworkACLRuleICMPCode},
+			Message:      "type and code only apply to the icmp protocol",
+			ZeroValueSet: true,
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isNetworkACLRuleProtocol}, {Identifier: isNetworkACLRuleProtocol, AllowedValues: "tcp, udp", Not: true}},
+			Targets: []string{isNetworkACLRulePortMin, isNetworkACLRulePortMax, isNetworkACLRuleSourcePortMin, isNetworkACLRuleSourcePortMax},
+			Message: "ports only apply to the tcp and udp protocols",
+		},
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: isNetworkACLRuleICMPCode}},
+			Targets:      []string{isNetworkACLRuleICMPType},
+			ZeroValueSet: true,
+		},
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: "icmp.0.code"}},
+			Targets:      []string{"icmp.0.type"},
+			ZeroValueSet: true,
+		},
+		{Type: validate.RangeDependsOn, Identifier: isNetworkACLRulePortMin, MaxValue: isNetworkACLRulePortMax},
+		{Type: validate.RangeDependsOn, Identifier: isNetworkACLRuleSourcePortMin, MaxValue: isNetworkACLRuleSourcePortMax},
+		{Type: validate.RangeDependsOn, Identifier: "tcp.0.port_min", MaxValue: "tcp.0.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "tcp.0.source_port_min", MaxValue: "tcp.0.source_port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "udp.0.port_min", MaxValue: "udp.0.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "udp.0.source_port_min", MaxValue: "udp.0.source_port_max"},
+	}
+
+	ibmISNetworkACLRuleResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl_rule", Schema: validateSchema, Rules: rules}
 	return &ibmISNetworkACLRuleResourceValidator
 }
 
@@ -1334,6 +1375,67 @@ func nwaclRuleExists(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	return true, nil
 }
 
+// resourceIBMISNetworkACLRuleConflictsCustomizeDiff checks a new or changed
+// rule, at the position where it is planned, against the other rules of its
+// network ACL: for duplicates and, when it is new, for the quota, so that
+// they fail at plan rather than at apply. The rules that shadow it or that
+// it shadows are logged as a warning. The rule is not checked while its
+// network ACL or one of its arguments is unknown.
+func resourceIBMISNetworkACLRuleConflictsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges(isNwACLRuleBefore, isNetworkACLRuleAction, isNetworkACLRuleDirection, isNetworkACLRuleSource, isNetworkACLRuleDestination,
+		isNetworkACLRuleProtocol, isNetworkACLRuleICMPType, isNetworkACLRuleICMPCode, isNetworkACLRulePortMin, isNetworkACLRulePortMax,
+		isNetworkACLRuleSourcePortMin, isNetworkACLRuleSourcePortMax, isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP) {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsWhollyKnown() {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	nwACLID := diff.Get(isNwACLID).(string)
+	ruleID := ""
+	if diff.Id() != "" {
+		_, ruleID, _ = parseNwACLTerraformID(diff.Id())
+	}
+	nwacl, _, err := sess.GetNetworkACLWithContext(context, &vpcv1.GetNetworkACLOptions{
+		ID: &nwACLID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Network ACL (%s) to check its rules: %s", nwACLID, err)
+	}
+
+	// The rule is planned before the rule of before, else where it is, else
+	// last.
+	before, _ := configBlockMap(config)[isNwACLRuleBefore].(string)
+	var rules []rulecheck.Rule
+	planned := -1
+	for _, rule := range nwacl.Rules {
+		id, r := flattenIBMISNetworkACLRuleItem(rule)
+		if r == nil {
+			continue
+		}
+		if id == before || id == ruleID && before == "" {
+			planned = len(rules)
+		}
+		if id != ruleID {
+			rules = append(rules, networkACLConflictRule(id, r))
+		}
+	}
+	if planned < 0 {
+		planned = len(rules)
+	}
+	name, _ := diff.Get(isNetworkACLRuleName).(string)
+	if name == "" {
+		name = "(new)"
+	}
+	rules = append(rules[:planned], append([]rulecheck.Rule{networkACLConflictRule(name, configBlockMap(config))}, rules[planned:]...)...)
+	conflicts := rulecheck.NetworkACLRules(rules, rulecheck.NetworkACLRuleQuota)
+	return rulecheck.Error(rulecheck.Involving(conflicts, planned, diff.Id() == ""))
+}
+
 func makeTerraformACLRuleID(id1, id2 string) string {
 	// Include both network acl id and rule id to create a unique Terraform id.  As a bonus,
 	// we can extract the network acl id as needed for API calls such as READ.
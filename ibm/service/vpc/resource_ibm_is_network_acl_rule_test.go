@@ -9,14 +9,17 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNetworkACLRule_basicICMP(t *testing.T) {
@@ -653,3 +656,53 @@ func testAccCheckIBMISNetworkACLRuleBeforeUpdateConfig(vpcName, name, name1 stri
 	}
 	`, vpcName, name, name1)
 }
+
+func TestIBMISNetworkACLRuleConflictsMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-acl-vpc"})
+
+	// The rules of the default network ACL allow all traffic, a rule after
+	// them never applies, which is only a warning
+	l := acc.NewLifecycle(t, cloud, "ibm_is_network_acl_rule")
+	config := map[string]interface{}{
+		"network_acl": vpcState.Attributes["default_network_acl"],
+		"name":        "deny-ssh",
+		"action":      "deny",
+		"direction":   "inbound",
+		"source":      "0.0.0.0/0",
+		"destination": "0.0.0.0/0",
+		"protocol":    "tcp",
+		"port_min":    22,
+		"port_max":    22,
+	}
+	_, err := l.Plan(nil, config)
+	assert.NoError(t, err)
+
+	// A duplicate of a rule fails
+	config = map[string]interface{}{
+		"network_acl": vpcState.Attributes["default_network_acl"],
+		"name":        "allow-all",
+		"action":      "allow",
+		"direction":   "outbound",
+		"source":      "0.0.0.0/0",
+		"destination": "0.0.0.0/0",
+		"protocol":    "all",
+	}
+	_, err = l.Plan(nil, config)
+	assert.ErrorContains(t, err, "Rule allow-all is a duplicate of rule allow-outbound")
+
+	// Inline rules are checked in their order
+	acl := acc.NewLifecycle(t, cloud, "ibm_is_network_acl")
+	denyAll := map[string]interface{}{"name": "deny-all", "action": "deny", "direction": "inbound", "source": "0.0.0.0/0", "destination": "0.0.0.0/0"}
+	allowSSH := map[string]interface{}{"name": "allow-ssh", "action": "allow", "direction": "inbound", "source": "10.0.0.0/8", "destination": "0.0.0.0/0", "protocol": "tcp", "port_min": 22, "port_max": 22}
+	aclConfig := map[string]interface{}{"name": "tf-mock-acl", "vpc": vpcState.ID, "rules": []interface{}{denyAll, allowSSH}}
+	_, err = acl.Plan(nil, aclConfig)
+	assert.NoError(t, err)
+	aclConfig["rules"] = []interface{}{denyAll, allowSSH, allowSSH}
+	_, err = acl.Plan(nil, aclConfig)
+	assert.ErrorContains(t, err, "Rule allow-ssh is a duplicate of rule allow-ssh")
+
+	vpcLifecycle.Destroy(vpcState)
+}
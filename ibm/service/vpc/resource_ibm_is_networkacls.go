@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rulecheck"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -51,7 +52,7 @@ const (
 )
 
 func ResourceIBMISNetworkACL() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISNetworkACLCreate,
 		ReadContext:   resourceIBMISNetworkACLRead,
 		UpdateContext: resourceIBMISNetworkACLUpdate,
@@ -74,6 +75,10 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISNetworkACLRulesCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -147,190 +152,197 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						isNetworkACLRuleID: {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						isNetworkACLRuleName: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleName),
-						},
-						isNetworkACLRuleAction: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleAction),
-						},
-						isNetworkACLRuleIPVersion: {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						isNetworkACLRuleSource: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSource),
-						},
-						isNetworkACLRuleDestination: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDestination),
-						},
-						isNetworkACLRuleDirection: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							Description:  "Direction of traffic to enforce, either inbound or outbound",
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDirection),
-						},
-						isNetworkACLSubnets: {
-							Type:     schema.TypeInt,
-							Computed: true,
-						},
-						isNetworkACLRuleProtocol: {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							Description:  "The name of the network protocol",
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleProtocol),
-						},
-						isNetworkACLRuleICMPCode: {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPCode),
-							Description:  "The ICMP traffic code to allow. Valid values from 0 to 255.",
-						},
-						isNetworkACLRuleICMPType: {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
-							Description:  "The ICMP traffic type to allow. Valid values from 0 to 254.",
-						},
-						isNetworkACLRulePortMax: {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							DiffSuppressFunc: suppressNullValues,
-							ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
-							Description:      "The highest port in the range of ports to be matched",
-						},
-						isNetworkACLRulePortMin: {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							DiffSuppressFunc: suppressNullValues,
-							ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
-							Description:      "The lowest port in the range of ports to be matched",
-						},
-						isNetworkACLRuleSourcePortMax: {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							DiffSuppressFunc: suppressNullValues,
-							ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
-							Description:      "The highest port in the range of ports to be matched",
-						},
-						isNetworkACLRuleSourcePortMin: {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							DiffSuppressFunc: suppressNullValues,
-							ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
-							Description:      "The lowest port in the range of ports to be matched",
-						},
-						isNetworkACLRuleICMP: {
-							Type:       schema.TypeList,
-							MinItems:   0,
-							MaxItems:   1,
-							Optional:   true,
-							Deprecated: "icmp is deprecated, use 'protocol', 'code', and 'type' instead.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRuleICMPCode: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPCode),
-									},
-									isNetworkACLRuleICMPType: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
-									},
-								},
-							},
-						},
-
-						isNetworkACLRuleTCP: {
-							Type:       schema.TypeList,
-							MinItems:   0,
-							MaxItems:   1,
-							Optional:   true,
-							Deprecated: "tcp is deprecated, use 'protocol', 'port_min', 'port_max', 'source_port_min', and 'source_port_max' instead.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRulePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
-									},
-									isNetworkACLRulePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
-									},
-									isNetworkACLRuleSourcePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
-									},
-									isNetworkACLRuleSourcePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
-									},
-								},
-							},
-						},
-
-						isNetworkACLRuleUDP: {
-							Type:       schema.TypeList,
-							MinItems:   0,
-							MaxItems:   1,
-							Optional:   true,
-							Deprecated: "udp is deprecated, use 'protocol', 'port_min', 'port_max', 'source_port_min', and 'source_port_max' instead.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRulePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
-									},
-									isNetworkACLRulePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
-									},
-									isNetworkACLRuleSourcePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
-									},
-									isNetworkACLRuleSourcePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
-									},
-								},
-							},
-						},
+					Schema: makeIBMISNetworkACLRuleSchema(),
+				},
+			},
+		},
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
+}
+
+func makeIBMISNetworkACLRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isNetworkACLRuleID: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		isNetworkACLRuleName: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleName),
+		},
+		isNetworkACLRuleAction: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleAction),
+		},
+		isNetworkACLRuleIPVersion: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		isNetworkACLRuleSource: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSource),
+		},
+		isNetworkACLRuleDestination: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDestination),
+		},
+		isNetworkACLRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDirection),
+		},
+		isNetworkACLSubnets: {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		isNetworkACLRuleProtocol: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The name of the network protocol",
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleProtocol),
+		},
+		isNetworkACLRuleICMPCode: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPCode),
+			Description:  "The ICMP traffic code to allow. Valid values from 0 to 255.",
+		},
+		isNetworkACLRuleICMPType: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
+			Description:  "The ICMP traffic type to allow. Valid values from 0 to 254.",
+		},
+		isNetworkACLRulePortMax: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			DiffSuppressFunc: suppressNullValues,
+			ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
+			Description:      "The highest port in the range of ports to be matched",
+		},
+		isNetworkACLRulePortMin: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			DiffSuppressFunc: suppressNullValues,
+			ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
+			Description:      "The lowest port in the range of ports to be matched",
+		},
+		isNetworkACLRuleSourcePortMax: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			DiffSuppressFunc: suppressNullValues,
+			ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
+			Description:      "The highest port in the range of ports to be matched",
+		},
+		isNetworkACLRuleSourcePortMin: {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			DiffSuppressFunc: suppressNullValues,
+			ValidateFunc:     validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
+			Description:      "The lowest port in the range of ports to be matched",
+		},
+		isNetworkACLRuleICMP: {
+			Type:       schema.TypeList,
+			MinItems:   0,
+			MaxItems:   1,
+			Optional:   true,
+			Deprecated: "icmp is deprecated, use 'protocol', 'code', and 'type' instead.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRuleICMPCode: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPCode),
+					},
+					isNetworkACLRuleICMPType: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
+					},
+				},
+			},
+		},
+
+		isNetworkACLRuleTCP: {
+			Type:       schema.TypeList,
+			MinItems:   0,
+			MaxItems:   1,
+			Optional:   true,
+			Deprecated: "tcp is deprecated, use 'protocol', 'port_min', 'port_max', 'source_port_min', and 'source_port_max' instead.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRulePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
+					},
+					isNetworkACLRulePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
+					},
+					isNetworkACLRuleSourcePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
+					},
+					isNetworkACLRuleSourcePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
+					},
+				},
+			},
+		},
+
+		isNetworkACLRuleUDP: {
+			Type:       schema.TypeList,
+			MinItems:   0,
+			MaxItems:   1,
+			Optional:   true,
+			Deprecated: "udp is deprecated, use 'protocol', 'port_min', 'port_max', 'source_port_min', and 'source_port_max' instead.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRulePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
+					},
+					isNetworkACLRulePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
+					},
+					isNetworkACLRuleSourcePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
+					},
+					isNetworkACLRuleSourcePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
 					},
 				},
 			},
@@ -486,7 +498,52 @@ func ResourceIBMISNetworkACLValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			AllowedValues:              protocol})
 
-	ibmISNetworkACLResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "rules.*.icmp"}},
+			Targets: []string{"rules.*.tcp", "rules.*.udp"},
+			Message: "a rule has a single protocol",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "rules.*.tcp"}},
+			Targets: []string{"rules.*.udp"},
+			Message: "a rule has a single protocol",
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "rules.*.protocol"}},
+			Targets: []string{"rules.*.icmp", "rules.*.tcp", "rules.*.udp"},
+		},
+		{
+			Type:         validate.Conflicts,
+			When:         []validate.CrossFieldCondition{{Identifier: "rules.*.protocol"}, {Identifier: "rules.*.protocol", AllowedValues: "icmp", Not: true}},
+			Targets:      []string{"rules.*.type", "rules.*.code"},
+			Message:      "type and code only apply to the icmp protocol",
+			ZeroValueSet: true,
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: "rules.*.protocol"}, {Identifier: "rules.*.protocol", AllowedValues: "tcp, udp", Not: true}},
+			Targets: []string{"rules.*.port_min", "rules.*.port_max", "rules.*.source_port_min", "rules.*.source_port_max"},
+			Message: "ports only apply to the tcp and udp protocols",
+		},
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: "rules.*.icmp.0.code"}},
+			Targets:      []string{"rules.*.icmp.0.type"},
+			ZeroValueSet: true,
+		},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.port_min", MaxValue: "rules.*.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.source_port_min", MaxValue: "rules.*.source_port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.tcp.0.port_min", MaxValue: "rules.*.tcp.0.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.tcp.0.source_port_min", MaxValue: "rules.*.tcp.0.source_port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.udp.0.port_min", MaxValue: "rules.*.udp.0.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "rules.*.udp.0.source_port_min", MaxValue: "rules.*.udp.0.source_port_max"},
+	}
+
+	ibmISNetworkACLResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl", Schema: validateSchema, Rules: rules}
 	return &ibmISNetworkACLResourceValidator
 }
 
@@ -1125,6 +1182,146 @@ func validateInlineRules(d *schema.ResourceData, rules []interface{}) error {
 	return nil
 }
 
+// networkACLConflictRule returns a rule of a network ACL, declared in the
+// configuration or flattened from the API, to check it for conflicts. The
+// rule is named name when it has no name.
+func networkACLConflictRule(name string, r map[string]interface{}) rulecheck.Rule {
+	str := func(key string) string {
+		v, _ := r[key].(string)
+		return v
+	}
+	rule := rulecheck.Rule{
+		Name:        str(isNetworkACLRuleName),
+		Action:      str(isNetworkACLRuleAction),
+		Direction:   str(isNetworkACLRuleDirection),
+		Protocol:    str(isNetworkACLRuleProtocol),
+		Source:      str(isNetworkACLRuleSource),
+		Destination: str(isNetworkACLRuleDestination),
+	}
+	if rule.Name == "" {
+		rule.Name = name
+	}
+	if rule.Protocol == "" {
+		// As in createInlineRules and nwaclRuleCreate
+		rule.Protocol = "icmp_tcp_udp"
+		if rule.Action == "deny" {
+			rule.Protocol = "any"
+		}
+	}
+
+	// The deprecated icmp, tcp and udp blocks set the protocol and hold its
+	// arguments.
+	args := r
+	for _, protocol := range []string{isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+		if blocks, _ := r[protocol].([]interface{}); len(blocks) > 0 {
+			rule.Protocol = protocol
+			args, _ = blocks[0].(map[string]interface{})
+		}
+	}
+	num := func(key string) *int64 {
+		if v, ok := args[key].(int); ok {
+			n := int64(v)
+			return &n
+		}
+		return nil
+	}
+	rule.Type, rule.Code = num(isNetworkACLRuleICMPType), num(isNetworkACLRuleICMPCode)
+	for key, port := range map[string]*int64{
+		isNetworkACLRulePortMin:       &rule.PortMin,
+		isNetworkACLRulePortMax:       &rule.PortMax,
+		isNetworkACLRuleSourcePortMin: &rule.SourcePortMin,
+		isNetworkACLRuleSourcePortMax: &rule.SourcePortMax,
+	} {
+		if v := num(key); v != nil {
+			*port = *v
+		}
+	}
+	return rule
+}
+
+// flattenIBMISNetworkACLRuleItem returns the ID of a rule of a network ACL
+// and its arguments, or nil for an unknown kind of rule.
+func flattenIBMISNetworkACLRuleItem(rule vpcv1.NetworkACLRuleItemIntf) (string, map[string]interface{}) {
+	var id, name, action, direction, protocol, source, destination *string
+	var icmpType, icmpCode, portMin, portMax, sourcePortMin, sourcePortMax *int64
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+		icmpType, icmpCode = rule.Type, rule.Code
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+		portMin, portMax, sourcePortMin, sourcePortMax = rule.DestinationPortMin, rule.DestinationPortMax, rule.SourcePortMin, rule.SourcePortMax
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAny:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIndividual:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+	case *vpcv1.NetworkACLRuleItem:
+		id, name, action, direction, protocol = rule.ID, rule.Name, rule.Action, rule.Direction, rule.Protocol
+		source, destination = rule.Source, rule.Destination
+		icmpType, icmpCode = rule.Type, rule.Code
+		portMin, portMax, sourcePortMin, sourcePortMax = rule.DestinationPortMin, rule.DestinationPortMax, rule.SourcePortMin, rule.SourcePortMax
+	default:
+		return "", nil
+	}
+
+	r := make(map[string]interface{})
+	for key, v := range map[string]*string{
+		isNetworkACLRuleName:        name,
+		isNetworkACLRuleAction:      action,
+		isNetworkACLRuleDirection:   direction,
+		isNetworkACLRuleProtocol:    protocol,
+		isNetworkACLRuleSource:      source,
+		isNetworkACLRuleDestination: destination,
+	} {
+		if v != nil {
+			r[key] = *v
+		}
+	}
+	for key, v := range map[string]*int64{
+		isNetworkACLRuleICMPType:      icmpType,
+		isNetworkACLRuleICMPCode:      icmpCode,
+		isNetworkACLRulePortMin:       portMin,
+		isNetworkACLRulePortMax:       portMax,
+		isNetworkACLRuleSourcePortMin: sourcePortMin,
+		isNetworkACLRuleSourcePortMax: sourcePortMax,
+	} {
+		if v != nil {
+			r[key] = int(*v)
+		}
+	}
+	if id == nil {
+		return "", r
+	}
+	return *id, r
+}
+
+// resourceIBMISNetworkACLRulesCustomizeDiff checks the declared rules for
+// duplicates and for the quota, so that they fail at plan rather than at
+// apply. The rules that earlier rules shadow are logged as a warning.
+func resourceIBMISNetworkACLRulesCustomizeDiff(diff *schema.ResourceDiff) error {
+	raw := diff.GetRawConfig()
+	if !diff.HasChange(isNetworkACLRules) || raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	config := raw.GetAttr(isNetworkACLRules)
+	if !config.IsWhollyKnown() {
+		return nil
+	}
+	wanted := configBlockMaps(config)
+	rules := make([]rulecheck.Rule, len(wanted))
+	for i, w := range wanted {
+		rules[i] = networkACLConflictRule(fmt.Sprintf("%s.%d", isNetworkACLRules, i), w.(map[string]interface{}))
+	}
+	return rulecheck.Error(rulecheck.NetworkACLRules(rules, rulecheck.NetworkACLRuleQuota))
+}
+
 func createInlineRules(d *schema.ResourceData, nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
 	before := ""
 
@@ -23,7 +23,9 @@ func ResourceIBMIsPrivatePathServiceGatewayAccountPolicy() *schema.Resource {
 		ReadContext:   resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead,
 		UpdateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate,
 		DeleteContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMIsPrivatePathServiceGatewayAccountPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"private_path_service_gateway": &schema.Schema{
@@ -170,6 +172,41 @@ func resourceIBMIsPrivatePathServiceGatewayAccountPolicyRead(context context.Con
 	return nil
 }
 
+// resourceIBMIsPrivatePathServiceGatewayAccountPolicyImport sets the gateway
+// and the account of the policy, which read leaves alone as they are only
+// sent on create.
+func resourceIBMIsPrivatePathServiceGatewayAccountPolicyImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	getPrivatePathServiceGatewayAccountPolicyOptions := &vpcv1.GetPrivatePathServiceGatewayAccountPolicyOptions{}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return nil, err
+	}
+
+	getPrivatePathServiceGatewayAccountPolicyOptions.SetPrivatePathServiceGatewayID(parts[0])
+	getPrivatePathServiceGatewayAccountPolicyOptions.SetID(parts[1])
+
+	privatePathServiceGatewayAccountPolicy, _, err := vpcClient.GetPrivatePathServiceGatewayAccountPolicyWithContext(context, getPrivatePathServiceGatewayAccountPolicyOptions)
+	if err != nil {
+		return nil, fmt.Errorf("GetPrivatePathServiceGatewayAccountPolicyWithContext failed: %s", err.Error())
+	}
+
+	if err = d.Set("private_path_service_gateway", parts[0]); err != nil {
+		return nil, fmt.Errorf("Error setting private_path_service_gateway: %s", err)
+	}
+	if privatePathServiceGatewayAccountPolicy.Account != nil {
+		if err = d.Set("account", privatePathServiceGatewayAccountPolicy.Account.ID); err != nil {
+			return nil, fmt.Errorf("Error setting account: %s", err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMIsPrivatePathServiceGatewayAccountPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
 	if err != nil {
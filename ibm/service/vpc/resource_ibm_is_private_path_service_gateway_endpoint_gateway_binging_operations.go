@@ -27,7 +27,9 @@ func ResourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperations() *s
 		ReadContext:   resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsRead,
 		UpdateContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsUpdate,
 		DeleteContext: resourceIBMIsPrivatePathServiceGatewayEndpointGatewayBindingOperationsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "private_path_service_gateway", "endpoint_gateway_binding"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"private_path_service_gateway": {
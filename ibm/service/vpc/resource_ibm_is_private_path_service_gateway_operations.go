@@ -22,7 +22,9 @@ func ResourceIBMIsPrivatePathServiceGatewayOperations() *schema.Resource {
 		ReadContext:   resourceIBMIsPrivatePathServiceGatewayOperationsRead,
 		UpdateContext: resourceIBMIsPrivatePathServiceGatewayOperationsUpdate,
 		DeleteContext: resourceIBMIsPrivatePathServiceGatewayOperationsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "private_path_service_gateway"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"private_path_service_gateway": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -21,7 +22,9 @@ func ResourceIBMIsPrivatePathServiceGatewayRevokeAccount() *schema.Resource {
 		ReadContext:   resourceIBMIsPrivatePathServiceGatewayRevokeAccountRead,
 		UpdateContext: resourceIBMIsPrivatePathServiceGatewayRevokeAccountUpdate,
 		DeleteContext: resourceIBMIsPrivatePathServiceGatewayRevokeAccountDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "private_path_service_gateway"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"private_path_service_gateway": {
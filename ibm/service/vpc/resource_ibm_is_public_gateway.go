@@ -40,7 +40,7 @@ const (
 )
 
 func ResourceIBMISPublicGateway() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISPublicGatewayCreate,
 		ReadContext:   resourceIBMISPublicGatewayRead,
 		UpdateContext: resourceIBMISPublicGatewayUpdate,
@@ -162,7 +162,10 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISPublicGatewayValidator() *validate.ResourceValidator {
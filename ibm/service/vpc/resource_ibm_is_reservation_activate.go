@@ -22,7 +22,9 @@ func ResourceIBMISReservationActivate() *schema.Resource {
 		CreateContext: resourceIBMISReservationActivateCreate,
 		ReadContext:   resourceIBMISReservationActivateRead,
 		DeleteContext: resourceIBMISReservationActivateDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "reservation"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isReservation: &schema.Schema{
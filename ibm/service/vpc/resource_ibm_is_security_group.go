@@ -8,13 +8,17 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rulecheck"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -25,6 +29,7 @@ const (
 	isSecurityGroupName          = "name"
 	isSecurityGroupVPC           = "vpc"
 	isSecurityGroupRules         = "rules"
+	isSecurityGroupManageRules   = "manage_rules"
 	isSecurityGroupResourceGroup = "resource_group"
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupAccessTags    = "access_tags"
@@ -33,7 +38,7 @@ const (
 
 func ResourceIBMISSecurityGroup() *schema.Resource {
 
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSecurityGroupCreate,
 		ReadContext:   resourceIBMISSecurityGroupRead,
 		UpdateContext: resourceIBMISSecurityGroupUpdate,
@@ -51,6 +56,10 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSecurityGroupRulesCustomizeDiff(diff)
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -98,10 +107,18 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Description: "The crn of the resource",
 			},
 
+			isSecurityGroupManageRules: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the security group has exactly the rules declared in rules. The other rules of the security group are deleted, including the rules of ibm_is_security_group_rule resources.",
+			},
+
 			isSecurityGroupRules: {
 				Type:        schema.TypeList,
+				Optional:    true,
 				Computed:    true,
-				Description: "Security Rules",
+				Description: "Security Rules. They can only be declared when manage_rules is true.",
 				Elem: &schema.Resource{
 					Schema: makeIBMISSecurityRuleSchema(),
 				},
@@ -139,7 +156,10 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
@@ -204,6 +224,15 @@ func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.Resourc
 		name = nm.(string)
 		createSecurityGroupOptions.Name = &name
 	}
+	if d.Get(isSecurityGroupManageRules).(bool) {
+		rules, err := expandIBMISSecurityGroupRulesConfig(d.GetRawConfig().GetAttr(isSecurityGroupRules))
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "create", "parse-rules").GetDiag()
+		}
+		for _, rule := range rules {
+			createSecurityGroupOptions.Rules = append(createSecurityGroupOptions.Rules, expandIBMISSecurityGroupRulePrototype(rule))
+		}
+	}
 	sg, _, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group", "create")
@@ -287,238 +316,22 @@ func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceD
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-vpc").GetDiag()
 		}
 	}
-	rules := make([]map[string]interface{}, 0)
-	if len(securityGroup.Rules) > 0 {
-		for _, rule := range securityGroup.Rules {
-			switch reflect.TypeOf(rule).String() {
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
-					r := make(map[string]interface{})
-					if rule.Code != nil {
-						r[isSecurityGroupRuleCode] = int(*rule.Code)
-					}
-					if rule.Type != nil {
-						r[isSecurityGroupRuleType] = int(*rule.Type)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					if rule.Name != nil {
-						r[isSecurityGroupRuleName] = *rule.Name
-					}
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleProtocolAny":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleProtocolAny)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					if rule.Name != nil {
-						r[isSecurityGroupRuleName] = *rule.Name
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleProtocolIndividual":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleProtocolIndividual)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					if rule.Name != nil {
-						r[isSecurityGroupRuleName] = *rule.Name
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleProtocolIcmptcpudp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleProtocolIcmptcpudp)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Name != nil {
-						r[isSecurityGroupRuleName] = *rule.Name
-					}
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRule":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRule)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
-					r := make(map[string]interface{})
-					if rule.PortMin != nil {
-						r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
-					}
-					if rule.PortMax != nil {
-						r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Name != nil {
-						r[isSecurityGroupRuleName] = *rule.Name
-					}
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					local, ok := rule.Local.(*vpcv1.SecurityGroupRuleLocal)
-					if ok {
-						if local != nil && reflect.ValueOf(local).IsNil() == false {
-							if local.Address != nil {
-								r[isSecurityGroupRuleLocal] = local.Address
-							} else if local.CIDRBlock != nil {
-								r[isSecurityGroupRuleLocal] = local.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			}
+	rules := make([]map[string]interface{}, 0, len(securityGroup.Rules))
+	for _, rule := range securityGroup.Rules {
+		if _, r := flattenIBMISSecurityGroupRule(rule); r != nil {
+			rules = append(rules, r)
 		}
 	}
+	manageRules := d.Get(isSecurityGroupManageRules).(bool)
+	if manageRules {
+		// Keep the order of the planned rules, the rules added outside of
+		// Terraform come last.
+		rules = orderIBMISSecurityGroupRules(rules, d.Get(isSecurityGroupRules).([]interface{}))
+	}
+	if err = d.Set(isSecurityGroupManageRules, manageRules); err != nil {
+		err = fmt.Errorf("Error setting manage_rules: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-manage_rules").GetDiag()
+	}
 	if err = d.Set(isSecurityGroupRules, rules); err != nil {
 		err = fmt.Errorf("Error setting rules: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-rules").GetDiag()
@@ -584,6 +397,11 @@ func resourceIBMISSecurityGroupUpdate(context context.Context, d *schema.Resourc
 				"Error on update of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if d.Get(isSecurityGroupManageRules).(bool) && d.HasChanges(isSecurityGroupRules, isSecurityGroupManageRules) {
+		if diags := resourceIBMISSecurityGroupUpdateRules(context, sess, d); diags != nil {
+			return diags
+		}
+	}
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
@@ -762,62 +580,558 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 
 		isSecurityGroupRuleDirection: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "Direction of traffic to enforce, either inbound or outbound",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
 		},
 
 		isSecurityGroupRuleIPVersion: {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "IP version: ipv4",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "IP version: ipv4",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
 		},
 
 		isSecurityGroupRuleName: {
 			Type:        schema.TypeString,
+			Optional:    true,
 			Computed:    true,
 			Description: "The name for this security group rule. The name is unique across all rules in the security group.",
 		},
 
 		isSecurityGroupRuleRemote: {
 			Type:        schema.TypeString,
+			Optional:    true,
 			Computed:    true,
 			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
 		},
 
 		isSecurityGroupRuleLocal: {
 			Type:        schema.TypeString,
+			Optional:    true,
 			Computed:    true,
 			Description: "Security group local ip: an IP address, a CIDR block",
 		},
 
 		isSecurityGroupRuleType: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
 		},
 
 		isSecurityGroupRuleCode: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
 		},
 
 		isSecurityGroupRulePortMin: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
 		},
 
 		isSecurityGroupRulePortMax: {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
 		},
 
 		isSecurityGroupRuleProtocol: {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleProtocol),
 		},
 	}
 }
 
+// flattenIBMISSecurityGroupRule returns the ID of a rule of a security group
+// and its element of the rules attribute, or nil for an unknown kind of rule.
+func flattenIBMISSecurityGroupRule(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	var id, direction, ipVersion, name, protocol *string
+	var remote vpcv1.SecurityGroupRuleRemoteIntf
+	var local vpcv1.SecurityGroupRuleLocalIntf
+	var icmpType, icmpCode, portMin, portMax *int64
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+		icmpType, icmpCode = rule.Type, rule.Code
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+		portMin, portMax = rule.PortMin, rule.PortMax
+	case *vpcv1.SecurityGroupRuleProtocolAny:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleProtocolIndividual:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleProtocolIcmptcpudp:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRule:
+		id, direction, ipVersion, name, protocol = rule.ID, rule.Direction, rule.IPVersion, rule.Name, rule.Protocol
+		remote, local = rule.Remote, rule.Local
+		icmpType, icmpCode, portMin, portMax = rule.Type, rule.Code, rule.PortMin, rule.PortMax
+	default:
+		return "", nil
+	}
+
+	r := make(map[string]interface{})
+	for key, v := range map[string]*string{
+		isSecurityGroupRuleDirection: direction,
+		isSecurityGroupRuleIPVersion: ipVersion,
+		isSecurityGroupRuleName:      name,
+		isSecurityGroupRuleProtocol:  protocol,
+	} {
+		if v != nil {
+			r[key] = *v
+		}
+	}
+	for key, v := range map[string]*int64{
+		isSecurityGroupRuleType:    icmpType,
+		isSecurityGroupRuleCode:    icmpCode,
+		isSecurityGroupRulePortMin: portMin,
+		isSecurityGroupRulePortMax: portMax,
+	} {
+		if v != nil {
+			r[key] = int(*v)
+		}
+	}
+	if remote, ok := remote.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			r[isSecurityGroupRuleRemote] = *remote.ID
+		} else if remote.Address != nil {
+			r[isSecurityGroupRuleRemote] = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+		}
+	}
+	if local, ok := local.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+		if local.Address != nil {
+			r[isSecurityGroupRuleLocal] = *local.Address
+		} else if local.CIDRBlock != nil {
+			r[isSecurityGroupRuleLocal] = *local.CIDRBlock
+		}
+	}
+	if id == nil {
+		return "", r
+	}
+	return *id, r
+}
+
+// expandIBMISSecurityGroupRulesConfig returns the rules declared in the
+// configuration, with the arguments that are set.
+func expandIBMISSecurityGroupRulesConfig(config cty.Value) ([]map[string]interface{}, error) {
+	var rules []map[string]interface{}
+	if config.IsNull() {
+		return rules, nil
+	}
+	for it := config.ElementIterator(); it.Next(); {
+		i, rule := it.Element()
+		r := configBlockMap(rule)
+		index, _ := i.AsBigFloat().Int64()
+		if _, ok := r[isSecurityGroupRuleDirection]; !ok {
+			return nil, fmt.Errorf("[ERROR] rules.%d: direction is required", index)
+		}
+		protocol, _ := r[isSecurityGroupRuleProtocol].(string)
+		if protocol != isSecurityGroupRuleProtocolICMP {
+			for _, key := range []string{isSecurityGroupRuleType, isSecurityGroupRuleCode} {
+				if _, ok := r[key]; ok {
+					return nil, fmt.Errorf("[ERROR] rules.%d: %s only applies to the icmp protocol", index, key)
+				}
+			}
+		}
+		if protocol != isSecurityGroupRuleProtocolTCP && protocol != isSecurityGroupRuleProtocolUDP {
+			for _, key := range []string{isSecurityGroupRulePortMin, isSecurityGroupRulePortMax} {
+				if _, ok := r[key]; ok {
+					return nil, fmt.Errorf("[ERROR] rules.%d: %s only applies to the tcp and udp protocols", index, key)
+				}
+			}
+		}
+		if _, ok := r[isSecurityGroupRuleCode]; ok {
+			if _, ok := r[isSecurityGroupRuleType]; !ok {
+				return nil, fmt.Errorf("[ERROR] rules.%d: code requires type", index)
+			}
+		}
+		_, hasMin := r[isSecurityGroupRulePortMin]
+		_, hasMax := r[isSecurityGroupRulePortMax]
+		if hasMin != hasMax {
+			return nil, fmt.Errorf("[ERROR] rules.%d: port_min and port_max must be set together", index)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// expandIBMISSecurityGroupRulePrototype returns the prototype of a rule
+// declared in the configuration.
+func expandIBMISSecurityGroupRulePrototype(r map[string]interface{}) *vpcv1.SecurityGroupRulePrototype {
+	str := func(key string) *string {
+		if v, _ := r[key].(string); v != "" {
+			return &v
+		}
+		return nil
+	}
+	num := func(key string) *int64 {
+		if v, ok := r[key].(int); ok {
+			n := int64(v)
+			return &n
+		}
+		return nil
+	}
+
+	protocol := "icmp_tcp_udp"
+	if v := str(isSecurityGroupRuleProtocol); v != nil {
+		protocol = *v
+	}
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: str(isSecurityGroupRuleDirection),
+		IPVersion: str(isSecurityGroupRuleIPVersion),
+		Name:      str(isSecurityGroupRuleName),
+		Protocol:  &protocol,
+	}
+	if remote := str(isSecurityGroupRuleRemote); remote != nil {
+		address, cidr, id, _ := inferRemoteSecurityGroup(*remote)
+		remotePrototype := &vpcv1.SecurityGroupRuleRemotePrototype{}
+		if address != "" {
+			remotePrototype.Address = &address
+		} else if cidr != "" {
+			remotePrototype.CIDRBlock = &cidr
+		} else {
+			remotePrototype.ID = &id
+		}
+		prototype.Remote = remotePrototype
+	}
+	if local := str(isSecurityGroupRuleLocal); local != nil {
+		address, cidr, _ := inferLocalSecurityGroup(*local)
+		localPrototype := &vpcv1.SecurityGroupRuleLocalPrototype{}
+		if address != "" {
+			localPrototype.Address = &address
+		} else {
+			localPrototype.CIDRBlock = &cidr
+		}
+		prototype.Local = localPrototype
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		prototype.Type, prototype.Code = num(isSecurityGroupRuleType), num(isSecurityGroupRuleCode)
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin, prototype.PortMax = num(isSecurityGroupRulePortMin), num(isSecurityGroupRulePortMax)
+	}
+	return prototype
+}
+
+// securityGroupRuleKey returns the traffic that a rule allows, with the
+// defaults of the API filled in, so that a declared rule and the rule read
+// back have the same key. As the state cannot tell an ICMP type or code of 0
+// from none, neither does the key.
+func securityGroupRuleKey(r map[string]interface{}) string {
+	str := func(key, def string) string {
+		if v, _ := r[key].(string); v != "" {
+			return v
+		}
+		return def
+	}
+	num := func(key string, def int) string {
+		if v, _ := r[key].(int); v != 0 {
+			return strconv.Itoa(v)
+		}
+		return strconv.Itoa(def)
+	}
+
+	protocol := str(isSecurityGroupRuleProtocol, "icmp_tcp_udp")
+	key := []string{
+		str(isSecurityGroupRuleDirection, ""),
+		str(isSecurityGroupRuleIPVersion, isSecurityGroupRuleIPVersionDefault),
+		protocol,
+		str(isSecurityGroupRuleRemote, "0.0.0.0/0"),
+		str(isSecurityGroupRuleLocal, "0.0.0.0/0"),
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		key = append(key, num(isSecurityGroupRuleType, -1), num(isSecurityGroupRuleCode, -1))
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		key = append(key, num(isSecurityGroupRulePortMin, 1), num(isSecurityGroupRulePortMax, 65535))
+	}
+	return strings.Join(key, "|")
+}
+
+// matchSecurityGroupRules returns, for each of the wanted rules, the index of
+// a distinct rule of rules that allows the same traffic and has the same name
+// if the wanted rule has one, or -1 when there is none.
+func matchSecurityGroupRules(wanted, rules []map[string]interface{}) []int {
+	keys := make([]string, len(rules))
+	for j, r := range rules {
+		keys[j] = securityGroupRuleKey(r)
+	}
+	matches := make([]int, len(wanted))
+	used := make([]bool, len(rules))
+	// The named rules are matched first, so that the rules without a name
+	// do not take their rule.
+	for _, named := range []bool{true, false} {
+		for i, w := range wanted {
+			name, _ := w[isSecurityGroupRuleName].(string)
+			if (name != "") != named {
+				continue
+			}
+			key := securityGroupRuleKey(w)
+			matches[i] = -1
+			for j, r := range rules {
+				if !used[j] && keys[j] == key && (name == "" || r[isSecurityGroupRuleName] == name) {
+					matches[i], used[j] = j, true
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// nestedBlockMaps returns the blocks of a list of nested blocks read from
+// the state, e.g. rules.
+func nestedBlockMaps(blocks []interface{}) []map[string]interface{} {
+	maps := make([]map[string]interface{}, 0, len(blocks))
+	for _, r := range blocks {
+		if r, ok := r.(map[string]interface{}); ok {
+			maps = append(maps, r)
+		}
+	}
+	return maps
+}
+
+// configBlockMap returns the arguments of a block of the configuration that
+// are set, with its nested blocks as lists of maps. The block must be wholly
+// known.
+func configBlockMap(block cty.Value) map[string]interface{} {
+	r := make(map[string]interface{})
+	for key, v := range block.AsValueMap() {
+		switch {
+		case v.IsNull():
+		case v.Type() == cty.String:
+			r[key] = v.AsString()
+		case v.Type() == cty.Number:
+			n, _ := v.AsBigFloat().Int64()
+			r[key] = int(n)
+		case v.Type().IsListType() && v.Type().ElementType().IsObjectType():
+			r[key] = configBlockMaps(v)
+		}
+	}
+	return r
+}
+
+// configBlockMaps returns the blocks of a list of nested blocks of the
+// configuration, e.g. rules.
+func configBlockMaps(blocks cty.Value) []interface{} {
+	maps := []interface{}{}
+	if blocks.IsNull() {
+		return maps
+	}
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		maps = append(maps, configBlockMap(block))
+	}
+	return maps
+}
+
+// securityGroupConflictRule returns a rule of a security group, declared in
+// the configuration or flattened from the API, to check it for conflicts.
+// The rule is named name when it has no name.
+func securityGroupConflictRule(name string, r map[string]interface{}) rulecheck.Rule {
+	str := func(key string) string {
+		v, _ := r[key].(string)
+		return v
+	}
+	rule := rulecheck.Rule{
+		Name:        str(isSecurityGroupRuleName),
+		Direction:   str(isSecurityGroupRuleDirection),
+		Protocol:    str(isSecurityGroupRuleProtocol),
+		Source:      str(isSecurityGroupRuleRemote),
+		Destination: str(isSecurityGroupRuleLocal),
+	}
+	if rule.Name == "" {
+		rule.Name = name
+	}
+	if rule.Protocol == "" {
+		rule.Protocol = "icmp_tcp_udp"
+	}
+	if rule.Direction == "outbound" {
+		rule.Source, rule.Destination = rule.Destination, rule.Source
+	}
+
+	// The deprecated icmp, tcp and udp blocks of ibm_is_security_group_rule
+	// set the protocol and hold its arguments.
+	args := r
+	for _, protocol := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		if blocks, _ := r[protocol].([]interface{}); len(blocks) > 0 {
+			rule.Protocol = protocol
+			args, _ = blocks[0].(map[string]interface{})
+		}
+	}
+	num := func(key string) *int64 {
+		if v, ok := args[key].(int); ok {
+			n := int64(v)
+			return &n
+		}
+		return nil
+	}
+	rule.Type, rule.Code = num(isSecurityGroupRuleType), num(isSecurityGroupRuleCode)
+	if v := num(isSecurityGroupRulePortMin); v != nil {
+		rule.PortMin = *v
+	}
+	if v := num(isSecurityGroupRulePortMax); v != nil {
+		rule.PortMax = *v
+	}
+	return rule
+}
+
+// orderIBMISSecurityGroupRules orders the rules read from the API as the
+// rules of prior, followed by the other rules.
+func orderIBMISSecurityGroupRules(rules []map[string]interface{}, prior []interface{}) []map[string]interface{} {
+	ordered := make([]map[string]interface{}, 0, len(rules))
+	used := make([]bool, len(rules))
+	for _, j := range matchSecurityGroupRules(nestedBlockMaps(prior), rules) {
+		if j >= 0 {
+			ordered = append(ordered, rules[j])
+			used[j] = true
+		}
+	}
+	for j, r := range rules {
+		if !used[j] {
+			ordered = append(ordered, r)
+		}
+	}
+	return ordered
+}
+
+// resourceIBMISSecurityGroupRulesCustomizeDiff plans the rules of a security
+// group whose rules are managed: the declared rules replace the rules of the
+// security group, so that the rules added outside of Terraform show up as
+// changes. The rules are compared regardless of their order.
+func resourceIBMISSecurityGroupRulesCustomizeDiff(diff *schema.ResourceDiff) error {
+	config := cty.NullVal(cty.DynamicPseudoType)
+	if raw := diff.GetRawConfig(); !raw.IsNull() && raw.IsKnown() {
+		config = raw.GetAttr(isSecurityGroupRules)
+	}
+	if !diff.Get(isSecurityGroupManageRules).(bool) {
+		if !config.IsNull() && (!config.IsKnown() || config.LengthInt() > 0) {
+			return fmt.Errorf("[ERROR] rules can only be declared when %s is true", isSecurityGroupManageRules)
+		}
+		return nil
+	}
+	if !config.IsWhollyKnown() {
+		return diff.SetNewComputed(isSecurityGroupRules)
+	}
+
+	wanted, err := expandIBMISSecurityGroupRulesConfig(config)
+	if err != nil {
+		return err
+	}
+	conflictRules := make([]rulecheck.Rule, len(wanted))
+	for i, w := range wanted {
+		conflictRules[i] = securityGroupConflictRule(fmt.Sprintf("%s.%d", isSecurityGroupRules, i), w)
+	}
+	if err := rulecheck.Error(rulecheck.SecurityGroupRules(conflictRules, rulecheck.SecurityGroupRuleQuota)); err != nil {
+		return err
+	}
+	old, _ := diff.GetChange(isSecurityGroupRules)
+	rules := nestedBlockMaps(old.([]interface{}))
+	planned := make([]interface{}, len(wanted))
+	changed := len(wanted) != len(rules)
+	for i, j := range matchSecurityGroupRules(wanted, rules) {
+		if j < 0 {
+			planned[i] = wanted[i]
+			changed = true
+		} else {
+			planned[i] = rules[j]
+		}
+	}
+	if !changed {
+		return diff.Clear(isSecurityGroupRules)
+	}
+	return diff.SetNew(isSecurityGroupRules, planned)
+}
+
+// resourceIBMISSecurityGroupUpdateRules deletes the rules of the security
+// group that are not declared, then creates the declared rules that it does
+// not have.
+func resourceIBMISSecurityGroupUpdateRules(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) diag.Diagnostics {
+	id := d.Id()
+	wanted, err := expandIBMISSecurityGroupRulesConfig(d.GetRawConfig().GetAttr(isSecurityGroupRules))
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "update", "parse-rules").GetDiag()
+	}
+
+	isSecurityGroupRuleKey := "security_group_rule_key_" + id
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	securityGroup, _, err := sess.GetSecurityGroupWithContext(context, &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	var ruleIDs []string
+	var rules []map[string]interface{}
+	for _, rule := range securityGroup.Rules {
+		if ruleID, r := flattenIBMISSecurityGroupRule(rule); r != nil {
+			ruleIDs = append(ruleIDs, ruleID)
+			rules = append(rules, r)
+		}
+	}
+	matches := matchSecurityGroupRules(wanted, rules)
+
+	// The rules are deleted first, so that their names can be reused.
+	used := make([]bool, len(rules))
+	for _, j := range matches {
+		if j >= 0 {
+			used[j] = true
+		}
+	}
+	for j := range rules {
+		if used[j] {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting rule %s of security group %s, it is not declared", ruleIDs[j], id)
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &id,
+			ID:              &ruleIDs[j],
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	for i, j := range matches {
+		if j >= 0 {
+			continue
+		}
+		_, _, err := sess.CreateSecurityGroupRuleWithContext(context, &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &id,
+			SecurityGroupRulePrototype: expandIBMISSecurityGroupRulePrototype(wanted[i]),
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecurityGroupRuleWithContext failed: %s", err.Error()), "ibm_is_security_group", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
+
 func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targetId)
 
@@ -12,10 +12,12 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc/rulecheck"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,7 +42,7 @@ const (
 
 func ResourceIBMISSecurityGroupRule() *schema.Resource {
 
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSecurityGroupRuleCreate,
 		ReadContext:   resourceIBMISSecurityGroupRuleRead,
 		UpdateContext: resourceIBMISSecurityGroupRuleUpdate,
@@ -48,6 +50,12 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 		Exists:        resourceIBMISSecurityGroupRuleExists,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISSecurityGroupRuleConflictsCustomizeDiff(context, diff, v)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isSecurityGroupID: {
@@ -227,7 +235,11 @@ func ResourceIBMISSecurityGroupRule() *schema.Resource {
 				ValidateFunc:  validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"security_group_id", "rule_id"},
+		Separator:  ".",
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISSecurityGroupRuleValidator() *validate.ResourceValidator {
@@ -285,7 +297,38 @@ func ResourceIBMISSecurityGroupRuleValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			AllowedValues:              protocol})
 
-	ibmISSecurityGroupRuleResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group_rule", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:         validate.Conflicts,
+			When:         []validate.CrossFieldCondition{{Identifier: isSecurityGroupRuleProtocol}, {Identifier: isSecurityGroupRuleProtocol, AllowedValues: "icmp", Not: true}},
+			Targets:      []string{isSecurityGroupRuleType, isSecurityGroupRuleCode},
+			Message:      "type and code only apply to the icmp protocol",
+			ZeroValueSet: true,
+		},
+		{
+			Type:    validate.Conflicts,
+			When:    []validate.CrossFieldCondition{{Identifier: isSecurityGroupRuleProtocol}, {Identifier: isSecurityGroupRuleProtocol, AllowedValues: "tcp, udp", Not: true}},
+			Targets: []string{isSecurityGroupRulePortMin, isSecurityGroupRulePortMax},
+			Message: "ports only apply to the tcp and udp protocols",
+		},
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: isSecurityGroupRuleCode}},
+			Targets:      []string{isSecurityGroupRuleType},
+			ZeroValueSet: true,
+		},
+		{
+			Type:         validate.Requires,
+			When:         []validate.CrossFieldCondition{{Identifier: "icmp.0.code"}},
+			Targets:      []string{"icmp.0.type"},
+			ZeroValueSet: true,
+		},
+		{Type: validate.RangeDependsOn, Identifier: isSecurityGroupRulePortMin, MaxValue: isSecurityGroupRulePortMax},
+		{Type: validate.RangeDependsOn, Identifier: "tcp.0.port_min", MaxValue: "tcp.0.port_max"},
+		{Type: validate.RangeDependsOn, Identifier: "udp.0.port_min", MaxValue: "udp.0.port_max"},
+	}
+
+	ibmISSecurityGroupRuleResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group_rule", Schema: validateSchema, Rules: rules}
 	return &ibmISSecurityGroupRuleResourceValidator
 }
 
@@ -929,6 +972,52 @@ func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface
 	return true, nil
 }
 
+// resourceIBMISSecurityGroupRuleConflictsCustomizeDiff checks a new or
+// changed rule against the other rules of its security group for duplicates
+// and, when it is new, for the quota, so that they fail at plan rather than
+// at apply. The rule is not checked while its security group or one of its
+// arguments is unknown.
+func resourceIBMISSecurityGroupRuleConflictsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges(isSecurityGroupRuleDirection, isSecurityGroupRuleRemote, isSecurityGroupRuleLocal,
+		isSecurityGroupRuleProtocol, isSecurityGroupRuleType, isSecurityGroupRuleCode, isSecurityGroupRulePortMin, isSecurityGroupRulePortMax,
+		isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP) {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsWhollyKnown() {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	secgrpID := diff.Get(isSecurityGroupID).(string)
+	ruleID := ""
+	if diff.Id() != "" {
+		_, ruleID, _ = parseISTerraformID(diff.Id())
+	}
+	securityGroup, _, err := sess.GetSecurityGroupWithContext(context, &vpcv1.GetSecurityGroupOptions{
+		ID: &secgrpID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s) to check its rules: %s", secgrpID, err)
+	}
+
+	var rules []rulecheck.Rule
+	for _, rule := range securityGroup.Rules {
+		if id, r := flattenIBMISSecurityGroupRule(rule); r != nil && id != ruleID {
+			rules = append(rules, securityGroupConflictRule(id, r))
+		}
+	}
+	name, _ := diff.Get(isSecurityGroupRuleName).(string)
+	if name == "" {
+		name = "(new)"
+	}
+	rules = append(rules, securityGroupConflictRule(name, configBlockMap(config)))
+	conflicts := rulecheck.SecurityGroupRules(rules, rulecheck.SecurityGroupRuleQuota)
+	return rulecheck.Error(rulecheck.Involving(conflicts, len(rules)-1, diff.Id() == ""))
+}
+
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
@@ -9,14 +9,17 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMISSecurityGroupRule_basic(t *testing.T) {
@@ -287,3 +290,44 @@ func testAccCheckIBMISsecurityGroupRuleConfig(vpcname, name string) string {
  `, vpcname, name)
 
 }
+
+func TestIBMISSecurityGroupRuleConflictsMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-sg-rule-vpc"})
+
+	l := acc.NewLifecycle(t, cloud, "ibm_is_security_group")
+	ssh := map[string]interface{}{"direction": "inbound", "protocol": "tcp", "port_min": 22, "port_max": 22, "remote": "10.0.0.0/8"}
+	egress := map[string]interface{}{"direction": "outbound", "name": "egress"}
+	config := map[string]interface{}{
+		"name":         "tf-mock-sg-rules",
+		"vpc":          vpcState.ID,
+		"manage_rules": true,
+		"rules":        []interface{}{egress},
+	}
+	state := l.Apply(nil, config)
+
+	// Conflicting rules fail at plan, inline or as ibm_is_security_group_rule
+	config["rules"] = []interface{}{egress, ssh, ssh}
+	_, err := l.Plan(state, config)
+	assert.ErrorContains(t, err, "Rule rules.2 is a duplicate of rule rules.1")
+	rule := acc.NewLifecycle(t, cloud, "ibm_is_security_group_rule")
+	_, err = rule.Plan(nil, map[string]interface{}{"group": state.ID, "direction": "outbound", "remote": "0.0.0.0/0"})
+	assert.ErrorContains(t, err, "Rule (new) is a duplicate of rule egress")
+	_, err = rule.Plan(nil, map[string]interface{}{"group": state.ID, "direction": "outbound", "protocol": "tcp", "port_min": 443, "port_max": 443})
+	assert.NoError(t, err)
+
+	// A change of the deprecated protocol blocks is checked too
+	https := rule.Apply(nil, map[string]interface{}{"group": state.ID, "direction": "outbound", "tcp": []interface{}{map[string]interface{}{"port_min": 443, "port_max": 443}}})
+	alt := map[string]interface{}{"group": state.ID, "direction": "outbound", "tcp": []interface{}{map[string]interface{}{"port_min": 8443, "port_max": 8443}}}
+	altState := rule.Apply(nil, alt)
+	alt["tcp"] = []interface{}{map[string]interface{}{"port_min": 443, "port_max": 443}}
+	_, err = rule.Plan(altState, alt)
+	assert.ErrorContains(t, err, "is a duplicate of rule")
+	rule.Destroy(altState)
+	rule.Destroy(https)
+
+	l.Destroy(state)
+	vpcLifecycle.Destroy(vpcState)
+}
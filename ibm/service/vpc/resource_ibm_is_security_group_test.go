@@ -8,14 +8,18 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccIBMISSecurityGroup_basic(t *testing.T) {
@@ -227,3 +231,62 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func TestIBMISSecurityGroupManageRulesMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-sg-vpc"})
+
+	l := acc.NewLifecycle(t, cloud, "ibm_is_security_group")
+	ssh := map[string]interface{}{"direction": "inbound", "protocol": "tcp", "port_min": 22, "port_max": 22, "remote": "10.0.0.0/8"}
+	egress := map[string]interface{}{"direction": "outbound", "name": "egress"}
+	config := map[string]interface{}{
+		"name":         "tf-mock-sg",
+		"vpc":          vpcState.ID,
+		"manage_rules": true,
+		"rules":        []interface{}{ssh, egress},
+	}
+	state := l.Apply(nil, config)
+	assert.True(t, cloud.Exists("security_groups", state.ID))
+	assert.Equal(t, "2", state.Attributes["rules.#"])
+	assert.Equal(t, "22", state.Attributes["rules.0.port_min"])
+	assert.Equal(t, "10.0.0.0/8", state.Attributes["rules.0.remote"])
+	assert.Equal(t, "egress", state.Attributes["rules.1.name"])
+
+	// A rule added outside of Terraform is planned for deletion
+	require.NoError(t, cloud.AddSecurityGroupRule(state.ID, map[string]interface{}{"direction": "inbound", "protocol": "udp", "port_min": 53, "port_max": 53}))
+	state = l.Refresh(state)
+	assert.Equal(t, "3", state.Attributes["rules.#"])
+	diff, err := l.Plan(state, config)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, "2", diff.Attributes["rules.#"].New)
+
+	state = l.Refresh(l.Apply(state, config))
+	assert.Equal(t, "2", state.Attributes["rules.#"])
+	assert.Equal(t, "inbound", state.Attributes["rules.0.direction"])
+	assert.Equal(t, "egress", state.Attributes["rules.1.name"])
+
+	// The order of the rules does not matter
+	config["rules"] = []interface{}{egress, ssh}
+	diff, err = l.Plan(state, config)
+	require.NoError(t, err)
+	assert.Empty(t, diff.Attributes)
+
+	config["rules"] = []interface{}{egress}
+	state = l.Apply(state, config)
+	assert.Equal(t, "1", state.Attributes["rules.#"])
+	assert.Equal(t, "outbound", state.Attributes["rules.0.direction"])
+
+	// Rules are only declared when they are managed
+	delete(config, "manage_rules")
+	_, err = l.Plan(state, config)
+	assert.ErrorContains(t, err, "rules can only be declared when manage_rules is true")
+	delete(config, "rules")
+	assert.Equal(t, "1", l.Apply(state, config).Attributes["rules.#"])
+
+	l.Destroy(state)
+	assert.False(t, cloud.Exists("security_groups", state.ID))
+	vpcLifecycle.Destroy(vpcState)
+}
@@ -24,7 +24,9 @@ func ResourceIbmIsShareDeleteAccessorBinding() *schema.Resource {
 		ReadContext:   resourceIbmIsShareDeleteAccessorBindingRead,
 		UpdateContext: resourceIbmIsShareDeleteAccessorBindingUpdate,
 		DeleteContext: resourceIbmIsShareDeleteAccessorBindingDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "share"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"share": {
@@ -26,7 +26,9 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 		ReadContext:   resourceIBMIsShareMountTargetRead,
 		UpdateContext: resourceIBMIsShareMountTargetUpdate,
 		DeleteContext: resourceIBMIsShareMountTargetDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "share", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"share": {
@@ -26,7 +26,9 @@ func ResourceIbmIsShareReplicaOperations() *schema.Resource {
 		ReadContext:   resourceIbmIsShareReplicaOperationsRead,
 		UpdateContext: resourceIbmIsShareReplicaOperationsUpdate,
 		DeleteContext: resourceIbmIsShareReplicaOperationsDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "share_replica"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"share_replica": {
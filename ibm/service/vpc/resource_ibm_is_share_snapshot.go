@@ -33,7 +33,9 @@ func ResourceIBMIsShareSnapshot() *schema.Resource {
 		ReadContext:   resourceIBMIsShareSnapshotRead,
 		UpdateContext: resourceIBMIsShareSnapshotUpdate,
 		DeleteContext: resourceIBMIsShareSnapshotDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "share", ""),
+		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
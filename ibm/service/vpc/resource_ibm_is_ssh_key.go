@@ -38,7 +38,7 @@ const (
 )
 
 func ResourceIBMISSSHKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSSHKeyCreate,
 		ReadContext:   resourceIBMISSSHKeyRead,
 		UpdateContext: resourceIBMISSSHKeyUpdate,
@@ -160,7 +160,10 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Description: "List of access management tags for SSH key",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISSHKeyValidator() *validate.ResourceValidator {
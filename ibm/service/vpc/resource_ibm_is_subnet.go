@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -52,7 +53,7 @@ const (
 )
 
 func ResourceIBMISSubnet() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISSubnetCreate,
 		ReadContext:   resourceIBMISSubnetRead,
 		UpdateContext: resourceIBMISSubnetUpdate,
@@ -230,7 +231,10 @@ func ResourceIBMISSubnet() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
@@ -273,10 +277,77 @@ func ResourceIBMISSubnetValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISSubnetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: isSubnetIpv4CidrBlock, Not: true}},
+			Targets: []string{isSubnetTotalIpv4AddressCount},
+			Message: fmt.Sprintf("one of %s or %s must be set", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount),
+		},
+		{
+			Type:  validate.Custom,
+			Check: resourceIBMISSubnetValidateAddressPrefix,
+		},
+	}
+
+	ibmISSubnetResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_subnet", Schema: validateSchema, Rules: rules}
 	return &ibmISSubnetResourceValidator
 }
 
+// resourceIBMISSubnetValidateAddressPrefix checks that the CIDR block of a
+// new subnet is inside an address prefix of its VPC in its zone. It is
+// skipped while the VPC is unknown, as when it is created in the same apply.
+func resourceIBMISSubnetValidateAddressPrefix(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isSubnetIpv4CidrBlock) {
+		return nil
+	}
+	if !diff.NewValueKnown(isSubnetIpv4CidrBlock) || !diff.NewValueKnown(isSubnetVPC) || !diff.NewValueKnown(isSubnetZone) {
+		return nil
+	}
+	cidr := diff.Get(isSubnetIpv4CidrBlock).(string)
+	vpc := diff.Get(isSubnetVPC).(string)
+	zone := diff.Get(isSubnetZone).(string)
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil || vpc == "" {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil
+	}
+	start := ""
+	prefixes := []vpcv1.AddressPrefix{}
+	for {
+		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+		listVpcAddressPrefixesOptions.SetVPCID(vpc)
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, _, err := sess.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Error listing the address prefixes of VPC %s to check the CIDR block of the subnet: %s", vpc, err)
+			return nil
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		prefixes = append(prefixes, addressPrefixCollection.AddressPrefixes...)
+		if start == "" {
+			break
+		}
+	}
+	for _, prefix := range prefixes {
+		_, block, err := net.ParseCIDR(*prefix.CIDR)
+		if err != nil {
+			continue
+		}
+		blockSize, _ := block.Mask.Size()
+		subnetSize, _ := subnet.Mask.Size()
+		if block.Contains(subnet.IP) && blockSize <= subnetSize && prefix.Zone != nil && *prefix.Zone.Name == zone {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s %s is not inside an address prefix of VPC %s in zone %s", isSubnetIpv4CidrBlock, cidr, vpc, zone)
+}
+
 func resourceIBMISSubnetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(isSubnetName).(string)
@@ -436,8 +507,8 @@ func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Dura
 		Target:     []string{isSubnetProvisioningDone, ""},
 		Refresh:    isSubnetRefreshFunc(subnetC, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcPollInterval,
+		MinTimeout: vpcPollInterval,
 	}
 
 	return stateConf.WaitForState()
@@ -860,8 +931,8 @@ func isWaitForSubnetDeleteRetry(vpcClient *vpcv1.VpcV1, id string, timeout time.
 			return response, isSubnetDeleting, nil
 		},
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcPollInterval,
+		MinTimeout: vpcPollInterval,
 	}
 	return stateConf.WaitForState()
 }
@@ -874,8 +945,8 @@ func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Durati
 		Target:     []string{isSubnetDeleted, ""},
 		Refresh:    isSubnetDeleteRefreshFunc(subnetC, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcPollInterval,
+		MinTimeout: vpcPollInterval,
 	}
 
 	return stateConf.WaitForState()
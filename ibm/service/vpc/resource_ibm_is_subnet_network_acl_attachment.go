@@ -29,7 +29,9 @@ func ResourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 		UpdateContext: resourceIBMISSubnetNetworkACLAttachmentUpdate,
 		DeleteContext: resourceIBMISSubnetNetworkACLAttachmentDelete,
 		Exists:        resourceIBMISSubnetNetworkACLAttachmentExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isSubnetID),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -29,7 +29,7 @@ const (
 )
 
 func ResourceIBMISReservedIP() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISReservedIPCreate,
 		ReadContext:   resourceIBMISReservedIPRead,
 		UpdateContext: resourceIBMISReservedIPUpdate,
@@ -123,7 +123,10 @@ func ResourceIBMISReservedIP() *schema.Resource {
 				Description: "The resource type.",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"subnet_id", "reserved_ip_id"},
+		Regional:   true,
+	})
 }
 func ResourceIBMISSubnetReservedIPValidator() *validate.ResourceValidator {
 
@@ -7,14 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMISSubnet_basic(t *testing.T) {
@@ -145,3 +149,23 @@ func testAccCheckIBMISSubnetConfigUpdate(vpcname, name, zone, cidr, gwname strin
 		tags = ["tag1"]
 	}`, vpcname, gwname, zone, name, zone, cidr)
 }
+
+func TestIBMISSubnetAddressPrefixMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-subnet-vpc"})
+
+	// The CIDR block of a subnet is inside an address prefix of its zone
+	l := acc.NewLifecycle(t, cloud, "ibm_is_subnet")
+	config := map[string]interface{}{"name": "tf-mock-subnet", "vpc": vpcState.ID, "zone": mockcloud.Region + "-1", "ipv4_cidr_block": "10.240.0.0/24"}
+	_, err := l.Plan(nil, config)
+	assert.NoError(t, err)
+	for _, cidr := range []string{"192.168.0.0/24", "10.240.0.0/17", "10.240.64.0/24"} {
+		config["ipv4_cidr_block"] = cidr
+		_, err = l.Plan(nil, config)
+		assert.ErrorContains(t, err, fmt.Sprintf("ipv4_cidr_block %s is not inside an address prefix", cidr))
+	}
+
+	vpcLifecycle.Destroy(vpcState)
+}
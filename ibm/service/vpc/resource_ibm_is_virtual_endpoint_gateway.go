@@ -52,7 +52,7 @@ const (
 func ResourceIBMISEndpointGateway() *schema.Resource {
 	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
 	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMisVirtualEndpointGatewayCreate,
 		ReadContext:   resourceIBMisVirtualEndpointGatewayRead,
 		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
@@ -278,7 +278,10 @@ func ResourceIBMISEndpointGateway() *schema.Resource {
 				Description: "List of access management tags",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
@@ -319,7 +322,30 @@ func ResourceIBMISEndpointGatewayValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmEndpointGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_endpoint_gateway", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isVirtualEndpointGatewayAllowDnsResolutionBinding, AllowedValues: "true"},
+				{Identifier: "dns_resolution_binding_mode", AllowedValues: "disabled"},
+			},
+			Targets:      []string{isVirtualEndpointGatewayAllowDnsResolutionBinding},
+			Message:      "use only dns_resolution_binding_mode",
+			ZeroValueSet: true,
+		},
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: isVirtualEndpointGatewayAllowDnsResolutionBinding, AllowedValues: "false"},
+				{Identifier: "dns_resolution_binding_mode", AllowedValues: "primary, per_resource_binding"},
+			},
+			Targets:      []string{isVirtualEndpointGatewayAllowDnsResolutionBinding},
+			Message:      "use only dns_resolution_binding_mode",
+			ZeroValueSet: true,
+		},
+	}
+
+	ibmEndpointGatewayResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_virtual_endpoint_gateway", Schema: validateSchema, Rules: rules}
 	return &ibmEndpointGatewayResourceValidator
 }
 
@@ -35,7 +35,9 @@ func ResourceIBMISEndpointGatewayIP() *schema.Resource {
 		ReadContext:   resourceIBMisVirtualEndpointGatewayIPRead,
 		DeleteContext: resourceIBMisVirtualEndpointGatewayIPDelete,
 		Exists:        resourceIBMisVirtualEndpointGatewayIPExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isVirtualEndpointGatewayID, ""),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -24,7 +24,9 @@ func ResourceIBMIsVirtualEndpointGatewayResourceBinding() *schema.Resource {
 		ReadContext:   resourceIBMIsVirtualEndpointGatewayResourceBindingRead,
 		UpdateContext: resourceIBMIsVirtualEndpointGatewayResourceBindingUpdate,
 		DeleteContext: resourceIBMIsVirtualEndpointGatewayResourceBindingDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "endpoint_gateway_id", ""),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"endpoint_gateway_id": &schema.Schema{
@@ -22,7 +22,9 @@ func ResourceIBMIsVirtualNetworkInterfaceFloatingIP() *schema.Resource {
 		CreateContext: resourceIBMIsVirtualNetworkInterfaceFloatingIPCreate,
 		ReadContext:   resourceIBMIsVirtualNetworkInterfaceFloatingIPRead,
 		DeleteContext: resourceIBMIsVirtualNetworkInterfaceFloatingIPDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "virtual_network_interface", "floating_ip"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"virtual_network_interface": &schema.Schema{
@@ -21,7 +21,9 @@ func ResourceIBMIsVirtualNetworkInterfaceIP() *schema.Resource {
 		CreateContext: resourceIBMIsVirtualNetworkInterfaceIPCreate,
 		ReadContext:   resourceIBMIsVirtualNetworkInterfaceIPRead,
 		DeleteContext: resourceIBMIsVirtualNetworkInterfaceIPDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "virtual_network_interface", "reserved_ip"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"virtual_network_interface": &schema.Schema{
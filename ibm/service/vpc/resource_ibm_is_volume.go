@@ -60,7 +60,7 @@ const (
 )
 
 func ResourceIBMISVolume() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVolumeCreate,
 		ReadContext:   resourceIBMISVolumeRead,
 		UpdateContext: resourceIBMISVolumeUpdate,
@@ -423,7 +423,10 @@ func ResourceIBMISVolume() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVolumeValidator() *validate.ResourceValidator {
@@ -92,8 +92,13 @@ const (
 	isVPCNoSgAclRules                         = "no_sg_acl_rules"
 )
 
+// vpcPollInterval is how often VPCs and subnets are polled while they are
+// created or deleted. Tests against the mock cloud, where they change at
+// once, shorten it.
+var vpcPollInterval = 10 * time.Second
+
 func ResourceIBMISVPC() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPCCreate,
 		ReadContext:   resourceIBMISVPCRead,
 		UpdateContext: resourceIBMISVPCUpdate,
@@ -637,7 +642,10 @@ func ResourceIBMISVPC() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVPCValidator() *validate.ResourceValidator {
@@ -716,7 +724,24 @@ func ResourceIBMISVPCValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
-	ibmISVPCResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Requires,
+			When: []validate.CrossFieldCondition{
+				{Identifier: "dns.0.resolver.0.type", AllowedValues: "delegated"},
+				{Identifier: "dns.0.resolver.0.vpc_crn", Not: true},
+			},
+			Targets: []string{"dns.0.resolver.0.vpc_id"},
+			Message: "a delegated resolver takes the DNS servers of the resolver of vpc_id or vpc_crn",
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "dns.0.resolver.0.type", AllowedValues: "manual"}},
+			Targets: []string{"dns.0.resolver.0." + isVPCDnsResolverManualServers},
+		},
+	}
+
+	ibmISVPCResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc", Schema: validateSchema, Rules: rules}
 	return &ibmISVPCResourceValidator
 }
 
@@ -895,8 +920,8 @@ func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (
 		Target:     []string{isVPCAvailable, isVPCFailed},
 		Refresh:    isVPCRefreshFunc(vpc, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcPollInterval,
+		MinTimeout: vpcPollInterval,
 	}
 
 	return stateConf.WaitForState()
@@ -1894,8 +1919,8 @@ func isWaitForVPCDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (in
 		Target:     []string{isVPCDeleted, isVPCFailed},
 		Refresh:    isVPCDeleteRefreshFunc(vpc, id),
 		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		Delay:      vpcPollInterval,
+		MinTimeout: vpcPollInterval,
 	}
 
 	return stateConf.WaitForState()
@@ -28,7 +28,7 @@ const (
 )
 
 func ResourceIBMISVpcAddressPrefix() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVpcAddressPrefixCreate,
 		ReadContext:   resourceIBMISVpcAddressPrefixRead,
 		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
@@ -90,7 +90,10 @@ func ResourceIBMISVpcAddressPrefix() *schema.Resource {
 				Description: "The unique identifier of the address prefix",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"vpc_id", "address_prefix_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISAddressPrefixValidator() *validate.ResourceValidator {
@@ -24,7 +24,9 @@ func ResourceIBMIsVPCDnsResolutionBinding() *schema.Resource {
 		ReadContext:   resourceIBMIsVPCDnsResolutionBindingRead,
 		UpdateContext: resourceIBMIsVPCDnsResolutionBindingUpdate,
 		DeleteContext: resourceIBMIsVPCDnsResolutionBindingDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", "vpc_id", ""),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,16 +49,38 @@ const (
 	rtAccessTagType              = "access"
 	rtTags                       = "tags"
 	rtUserTagType                = "user"
+	rtManageRoutes               = "manage_routes"
+	rtRoutePriority              = "priority"
+	rtRouteAdvertise             = "advertise"
 )
 
 func ResourceIBMISVPCRoutingTable() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPCRoutingTableCreate,
 		ReadContext:   resourceIBMISVPCRoutingTableRead,
 		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
 		DeleteContext: resourceIBMISVPCRoutingTableDelete,
 		Exists:        resourceIBMISVPCRoutingTableExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The routes are only read when they are managed, so they
+				// are listed on import for the configuration to declare.
+				sess, err := vpcClient(meta)
+				if err != nil {
+					return nil, err
+				}
+				idSet := strings.Split(d.Id(), "/")
+				if len(idSet) != 2 {
+					return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", d.Id())
+				}
+				routes, err := listIBMISVPCRoutingTableRoutes(context, sess, idSet[0], idSet[1])
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error listing the routes of routing table %s: %s", d.Id(), err)
+				}
+				d.Set(rtRoutes, routes)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -68,6 +92,10 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISVPCRoutingTableRoutesCustomizeDiff(diff)
+				}),
 		),
 		Schema: map[string]*schema.Schema{
 			rtVpcID: {
@@ -128,6 +156,77 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtName),
 				Description:  "The user-defined name for this routing table.",
 			},
+			rtManageRoutes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the routing table has exactly the routes declared in routes. The other user routes of the routing table are deleted, including the routes of ibm_is_vpc_routing_table_route resources.",
+			},
+			rtRoutes: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user routes of the routing table. They can only be declared when manage_routes is true.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rDestination: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtAction),
+							Description:  "The action to perform with a packet matching the route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "If action is deliver, the next hop that packets will be delivered to. For other action values, its address will be 0.0.0.0.",
+						},
+						rtRoutePriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtRoutePriority),
+							Description:  "The route's priority. Smaller values have higher priority.",
+						},
+						rtRouteAdvertise: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+						rName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtName),
+							Description:  "The user-defined name for this route.",
+						},
+						rID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table route identifier.",
+						},
+						rtOrigin: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The origin of this route.",
+						},
+					},
+				},
+			},
 			rtID: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -223,7 +322,10 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				Description: "List of access management tags",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"vpc_id", "routing_table_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVPCRoutingTableValidator() *validate.ResourceValidator {
@@ -269,6 +371,14 @@ func ResourceIBMISVPCRoutingTableValidator() *validate.ResourceValidator {
 			Required:                   false,
 			AllowedValues:              actionAllowedValues})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 rtRoutePriority,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "4"})
+
 	ibmISVPCRoutingTableValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc_routing_table", Schema: validateSchema}
 	return &ibmISVPCRoutingTableValidator
 }
@@ -326,6 +436,15 @@ func resourceIBMISVPCRoutingTableCreate(context context.Context, d *schema.Resou
 		routeVPCZoneIngress := d.Get(rtRouteVPCZoneIngress).(bool)
 		createVpcRoutingTableOptions.RouteVPCZoneIngress = &routeVPCZoneIngress
 	}
+	if d.Get(rtManageRoutes).(bool) {
+		routes, err := expandIBMISVPCRoutingTableRoutesConfig(d.GetRawConfig().GetAttr(rtRoutes))
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "create", "parse-routes").GetDiag()
+		}
+		for _, route := range routes {
+			createVpcRoutingTableOptions.Routes = append(createVpcRoutingTableOptions.Routes, *expandIBMISVPCRoutingTableRoutePrototype(route))
+		}
+	}
 	routeTable, _, err := sess.CreateVPCRoutingTableWithContext(context, createVpcRoutingTableOptions)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateVPCRoutingTableWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "create")
@@ -470,6 +589,26 @@ func resourceIBMISVPCRoutingTableRead(context context.Context, d *schema.Resourc
 		err = fmt.Errorf("Error setting subnets: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "read", "set-subnets").GetDiag()
 	}
+	manageRoutes := d.Get(rtManageRoutes).(bool)
+	if err = d.Set(rtManageRoutes, manageRoutes); err != nil {
+		err = fmt.Errorf("Error setting manage_routes: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "read", "set-manage_routes").GetDiag()
+	}
+	if manageRoutes {
+		routes, err := listIBMISVPCRoutingTableRoutes(context, sess, idSet[0], idSet[1])
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		// Keep the order of the planned routes, the routes added outside of
+		// Terraform come last.
+		routes = orderIBMISVPCRoutingTableRoutes(routes, d.Get(rtRoutes).([]interface{}))
+		if err = d.Set(rtRoutes, routes); err != nil {
+			err = fmt.Errorf("Error setting routes: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "read", "set-routes").GetDiag()
+		}
+	}
 	resourceGroupList := []map[string]interface{}{}
 	if routeTable.ResourceGroup != nil {
 		resourceGroupMap := routingTableResourceGroupToMap(*routeTable.ResourceGroup)
@@ -640,6 +779,11 @@ func resourceIBMISVPCRoutingTableUpdate(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	if d.Get(rtManageRoutes).(bool) && d.HasChanges(rtRoutes, rtManageRoutes) {
+		if diags := resourceIBMISVPCRoutingTableUpdateRoutes(context, sess, d); diags != nil {
+			return diags
+		}
+	}
 	return resourceIBMISVPCRoutingTableRead(context, d, meta)
 }
 
@@ -690,3 +834,363 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 	}
 	return true, nil
 }
+
+// listIBMISVPCRoutingTableRoutes returns the routes of a routing table that
+// were created by users. The routes that have a creator, e.g. a VPN gateway,
+// are managed by it and cannot be deleted.
+func listIBMISVPCRoutingTableRoutes(context context.Context, sess *vpcv1.VpcV1, vpcID, tableID string) ([]map[string]interface{}, error) {
+	routes := make([]map[string]interface{}, 0)
+	start := ""
+	for {
+		listVpcRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		if start != "" {
+			listVpcRoutingTableRoutesOptions.Start = &start
+		}
+		result, _, err := sess.ListVPCRoutingTableRoutesWithContext(context, listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, route := range result.Routes {
+			if route.Creator == nil {
+				routes = append(routes, flattenIBMISVPCRoutingTableRoute(route))
+			}
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			return routes, nil
+		}
+	}
+}
+
+func flattenIBMISVPCRoutingTableRoute(route vpcv1.Route) map[string]interface{} {
+	r := map[string]interface{}{
+		rID:              *route.ID,
+		rName:            *route.Name,
+		rDestination:     *route.Destination,
+		rAction:          *route.Action,
+		rtRouteAdvertise: *route.Advertise,
+		rtRoutePriority:  int(*route.Priority),
+		rtOrigin:         *route.Origin,
+	}
+	if route.Zone != nil {
+		r[rZone] = *route.Zone.Name
+	}
+	if nexthop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok {
+		if nexthop.Address != nil {
+			r[rNextHop] = *nexthop.Address
+		}
+		if nexthop.ID != nil {
+			r[rNextHop] = *nexthop.ID
+		}
+	}
+	return r
+}
+
+// expandIBMISVPCRoutingTableRoutesConfig returns the routes declared in the
+// configuration, with the arguments that are set.
+func expandIBMISVPCRoutingTableRoutesConfig(config cty.Value) ([]map[string]interface{}, error) {
+	var routes []map[string]interface{}
+	if config.IsNull() {
+		return routes, nil
+	}
+	for it := config.ElementIterator(); it.Next(); {
+		i, route := it.Element()
+		r := make(map[string]interface{})
+		for key, v := range route.AsValueMap() {
+			switch {
+			case v.IsNull():
+			case v.Type() == cty.String:
+				r[key] = v.AsString()
+			case v.Type() == cty.Number:
+				n, _ := v.AsBigFloat().Int64()
+				r[key] = int(n)
+			case v.Type() == cty.Bool:
+				r[key] = v.True()
+			}
+		}
+
+		index, _ := i.AsBigFloat().Int64()
+		for _, key := range []string{rDestination, rZone} {
+			if _, ok := r[key]; !ok {
+				return nil, fmt.Errorf("[ERROR] routes.%d: %s is required", index, key)
+			}
+		}
+		nextHop, _ := r[rNextHop].(string)
+		if routingTableRouteAction(r) == vpcv1.RouteActionDeliverConst {
+			if nextHop == "" {
+				return nil, fmt.Errorf("[ERROR] routes.%d: next_hop is required when action is deliver", index)
+			}
+		} else if nextHop != "" && nextHop != "0.0.0.0" {
+			return nil, fmt.Errorf("[ERROR] routes.%d: the next hop of a route that does not deliver packets is 0.0.0.0", index)
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
+
+func routingTableRouteAction(r map[string]interface{}) string {
+	if action, _ := r[rAction].(string); action != "" {
+		return action
+	}
+	return vpcv1.RouteActionDeliverConst
+}
+
+func expandIBMISVPCRoutingTableRouteNextHop(nextHop string) *vpcv1.RouteNextHopPrototype {
+	if net.ParseIP(nextHop) == nil {
+		return &vpcv1.RouteNextHopPrototype{ID: core.StringPtr(nextHop)}
+	}
+	return &vpcv1.RouteNextHopPrototype{Address: core.StringPtr(nextHop)}
+}
+
+// expandIBMISVPCRoutingTableRoutePrototype returns the prototype of a route
+// declared in the configuration.
+func expandIBMISVPCRoutingTableRoutePrototype(r map[string]interface{}) *vpcv1.RoutePrototype {
+	prototype := &vpcv1.RoutePrototype{
+		Action:      core.StringPtr(routingTableRouteAction(r)),
+		Destination: core.StringPtr(r[rDestination].(string)),
+		Zone:        &vpcv1.ZoneIdentityByName{Name: core.StringPtr(r[rZone].(string))},
+	}
+	if nextHop, _ := r[rNextHop].(string); nextHop != "" {
+		prototype.NextHop = expandIBMISVPCRoutingTableRouteNextHop(nextHop)
+	}
+	if name, _ := r[rName].(string); name != "" {
+		prototype.Name = &name
+	}
+	if priority, ok := r[rtRoutePriority].(int); ok {
+		prototype.Priority = core.Int64Ptr(int64(priority))
+	}
+	if advertise, ok := r[rtRouteAdvertise].(bool); ok {
+		prototype.Advertise = &advertise
+	}
+	return prototype
+}
+
+// routingTableRouteKey returns what a route applies to: the traffic to its
+// destination from its zone, and what it does with it. A route whose key
+// changes is replaced, the other arguments of a route are updated in place.
+func routingTableRouteKey(r map[string]interface{}) string {
+	destination, _ := r[rDestination].(string)
+	zone, _ := r[rZone].(string)
+	return strings.Join([]string{destination, zone, routingTableRouteAction(r)}, "|")
+}
+
+// routingTableRouteInSync reports whether route has the arguments that are
+// declared in wanted. A route that does not declare its priority or name
+// accepts the ones chosen by the API.
+func routingTableRouteInSync(wanted, route map[string]interface{}) bool {
+	nextHop := func(r map[string]interface{}) string {
+		if v, _ := r[rNextHop].(string); v != "" {
+			return v
+		}
+		return "0.0.0.0"
+	}
+	if routingTableRouteKey(wanted) != routingTableRouteKey(route) || nextHop(wanted) != nextHop(route) {
+		return false
+	}
+	if advertise, _ := wanted[rtRouteAdvertise].(bool); advertise != route[rtRouteAdvertise] {
+		return false
+	}
+	if priority, ok := wanted[rtRoutePriority].(int); ok && priority != route[rtRoutePriority] {
+		return false
+	}
+	if name, _ := wanted[rName].(string); name != "" && name != route[rName] {
+		return false
+	}
+	return true
+}
+
+// matchIBMISVPCRoutingTableRoutes returns, for each of the wanted routes, the
+// index of a distinct route of routes with the same key, or -1 when there is
+// none. The routes that are in sync are matched first, so that a route is
+// only updated when no route already has its arguments.
+func matchIBMISVPCRoutingTableRoutes(wanted, routes []map[string]interface{}) []int {
+	matches := make([]int, len(wanted))
+	for i := range matches {
+		matches[i] = -1
+	}
+	used := make([]bool, len(routes))
+	for _, inSync := range []bool{true, false} {
+		for i, w := range wanted {
+			if matches[i] >= 0 {
+				continue
+			}
+			key := routingTableRouteKey(w)
+			for j, r := range routes {
+				if !used[j] && routingTableRouteKey(r) == key && (!inSync || routingTableRouteInSync(w, r)) {
+					matches[i], used[j] = j, true
+					break
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// orderIBMISVPCRoutingTableRoutes orders the routes read from the API as the
+// routes of prior, followed by the other routes.
+func orderIBMISVPCRoutingTableRoutes(routes []map[string]interface{}, prior []interface{}) []map[string]interface{} {
+	ordered := make([]map[string]interface{}, 0, len(routes))
+	used := make([]bool, len(routes))
+	for _, j := range matchIBMISVPCRoutingTableRoutes(nestedBlockMaps(prior), routes) {
+		if j >= 0 {
+			ordered = append(ordered, routes[j])
+			used[j] = true
+		}
+	}
+	for j, r := range routes {
+		if !used[j] {
+			ordered = append(ordered, r)
+		}
+	}
+	return ordered
+}
+
+// resourceIBMISVPCRoutingTableRoutesCustomizeDiff plans the routes of a
+// routing table whose routes are managed: the declared routes replace the
+// user routes of the routing table, so that the routes added outside of
+// Terraform show up as changes. The routes are compared regardless of their
+// order.
+func resourceIBMISVPCRoutingTableRoutesCustomizeDiff(diff *schema.ResourceDiff) error {
+	config := cty.NullVal(cty.DynamicPseudoType)
+	if raw := diff.GetRawConfig(); !raw.IsNull() && raw.IsKnown() {
+		config = raw.GetAttr(rtRoutes)
+	}
+	if !diff.Get(rtManageRoutes).(bool) {
+		if !config.IsNull() && (!config.IsKnown() || config.LengthInt() > 0) {
+			return fmt.Errorf("[ERROR] routes can only be declared when %s is true", rtManageRoutes)
+		}
+		return nil
+	}
+	if !config.IsWhollyKnown() {
+		return diff.SetNewComputed(rtRoutes)
+	}
+
+	wanted, err := expandIBMISVPCRoutingTableRoutesConfig(config)
+	if err != nil {
+		return err
+	}
+	old, _ := diff.GetChange(rtRoutes)
+	routes := nestedBlockMaps(old.([]interface{}))
+	planned := make([]interface{}, len(wanted))
+	changed := len(wanted) != len(routes)
+	for i, j := range matchIBMISVPCRoutingTableRoutes(wanted, routes) {
+		switch {
+		case j < 0:
+			planned[i] = wanted[i]
+			changed = true
+		case routingTableRouteInSync(wanted[i], routes[j]):
+			planned[i] = routes[j]
+		default:
+			// The route is updated, it keeps its identifier
+			route := make(map[string]interface{}, len(routes[j]))
+			for key, v := range routes[j] {
+				route[key] = v
+			}
+			for key, v := range wanted[i] {
+				route[key] = v
+			}
+			planned[i] = route
+			changed = true
+		}
+	}
+	if !changed {
+		return diff.Clear(rtRoutes)
+	}
+	return diff.SetNew(rtRoutes, planned)
+}
+
+// resourceIBMISVPCRoutingTableUpdateRoutes converges the user routes of the
+// routing table to the declared routes with as few changes as possible: the
+// routes that are not declared are deleted, the routes whose next hop,
+// priority, advertise or name differ are updated, and the declared routes
+// that the routing table does not have are created.
+func resourceIBMISVPCRoutingTableUpdateRoutes(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData) diag.Diagnostics {
+	idSet := strings.Split(d.Id(), "/")
+	wanted, err := expandIBMISVPCRoutingTableRoutesConfig(d.GetRawConfig().GetAttr(rtRoutes))
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table", "update", "parse-routes").GetDiag()
+	}
+	routes, err := listIBMISVPCRoutingTableRoutes(context, sess, idSet[0], idSet[1])
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	matches := matchIBMISVPCRoutingTableRoutes(wanted, routes)
+
+	// The routes are deleted first, so that their names can be reused.
+	used := make([]bool, len(routes))
+	for _, j := range matches {
+		if j >= 0 {
+			used[j] = true
+		}
+	}
+	for j, route := range routes {
+		if used[j] {
+			continue
+		}
+		routeID := route[rID].(string)
+		log.Printf("[DEBUG] Deleting route %s of routing table %s, it is not declared", routeID, idSet[1])
+		response, err := sess.DeleteVPCRoutingTableRouteWithContext(context, sess.NewDeleteVPCRoutingTableRouteOptions(idSet[0], idSet[1], routeID))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteVPCRoutingTableRouteWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	for i, j := range matches {
+		if j < 0 || routingTableRouteInSync(wanted[i], routes[j]) {
+			continue
+		}
+		w, route := wanted[i], routes[j]
+		routePatchModel := new(vpcv1.RoutePatch)
+		if nextHop, _ := w[rNextHop].(string); nextHop != "" && nextHop != route[rNextHop] {
+			prototype := expandIBMISVPCRoutingTableRouteNextHop(nextHop)
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{Address: prototype.Address, ID: prototype.ID}
+		}
+		if advertise, _ := w[rtRouteAdvertise].(bool); advertise != route[rtRouteAdvertise] {
+			routePatchModel.Advertise = &advertise
+		}
+		if priority, ok := w[rtRoutePriority].(int); ok && priority != route[rtRoutePriority] {
+			routePatchModel.Priority = core.Int64Ptr(int64(priority))
+		}
+		if name, _ := w[rName].(string); name != "" && name != route[rName] {
+			routePatchModel.Name = &name
+		}
+		routePatch, err := routePatchModel.AsPatch()
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("routePatchModel.AsPatch() failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		_, _, err = sess.UpdateVPCRoutingTableRouteWithContext(context, sess.NewUpdateVPCRoutingTableRouteOptions(idSet[0], idSet[1], route[rID].(string), routePatch))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateVPCRoutingTableRouteWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	for i, j := range matches {
+		if j >= 0 {
+			continue
+		}
+		prototype := expandIBMISVPCRoutingTableRoutePrototype(wanted[i])
+		_, _, err := sess.CreateVPCRoutingTableRouteWithContext(context, &vpcv1.CreateVPCRoutingTableRouteOptions{
+			VPCID:          &idSet[0],
+			RoutingTableID: &idSet[1],
+			Action:         prototype.Action,
+			Advertise:      prototype.Advertise,
+			Destination:    prototype.Destination,
+			Name:           prototype.Name,
+			NextHop:        prototype.NextHop,
+			Priority:       prototype.Priority,
+			Zone:           prototype.Zone,
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateVPCRoutingTableRouteWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
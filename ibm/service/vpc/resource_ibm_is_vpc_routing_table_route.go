@@ -29,7 +29,7 @@ const (
 )
 
 func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPCRoutingTableRouteCreate,
 		ReadContext:   resourceIBMISVPCRoutingTableRouteRead,
 		UpdateContext: resourceIBMISVPCRoutingTableRouteUpdate,
@@ -176,7 +176,10 @@ func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 				Description: "The origin of this route.",
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"vpc_id", "routing_table_id", "route_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVPCRoutingTableRouteValidator() *validate.ResourceValidator {
@@ -209,7 +212,19 @@ func ResourceIBMISVPCRoutingTableRouteValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "0",
 			MaxValue:                   "4"})
-	ibmVPCRoutingTableRouteValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc_routing_table_route", Schema: validateSchema}
+
+	rules := []validate.CrossFieldRule{
+		{
+			Type: validate.Conflicts,
+			When: []validate.CrossFieldCondition{
+				{Identifier: rAction, AllowedValues: "delegate, delegate_vpc, drop"},
+				{Identifier: rNextHop, AllowedValues: "0.0.0.0", Not: true},
+			},
+			Targets: []string{rNextHop},
+			Message: "the next hop of a route that does not deliver packets is 0.0.0.0",
+		},
+	}
+	ibmVPCRoutingTableRouteValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc_routing_table_route", Schema: validateSchema, Rules: rules}
 	return &ibmVPCRoutingTableRouteValidator
 }
 
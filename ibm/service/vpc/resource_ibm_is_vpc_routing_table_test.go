@@ -7,13 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccIBMISVPCRoutingTable_basic(t *testing.T) {
@@ -271,3 +276,81 @@ resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
 	advertise_routes_to=[]
 }`, name, rtName)
 }
+
+func TestIBMISVPCRoutingTableManageRoutesMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	vpcLifecycle := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+	vpcState := vpcLifecycle.Apply(nil, map[string]interface{}{"name": "tf-mock-rt-vpc"})
+
+	l := acc.NewLifecycle(t, cloud, "ibm_is_vpc_routing_table")
+	zone := mockcloud.Region + "-1"
+	onPrem := map[string]interface{}{"destination": "192.168.0.0/16", "zone": zone, "next_hop": "10.240.0.4", "name": "on-prem"}
+	blackhole := map[string]interface{}{"destination": "172.16.0.0/12", "zone": zone, "action": "drop", "priority": 1}
+	config := map[string]interface{}{
+		"name":          "tf-mock-rt",
+		"vpc":           vpcState.ID,
+		"manage_routes": true,
+		"routes":        []interface{}{onPrem, blackhole},
+	}
+	state := l.Apply(nil, config)
+	tableID := state.Attributes["routing_table"]
+	assert.True(t, cloud.Exists("routing_tables", tableID))
+	assert.Equal(t, "2", state.Attributes["routes.#"])
+	assert.Equal(t, "on-prem", state.Attributes["routes.0.name"])
+	assert.Equal(t, "2", state.Attributes["routes.0.priority"])
+	assert.Equal(t, "0.0.0.0", state.Attributes["routes.1.next_hop"])
+	assert.Equal(t, "1", state.Attributes["routes.1.priority"])
+	routeID := state.Attributes["routes.0.route_id"]
+
+	// A route added outside of Terraform is planned for deletion
+	require.NoError(t, cloud.AddRoute(tableID, map[string]interface{}{"destination": "10.10.0.0/16", "zone": map[string]interface{}{"name": zone}, "next_hop": map[string]interface{}{"address": "10.240.0.5"}}))
+	state = l.Refresh(state)
+	assert.Equal(t, "3", state.Attributes["routes.#"])
+	diff, err := l.Plan(state, config)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, "2", diff.Attributes["routes.#"].New)
+
+	state = l.Refresh(l.Apply(state, config))
+	assert.Equal(t, "2", state.Attributes["routes.#"])
+	assert.Equal(t, "192.168.0.0/16", state.Attributes["routes.0.destination"])
+	assert.Equal(t, "172.16.0.0/12", state.Attributes["routes.1.destination"])
+
+	// The order of the routes does not matter
+	config["routes"] = []interface{}{blackhole, onPrem}
+	diff, err = l.Plan(state, config)
+	require.NoError(t, err)
+	assert.Empty(t, diff.Attributes)
+
+	// The priority, advertise and next hop of a route are updated in place
+	onPrem["priority"], onPrem["advertise"], onPrem["next_hop"] = 0, true, "10.240.0.6"
+	state = l.Apply(state, config)
+	assert.Equal(t, "2", state.Attributes["routes.#"])
+	assert.Equal(t, routeID, state.Attributes["routes.1.route_id"])
+	assert.Equal(t, "0", state.Attributes["routes.1.priority"])
+	assert.Equal(t, "true", state.Attributes["routes.1.advertise"])
+	assert.Equal(t, "10.240.0.6", state.Attributes["routes.1.next_hop"])
+
+	config["routes"] = []interface{}{onPrem}
+	state = l.Apply(state, config)
+	assert.Equal(t, "1", state.Attributes["routes.#"])
+	assert.Equal(t, routeID, state.Attributes["routes.0.route_id"])
+
+	// Routes are only declared when they are managed
+	delete(config, "manage_routes")
+	_, err = l.Plan(state, config)
+	assert.ErrorContains(t, err, "routes can only be declared when manage_routes is true")
+	delete(config, "routes")
+	state = l.Apply(state, config)
+	assert.Equal(t, "1", state.Attributes["routes.#"])
+
+	// Unmanaged routes are not refreshed, but are listed on import
+	require.NoError(t, cloud.AddRoute(tableID, map[string]interface{}{"destination": "10.20.0.0/16", "zone": map[string]interface{}{"name": zone}, "next_hop": map[string]interface{}{"address": "10.240.0.7"}}))
+	assert.Equal(t, "1", l.Refresh(state).Attributes["routes.#"])
+	assert.Equal(t, "2", l.ImportState(state.ID).Attributes["routes.#"])
+
+	l.Destroy(state)
+	assert.False(t, cloud.Exists("routing_tables", tableID))
+	vpcLifecycle.Destroy(vpcState)
+}
@@ -7,14 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/mockcloud"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMISVPC_basic(t *testing.T) {
@@ -1915,3 +1919,68 @@ resource "ibm_is_vpc" "hub_false_delegated" {
 }
 	`, hubVpcName, region, region, dnsInstanceName, customResolverName, delegatedVpcName)
 }
+
+func TestIBMISVPCMockCloud(t *testing.T) {
+	cloud := acc.UseMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: acc.TestAccProviderFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if rs.Type == "ibm_is_vpc" && cloud.Exists("vpcs", rs.Primary.ID) {
+					return fmt.Errorf("VPC still exists: %s", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: cloud.ProviderConfig() + testAccCheckIBMISVPCConfig("tf-mock-vpc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "tf-mock-vpc"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "status", "available"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "default_security_group_name", "dsgn"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "tags.#", "2"),
+				),
+			},
+			{
+				Config: cloud.ProviderConfig() + testAccCheckIBMISVPCConfigUpdate("tf-mock-vpc-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "tf-mock-vpc-renamed"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "tags.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_vpc.testacc_vpc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"no_sg_acl_rules", "address_prefix_management"},
+			},
+		},
+	})
+}
+
+func TestIBMISVPCLifecycleMockCloud(t *testing.T) {
+	cloud := acc.StartMockCloud(t)
+	vpc.SetVPCPollInterval(t, 10*time.Millisecond)
+	l := acc.NewLifecycle(t, cloud, "ibm_is_vpc")
+
+	state := l.Apply(nil, map[string]interface{}{"name": "tf-mock-vpc", "tags": []interface{}{"env:test"}})
+	assert.True(t, cloud.Exists("vpcs", state.ID))
+	assert.Equal(t, "tf-mock-vpc", state.Attributes["name"])
+	assert.Equal(t, "available", state.Attributes["status"])
+	assert.Equal(t, "1", state.Attributes["tags.#"])
+	assert.Equal(t, mockcloud.DefaultResourceGroupID, state.Attributes["resource_group"])
+	assert.Equal(t, "3", state.Attributes["default_address_prefixes.%"])
+
+	state = l.Apply(state, map[string]interface{}{"name": "tf-mock-vpc-renamed", "tags": []interface{}{"env:test", "team:network"}})
+	assert.Equal(t, "tf-mock-vpc-renamed", state.Attributes["name"])
+	assert.Equal(t, "2", state.Attributes["tags.#"])
+
+	acc.AssertImported(t, state, l.ImportState(state.ID), "no_sg_acl_rules", "address_prefix_management")
+
+	l.Destroy(state)
+	assert.False(t, cloud.Exists("vpcs", state.ID))
+	assert.Nil(t, l.Refresh(state))
+}
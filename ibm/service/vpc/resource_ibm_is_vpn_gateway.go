@@ -48,7 +48,7 @@ const (
 )
 
 func ResourceIBMISVPNGateway() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPNGatewayCreate,
 		ReadContext:   resourceIBMISVPNGatewayRead,
 		UpdateContext: resourceIBMISVPNGatewayUpdate,
@@ -350,7 +350,10 @@ func ResourceIBMISVPNGateway() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVPNGatewayValidator() *validate.ResourceValidator {
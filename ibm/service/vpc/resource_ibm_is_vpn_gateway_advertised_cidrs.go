@@ -29,7 +29,9 @@ func ResourceIBMISVPNGatewayAdvertisedCidr() *schema.Resource {
 		ReadContext:   resourceIBMISVPNGatewayAdvertisedCidrRead,
 		DeleteContext: resourceIBMISVPNGatewayAdvertisedCidrDelete,
 
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdParts("/", isVPNGatewayAdvertisedCidrVPNGateway, isVPNGatewayAdvertisedCidr),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
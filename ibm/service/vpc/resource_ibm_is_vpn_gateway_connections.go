@@ -47,7 +47,7 @@ const (
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithIdentity(&schema.Resource{
 		CreateContext: resourceIBMISVPNGatewayConnectionCreate,
 		ReadContext:   resourceIBMISVPNGatewayConnectionRead,
 		UpdateContext: resourceIBMISVPNGatewayConnectionUpdate,
@@ -405,7 +405,10 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, flex.IdentitySpec{
+		Attributes: []string{"vpn_gateway_id", "connection_id"},
+		Regional:   true,
+	})
 }
 
 func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
@@ -456,7 +459,15 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 			MinValue:                   "2",
 			MaxValue:                   "86399"})
 
-	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "routing_protocol", AllowedValues: "bgp"}},
+			Targets: []string{"peer.0.asn"},
+		},
+	}
+
+	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema, Rules: rules}
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
 
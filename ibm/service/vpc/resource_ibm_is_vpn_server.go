@@ -455,7 +455,20 @@ func ResourceIBMIsVPNServerValidator() *validate.ResourceValidator {
 		},
 	)
 
-	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server", Schema: validateSchema}
+	rules := []validate.CrossFieldRule{
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "client_authentication.*.method", AllowedValues: "certificate"}},
+			Targets: []string{"client_authentication.*.client_ca_crn"},
+		},
+		{
+			Type:    validate.Requires,
+			When:    []validate.CrossFieldCondition{{Identifier: "client_authentication.*.method", AllowedValues: "username"}},
+			Targets: []string{"client_authentication.*.identity_provider"},
+		},
+	}
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_server", Schema: validateSchema, Rules: rules}
 	return &resourceValidator
 }
 
@@ -22,7 +22,9 @@ func ResourceIBMIsVPNServerClient() *schema.Resource {
 		ReadContext:   resourceIBMIsVPNServerClientDisconnect,
 		UpdateContext: resourceIBMIsVPNServerClientDisconnect,
 		DeleteContext: resourceIBMIsVPNServerClientDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: flex.ImportIdPrefix("/", "vpn_server", "vpn_client"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpn_server": &schema.Schema{
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package rollingupdate replaces the members of an instance group that were
+// created from an older instance template, a batch at a time, so that the
+// group keeps serving while its instances are replaced.
+package rollingupdate
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Member is a member of an instance group.
+type Member struct {
+	ID string
+	// Template is the identifier of the instance template that the instance
+	// of the member was created from.
+	Template string
+}
+
+// Group is the instance group whose members are replaced.
+type Group interface {
+	// Members returns the members of the group, except the members that are
+	// being deleted.
+	Members(ctx context.Context) ([]Member, error)
+	// SetTemplate sets the instance template that the group creates its new
+	// members from.
+	SetTemplate(ctx context.Context, template string) error
+	// SetMembershipCount sets the number of members of the group, and waits
+	// for the group to create or delete members.
+	SetMembershipCount(ctx context.Context, count int) error
+	// DeleteMember deletes a member of the group and its instance, and waits
+	// for it to be deleted. The membership count of the group decreases by
+	// one.
+	DeleteMember(ctx context.Context, id string) error
+	// WaitHealthy waits for timeout at most for members to be healthy,
+	// including their load balancer pool members.
+	WaitHealthy(ctx context.Context, ids []string, timeout time.Duration) error
+}
+
+// Options are the options of a rolling update.
+type Options struct {
+	// MaxUnavailable is the number of members of a batch that are deleted
+	// before their replacements are created.
+	MaxUnavailable int
+	// MaxSurge is the number of members of a batch that are created before
+	// the members that they replace are deleted.
+	MaxSurge int
+	// HealthCheckTimeout is how long the new members of a batch have to be
+	// healthy.
+	HealthCheckTimeout time.Duration
+}
+
+// Replace replaces the members of group that were not created from
+// template, the instance template of the group, and keeps count members.
+//
+// Each batch replaces up to MaxSurge + MaxUnavailable members. It creates
+// MaxSurge members over count and waits for them to be healthy, then deletes
+// the members that it replaces and creates the members that restore count.
+// When the new members of a batch are not healthy in time, the batch is
+// rolled back: the instance template of the group is set back to previous,
+// the new members of the batch are deleted, and the group creates the
+// members that restore count from previous. The members replaced by the
+// earlier batches keep template.
+func Replace(ctx context.Context, group Group, count int, template, previous string, options Options) error {
+	if options.MaxSurge+options.MaxUnavailable < 1 {
+		return fmt.Errorf("max_surge and max_unavailable cannot both be 0")
+	}
+	remaining := -1
+	for {
+		members, err := group.Members(ctx)
+		if err != nil {
+			return err
+		}
+		var old []string
+		for _, m := range members {
+			if m.Template != template {
+				old = append(old, m.ID)
+			}
+		}
+		if len(old) == 0 {
+			return nil
+		}
+		if remaining >= 0 && len(old) >= remaining {
+			return fmt.Errorf("the group still has %d members that were not created from instance template %s", len(old), template)
+		}
+		remaining = len(old)
+
+		b := &batch{group: group, known: make(map[string]bool), count: count, timeout: options.HealthCheckTimeout}
+		for _, m := range members {
+			b.known[m.ID] = true
+		}
+		size := min(options.MaxSurge+options.MaxUnavailable, len(old))
+		if err := b.replace(ctx, old[:size], min(options.MaxSurge, size)); err != nil {
+			return b.rollback(ctx, previous, err)
+		}
+	}
+}
+
+// batch replaces some of the members of a group.
+type batch struct {
+	group Group
+	// known are the members of the group before the batch, and the members
+	// that the batch created
+	known   map[string]bool
+	created []string
+	count   int
+	timeout time.Duration
+}
+
+func (b *batch) replace(ctx context.Context, old []string, surge int) error {
+	if surge > 0 {
+		if err := b.group.SetMembershipCount(ctx, b.count+surge); err != nil {
+			return err
+		}
+		if err := b.waitCreated(ctx); err != nil {
+			return err
+		}
+	}
+	for _, id := range old {
+		if err := b.group.DeleteMember(ctx, id); err != nil {
+			return err
+		}
+	}
+	if surge < len(old) {
+		if err := b.group.SetMembershipCount(ctx, b.count); err != nil {
+			return err
+		}
+		if err := b.waitCreated(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitCreated waits for the members that the group created since the last
+// call to be healthy.
+func (b *batch) waitCreated(ctx context.Context) error {
+	members, err := b.group.Members(ctx)
+	if err != nil {
+		return err
+	}
+	var created []string
+	for _, m := range members {
+		if !b.known[m.ID] {
+			b.known[m.ID] = true
+			created = append(created, m.ID)
+		}
+	}
+	b.created = append(b.created, created...)
+	if len(created) == 0 {
+		return nil
+	}
+	return b.group.WaitHealthy(ctx, created, b.timeout)
+}
+
+func (b *batch) rollback(ctx context.Context, previous string, cause error) error {
+	err := b.group.SetTemplate(ctx, previous)
+	for _, id := range b.created {
+		if err != nil {
+			break
+		}
+		err = b.group.DeleteMember(ctx, id)
+	}
+	if err == nil {
+		err = b.group.SetMembershipCount(ctx, b.count)
+	}
+	if err == nil {
+		err = b.waitCreated(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("%s, and rolling back to instance template %s failed: %s", cause, previous, err)
+	}
+	return fmt.Errorf("%s, the batch was rolled back to instance template %s", cause, previous)
+}
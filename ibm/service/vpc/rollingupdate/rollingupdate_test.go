@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package rollingupdate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeGroup is an instance group whose members are created from its
+// template when its membership count grows.
+type fakeGroup struct {
+	template string
+	members  []Member
+	next     int
+	// healthy is the number of calls to WaitHealthy that succeed, or -1
+	healthy int
+	// The fewest and the most members that the group had
+	low, high int
+}
+
+func newFakeGroup(count int, template string) *fakeGroup {
+	g := &fakeGroup{template: template, healthy: -1, low: count, high: count}
+	for i := 0; i < count; i++ {
+		g.create()
+	}
+	return g
+}
+
+func (g *fakeGroup) create() {
+	g.next++
+	g.members = append(g.members, Member{ID: fmt.Sprintf("m%d", g.next), Template: g.template})
+}
+
+func (g *fakeGroup) Members(ctx context.Context) ([]Member, error) {
+	return append([]Member(nil), g.members...), nil
+}
+
+func (g *fakeGroup) SetTemplate(ctx context.Context, template string) error {
+	g.template = template
+	return nil
+}
+
+func (g *fakeGroup) SetMembershipCount(ctx context.Context, count int) error {
+	for len(g.members) < count {
+		g.create()
+	}
+	if len(g.members) > count {
+		return fmt.Errorf("unexpected scale in from %d to %d members", len(g.members), count)
+	}
+	g.high = max(g.high, len(g.members))
+	return nil
+}
+
+func (g *fakeGroup) DeleteMember(ctx context.Context, id string) error {
+	for i, m := range g.members {
+		if m.ID == id {
+			g.members = append(g.members[:i], g.members[i+1:]...)
+			g.low = min(g.low, len(g.members))
+			return nil
+		}
+	}
+	return fmt.Errorf("member %s not found", id)
+}
+
+func (g *fakeGroup) WaitHealthy(ctx context.Context, ids []string, timeout time.Duration) error {
+	if g.healthy == 0 {
+		return fmt.Errorf("members %s are not healthy after %s", strings.Join(ids, ", "), timeout)
+	}
+	g.healthy--
+	return nil
+}
+
+// templates returns the templates of the members of g.
+func (g *fakeGroup) templates() string {
+	templates := make([]string, len(g.members))
+	for i, m := range g.members {
+		templates[i] = m.Template
+	}
+	return strings.Join(templates, ",")
+}
+
+func TestReplace(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		options   Options
+		low, high int
+	}{
+		{name: "surge", options: Options{MaxSurge: 1}, low: 3, high: 4},
+		{name: "unavailable", options: Options{MaxUnavailable: 2}, low: 1, high: 3},
+		{name: "both", options: Options{MaxSurge: 1, MaxUnavailable: 1}, low: 2, high: 4},
+		{name: "all at once", options: Options{MaxSurge: 5}, low: 3, high: 6},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newFakeGroup(3, "old")
+			g.template = "new"
+			if err := Replace(context.Background(), g, 3, "new", "old", tc.options); err != nil {
+				t.Fatal(err)
+			}
+			if got := g.templates(); got != "new,new,new" {
+				t.Errorf("Got members of %s, want new", got)
+			}
+			if g.low != tc.low || g.high != tc.high {
+				t.Errorf("Got %d to %d members, want %d to %d", g.low, g.high, tc.low, tc.high)
+			}
+		})
+	}
+}
+
+func TestReplaceRollback(t *testing.T) {
+	// The members of the second batch are not healthy
+	g := newFakeGroup(3, "old")
+	g.template = "new"
+	g.healthy = 1
+	err := Replace(context.Background(), g, 3, "new", "old", Options{MaxSurge: 1, HealthCheckTimeout: time.Minute})
+	if err == nil || err.Error() != "members m5 are not healthy after 1m0s, the batch was rolled back to instance template old" {
+		t.Fatalf("Got error %v", err)
+	}
+	if g.template != "old" {
+		t.Errorf("Got template %s, want old", g.template)
+	}
+	if got := g.templates(); got != "old,old,new" {
+		t.Errorf("Got members of %s, want the member of the first batch to keep new", got)
+	}
+
+	// Rolling back fails too
+	g = newFakeGroup(3, "old")
+	g.template = "new"
+	g.healthy = 0
+	err = Replace(context.Background(), g, 3, "new", "old", Options{MaxUnavailable: 1, HealthCheckTimeout: time.Minute})
+	if err == nil || !strings.Contains(err.Error(), "and rolling back to instance template old failed: members m5 are not healthy") {
+		t.Fatalf("Got error %v", err)
+	}
+}
+
+func TestReplaceErrors(t *testing.T) {
+	g := newFakeGroup(2, "old")
+	if err := Replace(context.Background(), g, 2, "new", "old", Options{}); err == nil {
+		t.Error("Got no error without a batch")
+	}
+
+	// The group keeps creating members from the previous template
+	if err := Replace(context.Background(), g, 2, "new", "old", Options{MaxSurge: 1}); err == nil || !strings.Contains(err.Error(), "still has 2 members") {
+		t.Errorf("Got error %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package rulecheck finds the rules of a security group or a network ACL that
+// conflict with the others before they are created: exact duplicates, rules
+// of a network ACL that never apply because earlier rules match all of their
+// traffic, and rules over the quota. The rules that never apply are only
+// warned about.
+package rulecheck
+
+import (
+	"fmt"
+	"log"
+	"net/netip"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// The kinds of conflicts.
+const (
+	Duplicate = "duplicate"
+	Shadowed  = "shadowed"
+	Quota     = "quota"
+)
+
+// The default quotas of rules per security group and per network ACL.
+const (
+	SecurityGroupRuleQuota = 250
+	NetworkACLRuleQuota    = 200
+)
+
+// Rule is a rule of a security group or a network ACL. The rule of a
+// security group allows the traffic between its remote and local ends, which
+// are the source and the destination of inbound rules, and the other way
+// around for outbound rules.
+type Rule struct {
+	// Name identifies the rule in the conflicts, e.g. its name, or rules.2
+	// when it has none.
+	Name string
+	// Action is allow or deny, for the rules of a network ACL.
+	Action    string
+	Direction string
+	// Protocol is all, any, icmp_tcp_udp, icmp, tcp, udp or another IP
+	// protocol. It is all when it is not set.
+	Protocol string
+	// Source and Destination are an address, a CIDR block or, for the remote
+	// end of the rule of a security group, the identifier of a security
+	// group. They are any address when they are not set.
+	Source      string
+	Destination string
+	// The ranges of the ports of tcp and udp rules, any port when they are
+	// not set.
+	PortMin       int64
+	PortMax       int64
+	SourcePortMin int64
+	SourcePortMax int64
+	// The type and code of icmp rules, any when they are nil.
+	Type *int64
+	Code *int64
+}
+
+// Conflict is a rule that conflicts with another rule or with the quota.
+type Conflict struct {
+	Kind string
+	// Index is the index of the rule that conflicts, and With the index of
+	// the rule that it conflicts with. Both are -1 for the quota.
+	Index   int
+	With    int
+	Message string
+}
+
+// SecurityGroupRules returns the conflicts of the rules of a security group:
+// the duplicates and the rules over quota.
+func SecurityGroupRules(rules []Rule, quota int) []Conflict {
+	return check(rules, quota, "security group", false)
+}
+
+// NetworkACLRules returns the conflicts of the rules of a network ACL, in the
+// order in which they are evaluated: the duplicates, the rules that earlier
+// rules shadow and the rules over quota.
+func NetworkACLRules(rules []Rule, quota int) []Conflict {
+	return check(rules, quota, "network ACL", true)
+}
+
+// Error returns an error that lists the duplicates and the rules over quota
+// in conflicts, or nil when there is none. A shadowed rule is valid, if
+// likely a mistake, so it is only logged as a warning; the
+// ibm_is_rule_conflicts data source reports it.
+func Error(conflicts []Conflict) error {
+	var messages []string
+	for _, c := range conflicts {
+		if c.Kind == Shadowed {
+			log.Printf("[WARN] %s", c.Message)
+			continue
+		}
+		messages = append(messages, "- "+c.Message)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] Conflicting rules:\n%s", strings.Join(messages, "\n"))
+}
+
+// Involving returns the conflicts of the rule at index with the other rules,
+// and the quota if quota is true. It checks a rule against the rules that
+// exist already.
+func Involving(conflicts []Conflict, index int, quota bool) []Conflict {
+	var involving []Conflict
+	for _, c := range conflicts {
+		if c.Index == index || c.With == index || c.Kind == Quota && quota {
+			involving = append(involving, c)
+		}
+	}
+	return involving
+}
+
+func check(rules []Rule, quota int, kind string, ordered bool) []Conflict {
+	var conflicts []Conflict
+	normalized := make([]Rule, len(rules))
+	for i, r := range rules {
+		normalized[i] = normalize(r)
+	}
+	for j, r := range normalized {
+		conflict := Conflict{Index: j, With: -1}
+		for i, earlier := range normalized[:j] {
+			if earlier.key() == r.key() {
+				conflict.Kind, conflict.With = Duplicate, i
+				conflict.Message = fmt.Sprintf("Rule %s is a duplicate of rule %s", r.Name, earlier.Name)
+				break
+			}
+			if ordered && conflict.With < 0 && earlier.covers(r) {
+				conflict.Kind, conflict.With = Shadowed, i
+				conflict.Message = fmt.Sprintf("Rule %s never applies: rule %s, which is evaluated before it, matches all of its traffic and %ss it", r.Name, earlier.Name, earlier.Action)
+			}
+		}
+		if conflict.With >= 0 {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	if quota > 0 && len(rules) > quota {
+		conflicts = append(conflicts, Conflict{
+			Kind:    Quota,
+			Index:   -1,
+			With:    -1,
+			Message: fmt.Sprintf("The %d rules are over the quota of %d rules per %s", len(rules), quota, kind),
+		})
+	}
+	return conflicts
+}
+
+// hasPorts reports whether the rules of protocol have ports, or a type and a
+// code.
+func hasPorts(protocol string) bool {
+	_, errs := validate.ValidateSecurityRuleProtocol(protocol, "protocol")
+	return len(errs) == 0
+}
+
+// normalize fills in the defaults of r, and drops the ports and ICMP types
+// of the protocols that do not have them.
+func normalize(r Rule) Rule {
+	if r.Protocol == "" || r.Protocol == "any" {
+		r.Protocol = "all"
+	}
+	if r.Source == "" {
+		r.Source = "0.0.0.0/0"
+	}
+	if r.Destination == "" {
+		r.Destination = "0.0.0.0/0"
+	}
+	if !hasPorts(r.Protocol) || r.Protocol == "icmp" {
+		r.PortMin, r.PortMax, r.SourcePortMin, r.SourcePortMax = 0, 0, 0, 0
+	}
+	if r.Protocol != "icmp" {
+		r.Type, r.Code = nil, nil
+	}
+	if r.Protocol == "tcp" || r.Protocol == "udp" {
+		for _, port := range []*int64{&r.PortMin, &r.SourcePortMin} {
+			if *port == 0 {
+				*port = 1
+			}
+		}
+		for _, port := range []*int64{&r.PortMax, &r.SourcePortMax} {
+			if *port == 0 {
+				*port = 65535
+			}
+		}
+	}
+	return r
+}
+
+// key returns the traffic that the normalized rule r matches and its action.
+func (r Rule) key() string {
+	value := func(v *int64) string {
+		if v == nil {
+			return "any"
+		}
+		return fmt.Sprint(*v)
+	}
+	return strings.Join([]string{
+		r.Action, r.Direction, r.Protocol, prefixKey(r.Source), prefixKey(r.Destination),
+		fmt.Sprint(r.PortMin), fmt.Sprint(r.PortMax), fmt.Sprint(r.SourcePortMin), fmt.Sprint(r.SourcePortMax),
+		value(r.Type), value(r.Code),
+	}, "|")
+}
+
+// covers reports whether the normalized rule r matches all of the traffic
+// that the normalized rule other matches.
+func (r Rule) covers(other Rule) bool {
+	if r.Direction != other.Direction || !protocolCovers(r.Protocol, other.Protocol) {
+		return false
+	}
+	if !prefixCovers(r.Source, other.Source) || !prefixCovers(r.Destination, other.Destination) {
+		return false
+	}
+	if r.Protocol != other.Protocol {
+		// r matches any port and ICMP type of a protocol that it covers
+		return true
+	}
+	return r.PortMin <= other.PortMin && other.PortMax <= r.PortMax &&
+		r.SourcePortMin <= other.SourcePortMin && other.SourcePortMax <= r.SourcePortMax &&
+		(r.Type == nil || other.Type != nil && *r.Type == *other.Type) &&
+		(r.Code == nil || other.Code != nil && *r.Code == *other.Code)
+}
+
+func protocolCovers(protocol, other string) bool {
+	switch protocol {
+	case other, "all":
+		return true
+	case "icmp_tcp_udp":
+		return other == "icmp" || other == "tcp" || other == "udp"
+	}
+	return false
+}
+
+// parsePrefix parses an address or a CIDR block. It returns false for the
+// identifier of a security group.
+func parsePrefix(s string) (netip.Prefix, bool) {
+	if _, errs := validate.ValidateCIDR(s, "cidr"); len(errs) == 0 {
+		prefix, err := netip.ParsePrefix(s)
+		return prefix.Masked(), err == nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
+func prefixKey(s string) string {
+	if prefix, ok := parsePrefix(s); ok {
+		return prefix.String()
+	}
+	return s
+}
+
+// prefixCovers reports whether the address, CIDR block or security group s
+// contains all of other.
+func prefixCovers(s, other string) bool {
+	prefix, ok := parsePrefix(s)
+	otherPrefix, otherOK := parsePrefix(other)
+	if !ok || !otherOK {
+		return s == other
+	}
+	return prefix.Bits() <= otherPrefix.Bits() && prefix.Contains(otherPrefix.Addr())
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package rulecheck
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func icmp(value int64) *int64 {
+	return &value
+}
+
+func TestNetworkACLRules(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		rules []Rule
+		// The conflicts, as kind:index:with
+		want []string
+	}{
+		{
+			name: "no conflict",
+			rules: []Rule{
+				{Name: "ssh", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 22, PortMax: 22},
+				{Name: "https", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 443, PortMax: 443},
+				{Name: "deny", Action: "deny", Direction: "inbound"},
+				{Name: "outbound", Action: "allow", Direction: "outbound"},
+			},
+		},
+		{
+			name: "duplicate with the defaults filled in",
+			rules: []Rule{
+				{Name: "web", Action: "allow", Direction: "inbound", Protocol: "tcp", Source: "10.0.0.0/8"},
+				{Name: "other", Action: "allow", Direction: "outbound"},
+				{Name: "web-again", Action: "allow", Direction: "inbound", Protocol: "tcp", Source: "10.1.2.3/8", Destination: "0.0.0.0/0", PortMin: 1, PortMax: 65535, SourcePortMin: 1, SourcePortMax: 65535},
+			},
+			want: []string{"duplicate:2:0"},
+		},
+		{
+			name: "shadowed by a rule with another action",
+			rules: []Rule{
+				{Name: "deny-all", Action: "deny", Direction: "inbound", Protocol: "all", Source: "0.0.0.0/0"},
+				{Name: "ssh", Action: "allow", Direction: "inbound", Protocol: "tcp", Source: "10.240.0.0/24", PortMin: 22, PortMax: 22},
+				{Name: "out", Action: "allow", Direction: "outbound"},
+			},
+			want: []string{"shadowed:1:0"},
+		},
+		{
+			name: "shadowed by a wider range of ports",
+			rules: []Rule{
+				{Name: "ports", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 1000, PortMax: 2000},
+				{Name: "port", Action: "deny", Direction: "inbound", Protocol: "tcp", PortMin: 1500, PortMax: 1500},
+				{Name: "other-port", Action: "deny", Direction: "inbound", Protocol: "tcp", PortMin: 1500, PortMax: 2500},
+				{Name: "udp", Action: "deny", Direction: "inbound", Protocol: "udp", PortMin: 1500, PortMax: 1500},
+			},
+			want: []string{"shadowed:1:0"},
+		},
+		{
+			name: "shadowed by icmp_tcp_udp",
+			rules: []Rule{
+				{Name: "l4", Action: "deny", Direction: "outbound", Protocol: "icmp_tcp_udp", Destination: "192.0.2.0/24"},
+				{Name: "host", Action: "allow", Direction: "outbound", Protocol: "udp", Destination: "192.0.2.53", PortMin: 53, PortMax: 53},
+				{Name: "gre", Action: "allow", Direction: "outbound", Protocol: "gre", Destination: "192.0.2.1"},
+			},
+			want: []string{"shadowed:1:0"},
+		},
+		{
+			name: "icmp types",
+			rules: []Rule{
+				{Name: "echo-reply", Action: "allow", Direction: "inbound", Protocol: "icmp", Type: icmp(0)},
+				{Name: "echo", Action: "allow", Direction: "inbound", Protocol: "icmp", Type: icmp(8)},
+				{Name: "echo-code", Action: "deny", Direction: "inbound", Protocol: "icmp", Type: icmp(8), Code: icmp(0)},
+				{Name: "any", Action: "deny", Direction: "inbound", Protocol: "icmp"},
+				{Name: "echo-again", Action: "deny", Direction: "inbound", Protocol: "icmp", Type: icmp(8)},
+			},
+			want: []string{"shadowed:2:1", "shadowed:4:1"},
+		},
+		{
+			name: "duplicate of a rule after the one that shadows it",
+			rules: []Rule{
+				{Name: "deny-all", Action: "deny", Direction: "inbound"},
+				{Name: "ssh", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 22, PortMax: 22},
+				{Name: "ssh-again", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 22, PortMax: 22},
+			},
+			want: []string{"shadowed:1:0", "duplicate:2:1"},
+		},
+		{
+			name: "a narrower rule does not shadow a wider one",
+			rules: []Rule{
+				{Name: "subnet", Action: "deny", Direction: "inbound", Source: "10.240.0.0/24"},
+				{Name: "vpc", Action: "allow", Direction: "inbound", Source: "10.240.0.0/16"},
+				{Name: "host", Action: "allow", Direction: "inbound", Source: "10.240.0.5"},
+			},
+			want: []string{"shadowed:2:0"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, c := range NetworkACLRules(tc.rules, NetworkACLRuleQuota) {
+				got = append(got, fmt.Sprintf("%s:%d:%d", c.Kind, c.Index, c.With))
+				if !strings.Contains(c.Message, tc.rules[c.Index].Name) || !strings.Contains(c.Message, tc.rules[c.With].Name) {
+					t.Errorf("Message %q does not name the rules", c.Message)
+				}
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Got conflicts %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRules(t *testing.T) {
+	rules := []Rule{
+		{Name: "ssh", Direction: "inbound", Protocol: "tcp", Source: "r006-sg-bastion", PortMin: 22, PortMax: 22},
+		{Name: "all", Direction: "inbound", Protocol: "all"},
+		// Security group rules are not ordered, a wider rule does not shadow
+		{Name: "https", Direction: "inbound", Protocol: "tcp", PortMin: 443, PortMax: 443},
+		{Name: "ssh-again", Direction: "inbound", Protocol: "tcp", Source: "r006-sg-bastion", PortMin: 22, PortMax: 22},
+		{Name: "ssh-other", Direction: "inbound", Protocol: "tcp", Source: "r006-sg-other", PortMin: 22, PortMax: 22},
+		{Name: "any", Direction: "inbound", Protocol: "any"},
+	}
+	conflicts := SecurityGroupRules(rules, 5)
+	if len(conflicts) != 3 {
+		t.Fatalf("Got conflicts %+v, want 3", conflicts)
+	}
+	if c := conflicts[0]; c.Kind != Duplicate || c.Index != 3 || c.With != 0 {
+		t.Errorf("Got conflict %+v, want ssh-again to duplicate ssh", c)
+	}
+	if c := conflicts[1]; c.Kind != Duplicate || c.Index != 5 || c.With != 1 {
+		t.Errorf("Got conflict %+v, want any to duplicate all", c)
+	}
+	if c := conflicts[2]; c.Kind != Quota || c.Index != -1 || c.Message != "The 6 rules are over the quota of 5 rules per security group" {
+		t.Errorf("Got conflict %+v, want the quota", c)
+	}
+
+	if involving := Involving(conflicts, 1, false); len(involving) != 1 || involving[0].Index != 5 {
+		t.Errorf("Got conflicts %+v involving all, want the duplicate", involving)
+	}
+	if involving := Involving(conflicts, 2, true); len(involving) != 1 || involving[0].Kind != Quota {
+		t.Errorf("Got conflicts %+v involving https, want the quota", involving)
+	}
+
+	if err := Error(conflicts); err == nil || !strings.Contains(err.Error(), "- Rule ssh-again is a duplicate of rule ssh\n") {
+		t.Errorf("Got error %v", err)
+	}
+	if err := Error(SecurityGroupRules(rules[:3], 5)); err != nil {
+		t.Errorf("Got error %v, want none", err)
+	}
+
+	// Shadowed rules are only warned about
+	shadowed := NetworkACLRules([]Rule{
+		{Name: "deny-all", Action: "deny", Direction: "inbound"},
+		{Name: "ssh", Action: "allow", Direction: "inbound", Protocol: "tcp", PortMin: 22, PortMax: 22},
+	}, NetworkACLRuleQuota)
+	if len(shadowed) != 1 {
+		t.Errorf("Got conflicts %+v, want ssh to be shadowed", shadowed)
+	}
+	if err := Error(shadowed); err != nil {
+		t.Errorf("Got error %v for a shadowed rule, want none", err)
+	}
+}
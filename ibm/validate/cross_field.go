@@ -0,0 +1,437 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Type of cross-attribute rule
+type CrossFieldRuleType int
+
+const (
+	// Requires fails when the conditions hold and one of the Targets is not
+	// set.
+	Requires CrossFieldRuleType = iota
+
+	// Conflicts fails when the conditions hold and one of the Targets is set.
+	Conflicts
+
+	// RangeDependsOn fails when the conditions hold and the value of
+	// Identifier, multiplied by the value of Scale when it is given, is below
+	// MinValue or above MaxValue, or is not a multiple of MultipleOf.
+	RangeDependsOn
+
+	// Custom fails when the conditions hold and Check fails.
+	Custom
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t CrossFieldRuleType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t CrossFieldRuleType) String() string {
+	return [...]string{"Requires", "Conflicts", "RangeDependsOn", "Custom"}[t]
+}
+
+// CrossFieldCondition is a condition on an attribute of the configuration
+// of a resource. It holds when the attribute is set, or when AllowedValues
+// or Regexp are given, when it is set to one of AllowedValues or a value
+// that matches Regexp, zero values included. Not negates the condition.
+type CrossFieldCondition struct {
+	// This is the attribute path, with list indexes and "#" for the number
+	// of elements of a list or set. A "*" index applies the rule to each
+	// element of a list or set of blocks.
+	// Ex: boot_volume.0.size, zones.#, rules.*.protocol
+	Identifier string
+
+	AllowedValues string //Comma separated list of strings.
+	Regexp        string
+	Not           bool
+}
+
+// CrossFieldRule is a rule between attributes of a resource, which is
+// checked when the resource is planned.
+type CrossFieldRule struct {
+	Type CrossFieldRuleType
+
+	// The rule applies when all of the conditions hold, or always when there
+	// is none. Conditions on unknown values do not hold.
+	When []CrossFieldCondition
+
+	// The attributes that the Requires and Conflicts rules apply to.
+	Targets []string
+
+	// The attribute that the RangeDependsOn rule applies to. MinValue and
+	// MaxValue are numbers or the paths of numeric attributes.
+	Identifier string
+	Scale      string
+	MinValue   string
+	MaxValue   string
+	MultipleOf string
+
+	// The check of a Custom rule.
+	Check schema.CustomizeDiffFunc
+
+	// Message explains the rule in its error.
+	Message string
+
+	// ZeroValueSet counts zero values of the conditions and targets as set,
+	// for attributes where they are meaningful, such as an icmp code of 0 or
+	// a bool that is explicitly false. Zero values are otherwise not set, as
+	// with GetOk.
+	ZeroValueSet bool
+}
+
+// InvokeCrossFieldValidator returns the CustomizeDiff function that checks
+// the cross-attribute rules of the resource, or nil when it has none.
+func InvokeCrossFieldValidator(resourceName string) schema.CustomizeDiffFunc {
+	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
+	if resourceItem == nil || len(resourceItem.Rules) == 0 {
+		return nil
+	}
+	rules := resourceItem.Rules
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return CheckCrossFieldRules(ctx, rules, diff.GetRawConfig(), diff, meta)
+	}
+}
+
+// CheckCrossFieldRules checks rules against the configuration of a
+// resource, and joins the errors of the rules that fail. Custom rules are
+// skipped without a diff.
+func CheckCrossFieldRules(ctx context.Context, rules []CrossFieldRule, config cty.Value, diff *schema.ResourceDiff, meta interface{}) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	var errs []error
+	for _, rule := range rules {
+		for _, expanded := range rule.expand(config) {
+			if err := expanded.check(ctx, config, diff, meta); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// expand returns a rule for each element of the list or set of blocks that
+// the "*" in the paths of rule refers to, or rule itself without a "*".
+func (rule CrossFieldRule) expand(config cty.Value) []CrossFieldRule {
+	var prefix string
+	for _, path := range rule.paths() {
+		if i := strings.Index(path, ".*"); i >= 0 {
+			prefix = path[:i]
+			break
+		}
+	}
+	if prefix == "" {
+		return []CrossFieldRule{rule}
+	}
+	count, ok := attributeValue(config, prefix+".#")
+	if !ok || count.IsNull() || !count.IsKnown() {
+		return nil
+	}
+	n, _ := count.AsBigFloat().Int64()
+	rules := make([]CrossFieldRule, 0, n)
+	for i := int64(0); i < n; i++ {
+		index := fmt.Sprintf("%s.%d", prefix, i)
+		replace := func(path string) string {
+			return strings.Replace(path, prefix+".*", index, 1)
+		}
+		expanded := rule
+		expanded.When = make([]CrossFieldCondition, len(rule.When))
+		for j, condition := range rule.When {
+			condition.Identifier = replace(condition.Identifier)
+			expanded.When[j] = condition
+		}
+		expanded.Targets = make([]string, len(rule.Targets))
+		for j, target := range rule.Targets {
+			expanded.Targets[j] = replace(target)
+		}
+		expanded.Identifier = replace(rule.Identifier)
+		expanded.Scale = replace(rule.Scale)
+		expanded.MinValue = replace(rule.MinValue)
+		expanded.MaxValue = replace(rule.MaxValue)
+		rules = append(rules, expanded)
+	}
+	return rules
+}
+
+func (rule CrossFieldRule) paths() []string {
+	paths := append([]string{rule.Identifier, rule.Scale, rule.MinValue, rule.MaxValue}, rule.Targets...)
+	for _, condition := range rule.When {
+		paths = append(paths, condition.Identifier)
+	}
+	return paths
+}
+
+func (rule CrossFieldRule) check(ctx context.Context, config cty.Value, diff *schema.ResourceDiff, meta interface{}) error {
+	var conditions []string
+	for _, condition := range rule.When {
+		holds, known := condition.holds(config, rule.ZeroValueSet)
+		if !holds || !known {
+			return nil
+		}
+		conditions = append(conditions, condition.String())
+	}
+	var err error
+	var got string
+	switch rule.Type {
+	case Requires:
+		var missing []string
+		for _, target := range rule.Targets {
+			if !isSet(config, target, rule.ZeroValueSet) {
+				missing = append(missing, target)
+			}
+		}
+		if len(missing) > 0 {
+			err = fmt.Errorf("%s %s required", strings.Join(missing, ", "), plural(len(missing), "is", "are"))
+		}
+	case Conflicts:
+		var set []string
+		for _, target := range rule.Targets {
+			if isSet(config, target, rule.ZeroValueSet) {
+				set = append(set, target)
+			}
+		}
+		if len(set) > 0 {
+			err = fmt.Errorf("%s cannot be set", strings.Join(set, ", "))
+		}
+	case RangeDependsOn:
+		got, err = rule.checkRange(config)
+	case Custom:
+		if diff != nil {
+			err = rule.Check(ctx, diff, meta)
+		}
+	}
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+	if len(conditions) > 0 {
+		message += " when " + strings.Join(conditions, " and ")
+	}
+	if got != "" {
+		message += ", got " + got
+	}
+	if rule.Message != "" {
+		message += ": " + rule.Message
+	}
+	return fmt.Errorf("[ERROR] %s", message)
+}
+
+// checkRange returns the value of the rule, and an error when it is out
+// of range.
+func (rule CrossFieldRule) checkRange(config cty.Value) (string, error) {
+	value, ok := numberValue(config, rule.Identifier)
+	if !ok {
+		return "", nil
+	}
+	name := rule.Identifier
+	if rule.Scale != "" {
+		scale, ok := numberValue(config, rule.Scale)
+		if !ok {
+			return "", nil
+		}
+		value *= scale
+		name = fmt.Sprintf("%s multiplied by %s", rule.Identifier, rule.Scale)
+	}
+	got := strconv.FormatFloat(value, 'f', -1, 64)
+	if minimum, label, ok := bound(config, rule.MinValue); ok && value < minimum {
+		return got, fmt.Errorf("%s must be at least %s", name, label)
+	}
+	if maximum, label, ok := bound(config, rule.MaxValue); ok && value > maximum {
+		return got, fmt.Errorf("%s must be at most %s", name, label)
+	}
+	if multiple, err := strconv.ParseFloat(rule.MultipleOf, 64); err == nil && multiple > 0 {
+		if quotient := value / multiple; quotient != float64(int64(quotient)) {
+			return got, fmt.Errorf("%s must be a multiple of %s", name, rule.MultipleOf)
+		}
+	}
+	return "", nil
+}
+
+// bound returns the value of the bound of a range, which is a number or
+// the path of a numeric attribute, and its label in errors.
+func bound(config cty.Value, value string) (float64, string, bool) {
+	if value == "" {
+		return 0, "", false
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		return number, value, true
+	}
+	number, ok := numberValue(config, value)
+	return number, fmt.Sprintf("%s (%s)", value, strconv.FormatFloat(number, 'f', -1, 64)), ok
+}
+
+// holds reports whether the condition holds, and whether the values it
+// depends on are known.
+func (condition CrossFieldCondition) holds(config cty.Value, zeroValueSet bool) (bool, bool) {
+	value, ok := attributeValue(config, condition.Identifier)
+	if !ok || !value.IsKnown() {
+		return false, false
+	}
+	holds := isSet(config, condition.Identifier, zeroValueSet)
+	if condition.AllowedValues != "" || condition.Regexp != "" {
+		if value.IsNull() {
+			return condition.Not, true
+		}
+		str, ok := stringValue(value)
+		if !ok {
+			return false, false
+		}
+		holds = false
+		if condition.AllowedValues != "" {
+			for _, allowed := range strings.Split(condition.AllowedValues, ",") {
+				holds = holds || str == strings.TrimSpace(allowed)
+			}
+		}
+		if condition.Regexp != "" {
+			holds = holds || regexp.MustCompile(condition.Regexp).MatchString(str)
+		}
+	}
+	return holds != condition.Not, true
+}
+
+func (condition CrossFieldCondition) String() string {
+	verb := "is"
+	if condition.Not {
+		verb = "is not"
+	}
+	switch {
+	case condition.AllowedValues != "":
+		values := strings.Split(condition.AllowedValues, ",")
+		for i, value := range values {
+			values[i] = strconv.Quote(strings.TrimSpace(value))
+		}
+		if len(values) == 1 {
+			return fmt.Sprintf("%s %s %s", condition.Identifier, verb, values[0])
+		}
+		return fmt.Sprintf("%s %s one of %s", condition.Identifier, verb, strings.Join(values, ", "))
+	case condition.Regexp != "":
+		if condition.Not {
+			return fmt.Sprintf("%s does not match %q", condition.Identifier, condition.Regexp)
+		}
+		return fmt.Sprintf("%s matches %q", condition.Identifier, condition.Regexp)
+	}
+	return fmt.Sprintf("%s %s set", condition.Identifier, verb)
+}
+
+// attributeValue returns the value of the attribute at path in config, and
+// whether it could be resolved. An attribute under a null or unknown block
+// is null or unknown.
+func attributeValue(config cty.Value, path string) (cty.Value, bool) {
+	value := config
+	for _, step := range strings.Split(path, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return value, true
+		}
+		ty := value.Type()
+		switch {
+		case step == "#" && (ty.IsListType() || ty.IsSetType() || ty.IsTupleType() || ty.IsMapType()):
+			value = cty.NumberIntVal(int64(value.LengthInt()))
+		case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+			index, err := strconv.Atoi(step)
+			if err != nil {
+				return cty.NilVal, false
+			}
+			if index >= value.LengthInt() {
+				return cty.NullVal(cty.DynamicPseudoType), true
+			}
+			element := cty.NullVal(cty.DynamicPseudoType)
+			for i, it := 0, value.ElementIterator(); it.Next(); i++ {
+				if i == index {
+					_, element = it.Element()
+					break
+				}
+			}
+			value = element
+		case ty.IsMapType():
+			key := cty.StringVal(step)
+			if !value.HasIndex(key).True() {
+				return cty.NullVal(ty.ElementType()), true
+			}
+			value = value.Index(key)
+		case ty.IsObjectType() && ty.HasAttribute(step):
+			value = value.GetAttr(step)
+		default:
+			return cty.NilVal, false
+		}
+	}
+	return value, true
+}
+
+// isSet reports whether the attribute at path is set in config, as GetOk
+// does: an unknown value is set, and a zero value, "", false, 0 or an empty
+// list, set or map, is not unless zeroValueSet.
+func isSet(config cty.Value, path string, zeroValueSet bool) bool {
+	value, ok := attributeValue(config, path)
+	if !ok || value.IsNull() {
+		return false
+	}
+	if !value.IsKnown() || zeroValueSet {
+		return true
+	}
+	ty := value.Type()
+	switch {
+	case ty.IsListType() || ty.IsSetType() || ty.IsMapType() || ty.IsTupleType():
+		return value.LengthInt() > 0
+	case ty == cty.String:
+		return value.AsString() != ""
+	case ty == cty.Bool:
+		return value.True()
+	case ty == cty.Number:
+		return value.AsBigFloat().Sign() != 0
+	}
+	return true
+}
+
+func stringValue(value cty.Value) (string, bool) {
+	switch value.Type() {
+	case cty.String:
+		return value.AsString(), true
+	case cty.Number:
+		return value.AsBigFloat().Text('f', -1), true
+	case cty.Bool:
+		return strconv.FormatBool(value.True()), true
+	}
+	return "", false
+}
+
+func numberValue(config cty.Value, path string) (float64, bool) {
+	value, ok := attributeValue(config, path)
+	if !ok || value.IsNull() || !value.IsKnown() {
+		return 0, false
+	}
+	switch value.Type() {
+	case cty.Number:
+		f, _ := value.AsBigFloat().Float64()
+		return f, true
+	case cty.String:
+		f, ok := new(big.Float).SetString(value.AsString())
+		if !ok {
+			return 0, false
+		}
+		n, _ := f.Float64()
+		return n, true
+	}
+	return 0, false
+}
+
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
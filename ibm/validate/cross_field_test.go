@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func crossFieldConfig(attributes map[string]cty.Value) cty.Value {
+	base := map[string]cty.Value{
+		"type":     cty.NullVal(cty.String),
+		"cidr":     cty.NullVal(cty.String),
+		"gateway":  cty.NullVal(cty.String),
+		"count":    cty.NullVal(cty.Number),
+		"zones":    cty.NullVal(cty.List(cty.String)),
+		"settings": cty.ListValEmpty(cty.Object(map[string]cty.Type{"size": cty.Number})),
+		"rules":    cty.ListValEmpty(cty.Object(map[string]cty.Type{"protocol": cty.String, "port": cty.Number, "port_max": cty.Number})),
+	}
+	for name, value := range attributes {
+		base[name] = value
+	}
+	return cty.ObjectVal(base)
+}
+
+func TestCrossFieldRules(t *testing.T) {
+	rules := []CrossFieldRule{
+		{
+			Type:    Requires,
+			When:    []CrossFieldCondition{{Identifier: "type", AllowedValues: "vlan, vxlan"}},
+			Targets: []string{"cidr", "gateway"},
+		},
+		{
+			Type:    Conflicts,
+			When:    []CrossFieldCondition{{Identifier: "type", Regexp: "^pub-"}},
+			Targets: []string{"cidr"},
+			Message: "public networks are assigned a CIDR",
+		},
+		{
+			Type:       RangeDependsOn,
+			When:       []CrossFieldCondition{{Identifier: "type", AllowedValues: "vlan"}},
+			Identifier: "count",
+			Scale:      "zones.#",
+			MinValue:   "2",
+			MaxValue:   "100",
+		},
+		{
+			Type:       RangeDependsOn,
+			Identifier: "settings.0.size",
+			MultipleOf: "0.25",
+		},
+		{
+			Type:    Conflicts,
+			When:    []CrossFieldCondition{{Identifier: "rules.*.protocol", AllowedValues: "icmp", Not: true}},
+			Targets: []string{"rules.*.port"},
+		},
+		{
+			Type:       RangeDependsOn,
+			Identifier: "rules.*.port",
+			MaxValue:   "rules.*.port_max",
+		},
+		{
+			Type:    Requires,
+			When:    []CrossFieldCondition{{Identifier: "count"}},
+			Targets: []string{"zones"},
+		},
+		{
+			Type:         Conflicts,
+			When:         []CrossFieldCondition{{Identifier: "settings.0.size"}},
+			Targets:      []string{"gateway"},
+			ZeroValueSet: true,
+		},
+	}
+	listOf := func(values ...string) cty.Value {
+		var list []cty.Value
+		for _, value := range values {
+			list = append(list, cty.StringVal(value))
+		}
+		return cty.ListVal(list)
+	}
+	rule := func(protocol string, port cty.Value, portMax int64) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"protocol": cty.StringVal(protocol), "port": port, "port_max": cty.NumberIntVal(portMax)})
+	}
+	for name, test := range map[string]struct {
+		config   map[string]cty.Value
+		expected []string
+	}{
+		"no condition holds": {
+			config: map[string]cty.Value{"type": cty.StringVal("pvt")},
+		},
+		"required attributes": {
+			config:   map[string]cty.Value{"type": cty.StringVal("vxlan"), "cidr": cty.StringVal("10.0.0.0/24")},
+			expected: []string{`gateway is required when type is one of "vlan", "vxlan"`},
+		},
+		"unknown required attribute": {
+			config: map[string]cty.Value{"type": cty.StringVal("vxlan"), "cidr": cty.UnknownVal(cty.String), "gateway": cty.StringVal("10.0.0.1")},
+		},
+		"unknown condition": {
+			config: map[string]cty.Value{"type": cty.UnknownVal(cty.String)},
+		},
+		"conflicting attribute": {
+			config:   map[string]cty.Value{"type": cty.StringVal("pub-vlan"), "cidr": cty.StringVal("10.0.0.0/24")},
+			expected: []string{`cidr cannot be set when type matches "^pub-": public networks are assigned a CIDR`},
+		},
+		"scaled range": {
+			config: map[string]cty.Value{
+				"type": cty.StringVal("vlan"), "cidr": cty.StringVal("10.0.0.0/24"), "gateway": cty.StringVal("10.0.0.1"),
+				"count": cty.NumberIntVal(1), "zones": listOf("us-south-1"),
+			},
+			expected: []string{`count multiplied by zones.# must be at least 2 when type is "vlan", got 1`},
+		},
+		"scaled range in bounds": {
+			config: map[string]cty.Value{
+				"type": cty.StringVal("vlan"), "cidr": cty.StringVal("10.0.0.0/24"), "gateway": cty.StringVal("10.0.0.1"),
+				"count": cty.NumberIntVal(1), "zones": listOf("us-south-1", "us-south-2"),
+			},
+		},
+		"multiple of a nested attribute": {
+			config: map[string]cty.Value{
+				"settings": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"size": cty.NumberFloatVal(0.3)})}),
+			},
+			expected: []string{"settings.0.size must be a multiple of 0.25, got 0.3"},
+		},
+		"zero values are not set": {
+			config: map[string]cty.Value{
+				"type": cty.StringVal(""), "cidr": cty.StringVal(""), "count": cty.NumberIntVal(0),
+				"rules": cty.ListVal([]cty.Value{rule("tcp", cty.NumberIntVal(0), 0)}),
+			},
+		},
+		"zero values are set": {
+			config: map[string]cty.Value{
+				"gateway":  cty.StringVal(""),
+				"settings": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(0)})}),
+			},
+			expected: []string{"gateway cannot be set when settings.0.size is set"},
+		},
+		"each element of a block": {
+			config: map[string]cty.Value{
+				"rules": cty.ListVal([]cty.Value{
+					rule("icmp", cty.NumberIntVal(8), 8),
+					rule("tcp", cty.NullVal(cty.Number), 80),
+					rule("udp", cty.NumberIntVal(53), 52),
+				}),
+			},
+			expected: []string{
+				`rules.2.port cannot be set when rules.2.protocol is not "icmp"`,
+				"rules.2.port must be at most rules.2.port_max (52), got 53",
+			},
+		},
+	} {
+		err := CheckCrossFieldRules(context.Background(), rules, crossFieldConfig(test.config), nil, nil)
+		var messages []string
+		if err != nil {
+			messages = strings.Split(err.Error(), "\n")
+		}
+		if len(messages) != len(test.expected) {
+			t.Errorf("%s: expected %d errors, got %v", name, len(test.expected), err)
+			continue
+		}
+		for i, message := range messages {
+			if message != "[ERROR] "+test.expected[i] {
+				t.Errorf("%s: expected %q, got %q", name, "[ERROR] "+test.expected[i], message)
+			}
+		}
+	}
+}
+
+func TestInvokeCrossFieldValidator(t *testing.T) {
+	defer SetValidatorDict(validatorDict)
+	SetValidatorDict(ValidatorDict{ResourceValidatorDictionary: map[string]*ResourceValidator{
+		"ibm_without_rules": {ResourceName: "ibm_without_rules"},
+		"ibm_with_rules": {ResourceName: "ibm_with_rules", Rules: []CrossFieldRule{
+			{Type: Requires, When: []CrossFieldCondition{{Identifier: "type"}}, Targets: []string{"cidr"}},
+		}},
+	}})
+	if InvokeCrossFieldValidator("ibm_without_rules") != nil || InvokeCrossFieldValidator("ibm_unknown") != nil {
+		t.Error("Expected no CustomizeDiff function for resources without rules")
+	}
+	if InvokeCrossFieldValidator("ibm_with_rules") == nil {
+		t.Error("Expected a CustomizeDiff function for a resource with rules")
+	}
+}
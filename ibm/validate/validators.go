@@ -1216,6 +1216,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of rules between the parameters, which are checked when the resource is planned.
+	Rules []CrossFieldRule
 }
 
 type ValidatorDict struct {
@@ -1242,7 +1245,7 @@ func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc
 	var schemaToInvoke ValidateSchema
 	found := false
 	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
-	if resourceItem.ResourceName == resourceName {
+	if resourceItem != nil && resourceItem.ResourceName == resourceName {
 		parameterValidateSchema := resourceItem.Schema
 		for _, validateSchema := range parameterValidateSchema {
 			if validateSchema.Identifier == identifier {
@@ -1267,7 +1270,7 @@ func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaVal
 	found := false
 
 	dataSourceItem := validatorDict.DataSourceValidatorDictionary[resourceName]
-	if dataSourceItem.ResourceName == resourceName {
+	if dataSourceItem != nil && dataSourceItem.ResourceName == resourceName {
 		parameterValidateSchema := dataSourceItem.Schema
 		for _, validateSchema := range parameterValidateSchema {
 			if validateSchema.Identifier == identifier {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
@@ -10,7 +11,11 @@ import (
 
 func main() {
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
+	serverFactory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
+		GRPCProviderFunc: serverFactory,
 	})
 }